@@ -1,9 +1,15 @@
 package main
 
 import (
+	"log"
+
 	"github.com/theodorusyoga/loan-service-state-machine/config"
 	fxpkg "github.com/theodorusyoga/loan-service-state-machine/pkg/fx"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
 	"go.uber.org/fx"
+	"go.uber.org/fx/fxevent"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	_ "github.com/theodorusyoga/loan-service-state-machine/docs"
 )
@@ -14,13 +20,27 @@ import (
 // @host localhost:5002
 // @BasePath /api/v1
 func main() {
+	cfg, err := config.Load("config/config.yaml")
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	logger, err := logging.New(cfg)
+	if err != nil {
+		log.Fatalf("failed to create logger: %v", err)
+	}
+	defer logger.Sync()
+	logging.Setup(logger)
+
 	app := fx.New(
-		fx.Provide(
-			func() string {
-				return "config/config.yaml"
-			},
-			config.Load,
-		),
+		fx.Supply(cfg, logger),
+		// The dependency injection events are only of interest when debugging
+		fx.WithLogger(func(logger *zap.Logger) fxevent.Logger {
+			fxLogger := &fxevent.ZapLogger{Logger: logger}
+			fxLogger.UseLogLevel(zapcore.DebugLevel)
+			return fxLogger
+		}),
+		fxpkg.StopTimeout(cfg),
 		fxpkg.Module,
 		// TODO: Put other modules
 	)
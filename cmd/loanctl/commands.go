@@ -0,0 +1,429 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/request"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/importer"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+// CLI runs the loanctl commands on top of the domain services
+type CLI struct {
+	loanService     *loan.LoanService
+	borrowerService *borrower.BorrowerService
+	lenderService   *lender.LenderService
+	employeeService *employee.EmployeeService
+	importService   *importer.ImportService
+	validate        *validator.Validate
+	printer         *printer
+}
+
+// Run executes the command given as "<resource> <action> [arguments]"
+func (c *CLI) Run(ctx context.Context, args []string) error {
+	resource, action, args := args[0], args[1], args[2:]
+
+	switch resource + " " + action {
+	case "borrower create":
+		return c.createBorrower(ctx, args)
+	case "lender create":
+		return c.createLender(ctx, args)
+	case "employee create":
+		return c.createEmployee(ctx, args)
+	case "borrower import":
+		return c.importFile(ctx, "borrower import", args, c.importService.ImportBorrowers)
+	case "lender import":
+		return c.importFile(ctx, "lender import", args, c.importService.ImportLenders)
+	case "employee import":
+		return c.importFile(ctx, "employee import", args, c.importService.ImportEmployees)
+	case "loan list":
+		return c.listLoans(ctx, args)
+	case "loan get":
+		return c.getLoan(ctx, args)
+	case "loan history":
+		return c.loanHistory(ctx, args)
+	case "loan approve":
+		return c.approveLoan(ctx, args)
+	case "loan invest":
+		return c.investLoan(ctx, args)
+	case "loan disburse":
+		return c.disburseLoan(ctx, args)
+	case "loan reject":
+		return c.rejectLoan(ctx, args)
+	default:
+		return fmt.Errorf("unknown command %q, run loanctl -h for the list of commands", resource+" "+action)
+	}
+}
+
+// partyFlags are the details shared by borrowers, lenders and employees
+type partyFlags struct {
+	name, email, phone, idNumber *string
+}
+
+func newPartyFlags(fs *flag.FlagSet) partyFlags {
+	return partyFlags{
+		name:     fs.String("name", "", "full name"),
+		email:    fs.String("email", "", "email address"),
+		phone:    fs.String("phone", "", "phone number"),
+		idNumber: fs.String("id-number", "", "identity card number"),
+	}
+}
+
+func (c *CLI) createBorrower(ctx context.Context, args []string) error {
+	fs := newFlagSet("borrower create")
+	party := newPartyFlags(fs)
+	creditLimit := fs.Float64("credit-limit", -1, "maximum outstanding loan amount, no limit when omitted")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	req := request.CreateBorrowerRequest{
+		FullName:    *party.name,
+		Email:       *party.email,
+		PhoneNumber: *party.phone,
+		IDNumber:    *party.idNumber,
+	}
+	if *creditLimit >= 0 {
+		req.CreditLimit = creditLimit
+	}
+	if err := c.validateRequest(req); err != nil {
+		return err
+	}
+
+	b, err := c.borrowerService.CreateBorrower(ctx, req.FullName, req.Email, req.PhoneNumber, req.IDNumber, req.CreditLimit)
+	if err != nil {
+		return err
+	}
+
+	return c.printer.borrowers(b)
+}
+
+func (c *CLI) createLender(ctx context.Context, args []string) error {
+	fs := newFlagSet("lender create")
+	party := newPartyFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	req := request.CreateLenderRequest{
+		FullName:    *party.name,
+		Email:       *party.email,
+		PhoneNumber: *party.phone,
+		IDNumber:    *party.idNumber,
+	}
+	if err := c.validateRequest(req); err != nil {
+		return err
+	}
+
+	l, err := c.lenderService.CreateLender(ctx, req.FullName, req.Email, req.PhoneNumber, req.IDNumber)
+	if err != nil {
+		return err
+	}
+
+	return c.printer.lenders(l)
+}
+
+func (c *CLI) createEmployee(ctx context.Context, args []string) error {
+	fs := newFlagSet("employee create")
+	party := newPartyFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	req := request.CreateEmployeeRequest{
+		FullName:    *party.name,
+		Email:       *party.email,
+		PhoneNumber: *party.phone,
+		IDNumber:    *party.idNumber,
+	}
+	if err := c.validateRequest(req); err != nil {
+		return err
+	}
+
+	e, err := c.employeeService.CreateEmployee(ctx, req.FullName, req.Email, req.PhoneNumber, req.IDNumber)
+	if err != nil {
+		return err
+	}
+
+	return c.printer.employees(e)
+}
+
+// importFile creates parties from a CSV file, see the importer package for the columns
+func (c *CLI) importFile(ctx context.Context, command string, args []string, importRows func(ctx context.Context, r io.Reader, dryRun bool) (*importer.Result, error)) error {
+	fs := newFlagSet(command)
+	path := fs.String("file", "", "CSV file to import")
+	dryRun := fs.Bool("dry-run", false, "only validate the rows, nothing is inserted")
+	batchSize := fs.Int("batch-size", importer.DefaultBatchSize, "rows inserted per transaction")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := requireFlags("file", *path); err != nil {
+		return err
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	c.importService.WithBatchSize(*batchSize)
+	result, err := importRows(ctx, file, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	if err := c.printer.importResult(result); err != nil {
+		return err
+	}
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("%d of %d rows have errors", len(result.Errors), result.TotalRows)
+	}
+	return nil
+}
+
+func (c *CLI) listLoans(ctx context.Context, args []string) error {
+	fs := newFlagSet("loan list")
+	status := fs.String("status", "", "only loans in this status")
+	borrowerID := fs.String("borrower", "", "only loans of this borrower")
+	page := fs.Int("page", 1, "page number")
+	pageSize := fs.Int("page-size", 20, "loans per page")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	filter := loan.LoanFilter{Page: *page, PageSize: *pageSize}
+	if *status != "" {
+		s := loan.Status(*status)
+		filter.Status = &s
+	}
+	if *borrowerID != "" {
+		filter.BorrowerID = borrowerID
+	}
+
+	result, err := c.loanService.ListLoans(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	loans, _ := result.Data.([]*loan.Loan)
+	return c.printer.loanPage(loans, result)
+}
+
+func (c *CLI) getLoan(ctx context.Context, args []string) error {
+	l, err := c.loadLoan(ctx, "loan get", args, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.printer.loans(l)
+}
+
+func (c *CLI) loanHistory(ctx context.Context, args []string) error {
+	l, err := c.loadLoan(ctx, "loan history", args, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.printer.transitions(l.StatusTransitions)
+}
+
+func (c *CLI) approveLoan(ctx context.Context, args []string) error {
+	var approvedBy, fileName *string
+	loanID, err := parseLoanArgs("loan approve", args, func(fs *flag.FlagSet) {
+		approvedBy = fs.String("by", "", "ID of the approving employee")
+		fileName = fs.String("file", "", "file name of the survey document")
+	})
+	if err != nil {
+		return err
+	}
+	l, err := c.loanService.GetByID(ctx, loanID)
+	if err != nil {
+		return c.refuse(ctx, loanID, loan.EventApprove, *approvedBy, nil, err)
+	}
+	if err := requireFlags("by", *approvedBy, "file", *fileName); err != nil {
+		return c.refuse(ctx, loanID, loan.EventApprove, *approvedBy, l, err)
+	}
+
+	if err := c.loanService.ApproveLoan(ctx, l, *approvedBy, *fileName); err != nil {
+		return err
+	}
+
+	return c.printer.loans(l)
+}
+
+func (c *CLI) investLoan(ctx context.Context, args []string) error {
+	var lenderID *string
+	var amount *float64
+	loanID, err := parseLoanArgs("loan invest", args, func(fs *flag.FlagSet) {
+		lenderID = fs.String("lender", "", "ID of the investing lender")
+		amount = fs.Float64("amount", 0, "amount to invest")
+	})
+	if err != nil {
+		return err
+	}
+	l, err := c.loanService.GetByID(ctx, loanID)
+	if err != nil {
+		return c.refuse(ctx, loanID, loan.EventInvest, *lenderID, nil, err)
+	}
+	if err := requireFlags("lender", *lenderID); err != nil {
+		return c.refuse(ctx, loanID, loan.EventInvest, *lenderID, l, err)
+	}
+
+	investor, err := c.lenderService.GetByID(ctx, *lenderID)
+	if err != nil {
+		return c.refuse(ctx, loanID, loan.EventInvest, *lenderID, l, err)
+	}
+
+	result, err := c.loanService.InvestLoan(ctx, l, investor, *amount)
+	if err != nil {
+		return err
+	}
+
+	return c.printer.investment(l, result)
+}
+
+func (c *CLI) disburseLoan(ctx context.Context, args []string) error {
+	var disbursedBy, fileName *string
+	loanID, err := parseLoanArgs("loan disburse", args, func(fs *flag.FlagSet) {
+		disbursedBy = fs.String("by", "", "ID of the field officer handing over the money")
+		fileName = fs.String("file", "", "file name of the agreement signed by the borrower")
+	})
+	if err != nil {
+		return err
+	}
+	l, err := c.loanService.GetByID(ctx, loanID)
+	if err != nil {
+		return c.refuse(ctx, loanID, loan.EventDisburse, *disbursedBy, nil, err)
+	}
+	if err := requireFlags("by", *disbursedBy, "file", *fileName); err != nil {
+		return c.refuse(ctx, loanID, loan.EventDisburse, *disbursedBy, l, err)
+	}
+
+	result, err := c.loanService.DisburseLoan(ctx, l, *disbursedBy, *fileName)
+	if err != nil {
+		return err
+	}
+
+	return c.printer.disbursement(l, result)
+}
+
+func (c *CLI) rejectLoan(ctx context.Context, args []string) error {
+	var rejectedBy, reason *string
+	loanID, err := parseLoanArgs("loan reject", args, func(fs *flag.FlagSet) {
+		rejectedBy = fs.String("by", "", "ID of the rejecting employee")
+		reason = fs.String("reason", "", "reason of the rejection")
+	})
+	if err != nil {
+		return err
+	}
+	l, err := c.loanService.GetByID(ctx, loanID)
+	if err != nil {
+		return c.refuse(ctx, loanID, loan.EventReject, *rejectedBy, nil, err)
+	}
+	if err := requireFlags("by", *rejectedBy, "reason", *reason); err != nil {
+		return c.refuse(ctx, loanID, loan.EventReject, *rejectedBy, l, err)
+	}
+
+	if err := c.loanService.RejectLoan(ctx, l, *rejectedBy, *reason); err != nil {
+		return err
+	}
+
+	return c.printer.loans(l)
+}
+
+// refuse records a transition refused before reaching the state machine in the audit log, like
+// the API does, and returns err. l is nil when the loan was not found.
+func (c *CLI) refuse(ctx context.Context, loanID string, action string, actor string, l *loan.Loan, err error) error {
+	var status loan.Status
+	if l != nil {
+		status = l.Status
+	}
+	c.loanService.RecordRefusedAction(ctx, loanID, action, actor, status, err)
+
+	return err
+}
+
+// loadLoan parses "<id> [flags]" and gets the loan, defineFlags adds the flags of the command
+func (c *CLI) loadLoan(ctx context.Context, command string, args []string, defineFlags func(fs *flag.FlagSet)) (*loan.Loan, error) {
+	loanID, err := parseLoanArgs(command, args, defineFlags)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.loanService.GetByID(ctx, loanID)
+}
+
+// parseLoanArgs parses "<id> [flags]" and returns the loan ID, defineFlags adds the flags of the command
+func parseLoanArgs(command string, args []string, defineFlags func(fs *flag.FlagSet)) (string, error) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return "", fmt.Errorf("%s: loan ID is required", command)
+	}
+
+	fs := newFlagSet(command)
+	if defineFlags != nil {
+		defineFlags(fs)
+	}
+	if err := fs.Parse(args[1:]); err != nil {
+		return "", err
+	}
+
+	return args[0], nil
+}
+
+// requestFlags maps the fields of the API requests to the command flags
+var requestFlags = map[string]string{
+	"FullName":    "name",
+	"Email":       "email",
+	"PhoneNumber": "phone",
+	"IDNumber":    "id-number",
+	"CreditLimit": "credit-limit",
+}
+
+// validateRequest applies the validation rules of the API to the command arguments
+func (c *CLI) validateRequest(req any) error {
+	err := c.validate.Struct(req)
+
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		messages := make([]string, 0, len(validationErrors))
+		for _, fieldErr := range validationErrors {
+			name := fieldErr.Field()
+			if flagName, ok := requestFlags[name]; ok {
+				name = flagName
+			}
+			if fieldErr.Tag() == "required" {
+				messages = append(messages, fmt.Sprintf("-%s is required", name))
+			} else {
+				messages = append(messages, fmt.Sprintf("-%s is invalid (%s)", name, fieldErr.Tag()))
+			}
+		}
+		return errors.New(strings.Join(messages, ", "))
+	}
+
+	return err
+}
+
+// requireFlags takes pairs of flag name and value and fails on the first empty value
+func requireFlags(namesAndValues ...string) error {
+	for i := 0; i+1 < len(namesAndValues); i += 2 {
+		if namesAndValues[i+1] == "" {
+			return fmt.Errorf("-%s is required", namesAndValues[i])
+		}
+	}
+	return nil
+}
+
+func newFlagSet(command string) *flag.FlagSet {
+	return flag.NewFlagSet(command, flag.ExitOnError)
+}
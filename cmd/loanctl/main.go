@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository"
+	fxpkg "github.com/theodorusyoga/loan-service-state-machine/pkg/fx"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/requestid"
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+const usage = `Usage: loanctl [-config file] [-o table|json] <command> [arguments]
+
+Parties:
+  borrower create -name <name> -email <email> -phone <phone> -id-number <id> [-credit-limit <amount>]
+  lender create   -name <name> -email <email> -phone <phone> -id-number <id>
+  employee create -name <name> -email <email> -phone <phone> -id-number <id>
+  borrower|lender|employee import -file <csv file> [-dry-run] [-batch-size <n>]
+
+Loans:
+  loan list [-status <status>] [-borrower <id>] [-page <n>] [-page-size <n>]
+  loan get <id>
+  loan history <id>
+  loan approve <id> -by <employee id> -file <survey document>
+  loan invest <id> -lender <lender id> -amount <amount>
+  loan disburse <id> -by <field officer id> -file <signed agreement>
+  loan reject <id> -by <employee id> -reason <reason>
+`
+
+// Administration tool working directly on the database, for when the API is unavailable
+// or for scripted fixes. It runs the same services as the API, so every action goes
+// through the loan state machine and is recorded in the audit log.
+func main() {
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	configFile := flag.String("config", "config/config.yaml", "configuration file")
+	output := flag.String("o", outputTable, "output format, table or json")
+	flag.Parse()
+
+	if flag.NArg() < 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *output != outputTable && *output != outputJSON {
+		fail(fmt.Errorf("unknown output format %q", *output))
+	}
+
+	cfg, err := config.Load(*configFile)
+	if err != nil {
+		fail(fmt.Errorf("failed to load config: %w", err))
+	}
+	logger, err := logging.New(cfg)
+	if err != nil {
+		fail(err)
+	}
+	defer logger.Sync()
+	logging.Setup(logger)
+
+	cli := &CLI{printer: newPrinter(os.Stdout, *output)}
+	var db *repository.Database
+
+	// The modules are only used to build the services, the app is never started
+	// so the outbox dispatcher and the webhook worker stay with the API server
+	app := fx.New(
+		fx.NopLogger,
+		fx.Supply(cfg),
+		fxpkg.InfrastructureModule,
+		fxpkg.DomainModule,
+		fx.Provide(fxpkg.ProvideValidator),
+		// Keep the SQL log out of the output
+		fx.Decorate(func(db *gorm.DB) *gorm.DB {
+			if db == nil {
+				return nil
+			}
+			return db.Session(&gorm.Session{Logger: gormlogger.Default.LogMode(gormlogger.Silent)})
+		}),
+		fx.Populate(&db, &cli.loanService, &cli.borrowerService, &cli.lenderService, &cli.employeeService, &cli.importService, &cli.validate),
+	)
+	if err := app.Err(); err != nil {
+		fail(fmt.Errorf("failed to initialize: %w", err))
+	}
+	// No database to close when the repositories are kept in memory
+	closeDB := func() {
+		if db != nil {
+			db.Close()
+		}
+	}
+	defer closeDB()
+
+	// Actions are audited with a request ID telling they came from this tool
+	ctx := requestid.WithContext(context.Background(), "loanctl-"+uuid.New().String())
+
+	if err := cli.Run(ctx, flag.Args()); err != nil {
+		closeDB()
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "loanctl: "+err.Error())
+	os.Exit(1)
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/importer"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// printer writes command results as an aligned table for people or as JSON for scripts
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+func newPrinter(w io.Writer, format string) *printer {
+	return &printer{w: w, format: format}
+}
+
+func (p *printer) borrowers(borrowers ...*borrower.Borrower) error {
+	if p.format == outputJSON {
+		return p.json(borrowers)
+	}
+
+	rows := make([][]string, 0, len(borrowers))
+	for _, b := range borrowers {
+		creditLimit := "-"
+		if b.CreditLimit != nil {
+			creditLimit = formatAmount(*b.CreditLimit)
+		}
+		rows = append(rows, []string{b.ID, b.FullName, b.Email, b.PhoneNumber, b.IDNumber, creditLimit})
+	}
+	return p.table([]string{"ID", "NAME", "EMAIL", "PHONE", "ID NUMBER", "CREDIT LIMIT"}, rows)
+}
+
+func (p *printer) lenders(lenders ...*lender.Lender) error {
+	if p.format == outputJSON {
+		return p.json(lenders)
+	}
+
+	rows := make([][]string, 0, len(lenders))
+	for _, l := range lenders {
+		rows = append(rows, []string{l.ID, l.FullName, l.Email, l.PhoneNumber, l.IDNumber})
+	}
+	return p.table([]string{"ID", "NAME", "EMAIL", "PHONE", "ID NUMBER"}, rows)
+}
+
+func (p *printer) employees(employees ...*employee.Employee) error {
+	if p.format == outputJSON {
+		return p.json(employees)
+	}
+
+	rows := make([][]string, 0, len(employees))
+	for _, e := range employees {
+		rows = append(rows, []string{e.ID, e.FullName, e.Email, e.PhoneNumber, e.IDNumber})
+	}
+	return p.table([]string{"ID", "NAME", "EMAIL", "PHONE", "ID NUMBER"}, rows)
+}
+
+func (p *printer) loans(loans ...*loan.Loan) error {
+	if p.format == outputJSON {
+		if len(loans) == 1 {
+			return p.json(loans[0])
+		}
+		return p.json(loans)
+	}
+
+	rows := make([][]string, 0, len(loans))
+	for _, l := range loans {
+		rows = append(rows, []string{
+			l.ID,
+			l.BorrowerID,
+			string(l.Status),
+			formatAmount(l.Amount),
+			formatAmount(l.Rate) + "%",
+			formatAmount(l.ROI) + "%",
+			strconv.Itoa(l.TenorMonths) + "m " + string(l.InterestMethod),
+			formatAmount(l.TotalRepayment),
+			formatTime(&l.UpdatedAt),
+		})
+	}
+	return p.table([]string{"ID", "BORROWER", "STATUS", "AMOUNT", "RATE", "ROI", "TERMS", "REPAYMENT", "UPDATED"}, rows)
+}
+
+func (p *printer) loanPage(loans []*loan.Loan, page *domain.PaginatedResponse) error {
+	if p.format == outputJSON {
+		return p.json(page)
+	}
+
+	if err := p.loans(loans...); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(p.w, "\nPage %d of %d, %d loans\n", page.Pagination.CurrentPage, page.Pagination.TotalPages, page.Pagination.TotalItems)
+	return err
+}
+
+func (p *printer) transitions(transitions []loan.StatusTransition) error {
+	if p.format == outputJSON {
+		return p.json(transitions)
+	}
+
+	rows := make([][]string, 0, len(transitions))
+	for _, t := range transitions {
+		from := string(t.From)
+		if from == "" {
+			from = "-"
+		}
+		rows = append(rows, []string{formatTime(&t.Date), from, string(t.To), t.PerformedBy, t.Description})
+	}
+	return p.table([]string{"DATE", "FROM", "TO", "PERFORMED BY", "DESCRIPTION"}, rows)
+}
+
+func (p *printer) investment(l *loan.Loan, result *response.LoanLenderResponse) error {
+	if p.format == outputJSON {
+		return p.json(result)
+	}
+
+	agreement := "-"
+	if result.AgreementDocument != nil {
+		agreement = *result.AgreementDocument
+	}
+	return p.table([]string{"LOAN", "STATUS", "INVESTED", "REMAINING", "AGREEMENT"}, [][]string{{
+		l.ID, string(l.Status), formatAmount(result.InvestedAmount), formatAmount(result.RemainingAmount), agreement,
+	}})
+}
+
+func (p *printer) disbursement(l *loan.Loan, result *response.DisbursementResponse) error {
+	if p.format == outputJSON {
+		return p.json(result)
+	}
+
+	return p.table([]string{"LOAN", "STATUS", "DISBURSED BY", "DISBURSED AT", "BORROWER REPAYMENT", "INVESTOR ROI"}, [][]string{{
+		l.ID, string(l.Status), result.DisbursedBy, formatTime(&result.DisbursementDate),
+		formatAmount(result.BorrowerRepayment), formatAmount(result.InvestorROI),
+	}})
+}
+
+func (p *printer) importResult(result *importer.Result) error {
+	if p.format == outputJSON {
+		return p.json(result)
+	}
+
+	if len(result.Errors) > 0 {
+		rows := make([][]string, 0, len(result.Errors))
+		for _, rowErr := range result.Errors {
+			rows = append(rows, []string{strconv.Itoa(rowErr.Row), strings.Join(rowErr.Errors, "; ")})
+		}
+		if err := p.table([]string{"ROW", "ERRORS"}, rows); err != nil {
+			return err
+		}
+		fmt.Fprintln(p.w)
+	}
+
+	if result.DryRun {
+		_, err := fmt.Fprintf(p.w, "Dry run: %d of %d rows valid, nothing imported\n", result.ValidRows, result.TotalRows)
+		return err
+	}
+	_, err := fmt.Fprintf(p.w, "%d of %d rows imported\n", result.Imported, result.TotalRows)
+	return err
+}
+
+func (p *printer) json(v any) error {
+	encoder := json.NewEncoder(p.w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func (p *printer) table(header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func formatAmount(amount float64) string {
+	return fmt.Sprintf("%.2f", amount)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
@@ -1,10 +1,139 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository"
 	"github.com/theodorusyoga/loan-service-state-machine/migrations"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
 )
 
+const usage = `Usage: migrate [-config file] [command]
+
+Commands:
+  up                  apply the pending migrations (default)
+  down [-steps <n>]   revert the latest applied migrations, one unless set
+  status              list the migrations and when they were applied
+  create <name>       add empty up and down SQL files for every database type
+`
+
+// Applies the versioned migrations in migrations/sql to the configured database.
+//
+//	go run cmd/migrate/main.go up
+//	go run cmd/migrate/main.go down -steps 2
+//	go run cmd/migrate/main.go status
+//	go run cmd/migrate/main.go create add_loan_purpose
 func main() {
-	// Running migrations
-	migrations.RunMigrations()
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	configFile := flag.String("config", "config/config.yaml", "configuration file")
+	flag.Parse()
+
+	command := "up"
+	if flag.NArg() > 0 {
+		command = flag.Arg(0)
+	}
+	args := flag.Args()
+	if len(args) > 0 {
+		args = args[1:]
+	}
+
+	// Creating files needs neither the configuration nor the database
+	if command == "create" {
+		if len(args) != 1 {
+			flag.Usage()
+			os.Exit(2)
+		}
+		files, err := migrations.Create("migrations/sql", args[0])
+		if err != nil {
+			fail(fmt.Errorf("failed to create the migration: %w", err))
+		}
+		for _, file := range files {
+			fmt.Println(file)
+		}
+		return
+	}
+
+	cfg, err := config.Load(*configFile)
+	if err != nil {
+		fail(fmt.Errorf("failed to load config for migrations: %w", err))
+	}
+	dialect, err := migrations.Dialect(cfg.Database.Type)
+	if err != nil {
+		fail(err)
+	}
+	logger, err := logging.New(cfg)
+	if err != nil {
+		fail(err)
+	}
+	defer logger.Sync()
+	logging.Setup(logger)
+
+	db, err := repository.NewDatabase(cfg)
+	if err != nil {
+		logger.Fatal("Failed to connect to the database", zap.Error(err))
+	}
+	defer db.Close()
+	// Keep the SQL log out of the output
+	db.DB = db.DB.Session(&gorm.Session{Logger: gormlogger.Default.LogMode(gormlogger.Silent)})
+
+	migrator, err := migrations.NewMigrator(db.DB, dialect)
+	if err != nil {
+		logger.Fatal("Failed to load the migrations", zap.Error(err))
+	}
+
+	ctx := context.Background()
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			logger.Fatal("Migration failed", zap.Error(err))
+		}
+		logger.Info("Applied migrations, the schema is up to date", zap.Int("applied", len(applied)))
+
+	case "down":
+		downFlags := flag.NewFlagSet("down", flag.ExitOnError)
+		steps := downFlags.Int("steps", 1, "number of migrations to revert")
+		downFlags.Parse(args)
+
+		reverted, err := migrator.Down(ctx, *steps)
+		if err != nil {
+			logger.Fatal("Revert failed", zap.Error(err))
+		}
+		logger.Info("Reverted migrations", zap.Int("reverted", len(reverted)))
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			logger.Fatal("Failed to read the applied migrations", zap.Error(err))
+		}
+
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Local().Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(tw, "%04d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		tw.Flush()
+
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "migrate: "+err.Error())
+	os.Exit(1)
 }
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// Rebuilds the loans table and the loan status transitions from the event store.
+//
+//	go run cmd/replay/main.go                 replay every loan with stored events
+//	go run cmd/replay/main.go -loan <id>      replay a single loan
+//	go run cmd/replay/main.go -dry-run        print the rebuilt loans without writing them
+func main() {
+	configFile := flag.String("config", "config/config.yaml", "configuration file")
+	loanID := flag.String("loan", "", "ID of the loan to replay, all loans when empty")
+	dryRun := flag.Bool("dry-run", false, "print the rebuilt loans as JSON instead of writing them")
+	flag.Parse()
+
+	cfg, err := config.Load(*configFile)
+	if err != nil {
+		fail(fmt.Errorf("failed to load config: %w", err))
+	}
+	logger, err := logging.New(cfg)
+	if err != nil {
+		fail(err)
+	}
+	defer logger.Sync()
+	logging.Setup(logger)
+
+	db, err := repository.NewDatabase(cfg)
+	if err != nil {
+		logger.Fatal("Failed to connect to the database", zap.Error(err))
+	}
+	defer db.Close()
+	// Keep the SQL log out of the dry run output
+	db.DB = db.DB.Session(&gorm.Session{Logger: gormlogger.Default.LogMode(gormlogger.Silent)})
+
+	executor := repository.NewTxExecutor(db.DB, repository.NewRetryPolicy(cfg))
+	replayer := loan.NewReplayer(repository.NewEventStoreRepository(db.DB, executor), repository.NewLoanRepository(db.DB, executor))
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	onReplayed := func(l *loan.Loan) {
+		if *dryRun {
+			if err := encoder.Encode(l); err != nil {
+				logger.Fatal("Failed to print loan", zap.String("loan_id", l.ID), zap.Error(err))
+			}
+			return
+		}
+		logger.Info("Replayed loan", zap.String("loan_id", l.ID), zap.String("status", string(l.Status)))
+	}
+
+	ctx := context.Background()
+
+	if *loanID != "" {
+		l, err := replayer.Replay(ctx, *loanID, *dryRun)
+		if err != nil {
+			logger.Fatal("Replay failed", zap.String("loan_id", *loanID), zap.Error(err))
+		}
+		onReplayed(l)
+		return
+	}
+
+	count, err := replayer.ReplayAll(ctx, *dryRun, onReplayed)
+	if err != nil {
+		logger.Fatal("Replay failed", zap.Int("replayed", count), zap.Error(err))
+	}
+	logger.Info("Replayed loans", zap.Int("replayed", count))
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "replay: "+err.Error())
+	os.Exit(1)
+}
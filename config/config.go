@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
@@ -10,17 +11,78 @@ type DatabaseType string
 
 const (
 	DatabaseTypePostgres DatabaseType = "cockroach"
+	DatabaseTypeSQLite   DatabaseType = "sqlite" // The URL is the path of the database file
+	DatabaseTypeMemory   DatabaseType = "memory" // Data is lost on restart, for development and tests
+)
+
+type NotificationDriver string
+
+const (
+	NotificationDriverLog  NotificationDriver = "log"
+	NotificationDriverSMTP NotificationDriver = "smtp"
+)
+
+type TracingExporter string
+
+const (
+	TracingExporterNone   TracingExporter = "none"
+	TracingExporterStdout TracingExporter = "stdout"
+	TracingExporterOTLP   TracingExporter = "otlp" // To an OpenTelemetry collector over gRPC
 )
 
 // Config holds the service configuration
 type Config struct {
 	Server struct {
-		Port string `yaml:"port"`
+		Port     string `yaml:"port"`
+		GRPCPort string `yaml:"grpc_port"` // The gRPC server is not started when empty
+
+		// ShutdownTimeout bounds the drain of the in-flight requests and background jobs on
+		// shutdown, 30s when unset
+		ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
 	}
 
 	Database struct {
 		Type DatabaseType `yaml:"type"`
 		URL  string       `yaml:"url"`
+
+		// Retry of the transactions failing with a retryable error, such as a CockroachDB
+		// serialization conflict
+		Retry struct {
+			MaxAttempts    int           `yaml:"max_attempts"`    // 5 when unset
+			InitialBackoff time.Duration `yaml:"initial_backoff"` // Before the first retry, doubled on each retry, 10ms when unset
+			MaxBackoff     time.Duration `yaml:"max_backoff"`     // 1s when unset
+			Deadline       time.Duration `yaml:"deadline"`        // For all the attempts together, 5s when unset
+		} `yaml:"retry"`
+	}
+
+	// EventSourcing rebuilds loans from their event stream instead of reading the loans table
+	EventSourcing struct {
+		Enabled          bool `yaml:"enabled"`
+		SnapshotInterval int  `yaml:"snapshot_interval"` // Events replayed before a new snapshot is taken, 20 when unset
+	} `yaml:"event_sourcing"`
+
+	Log struct {
+		Level     string `yaml:"level"`      // debug, info, warn or error, info when unset
+		GORMLevel string `yaml:"gorm_level"` // silent, error, warn or info to log every statement, warn when unset
+	}
+
+	Tracing struct {
+		Exporter TracingExporter `yaml:"exporter"` // none (default), stdout or otlp
+		Endpoint string          `yaml:"endpoint"` // Address of the OTLP collector, localhost:4317 when unset
+		Insecure bool            `yaml:"insecure"` // Connect to the OTLP collector without TLS
+	}
+
+	Notification struct {
+		Driver           NotificationDriver `yaml:"driver"`   // log (default) or smtp
+		LogFile          string             `yaml:"log_file"` // Used by the log driver, standard log output when empty
+		AgreementBaseURL string             `yaml:"agreement_base_url"`
+		SMTP             struct {
+			Host     string `yaml:"host"`
+			Port     string `yaml:"port"`
+			Username string `yaml:"username"`
+			Password string `yaml:"password"`
+			From     string `yaml:"from"`
+		}
 	}
 }
 
@@ -41,6 +103,10 @@ func Load(filename string) (*Config, error) {
 		config.Server.Port = port
 	}
 
+	if grpcPort := os.Getenv("GRPC_PORT"); grpcPort != "" {
+		config.Server.GRPCPort = grpcPort
+	}
+
 	if dbType := os.Getenv("DATABASE_TYPE"); dbType != "" {
 		config.Database.Type = DatabaseType(dbType)
 	}
@@ -49,5 +115,17 @@ func Load(filename string) (*Config, error) {
 		config.Database.URL = dbURL
 	}
 
+	if level := os.Getenv("LOG_LEVEL"); level != "" {
+		config.Log.Level = level
+	}
+
+	if exporter := os.Getenv("TRACING_EXPORTER"); exporter != "" {
+		config.Tracing.Exporter = TracingExporter(exporter)
+	}
+
+	if password := os.Getenv("SMTP_PASSWORD"); password != "" {
+		config.Notification.SMTP.Password = password
+	}
+
 	return &config, nil
 }
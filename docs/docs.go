@@ -15,6 +15,94 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/audit": {
+            "get": {
+                "description": "Get every attempted loan action, successful or refused, newest first, with optional filtering",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "audit"
+                ],
+                "summary": "List audit log entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by loan ID",
+                        "name": "loan_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by actor (employee, lender or borrower ID, or system)",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by action (create, approve, invest, disburse, cancel, expire)",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by outcome (success, failure)",
+                        "name": "outcome",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by API request ID",
+                        "name": "request_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of entries per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of audit log entries",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/domain.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/audit.Entry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/borrowers": {
             "get": {
                 "description": "Get a list of all borrowers with optional filtering",
@@ -31,13 +119,19 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by full name",
+                        "description": "Search by name, email, phone number or ID number (partial, case-insensitive)",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by full name (partial, case-insensitive)",
                         "name": "full_name",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by email",
+                        "description": "Filter by email (case-insensitive)",
                         "name": "email",
                         "in": "query"
                     },
@@ -131,71 +225,69 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
+                    },
+                    "409": {
+                        "description": "Email or ID number already registered",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
                     }
                 }
             }
         },
-        "/employees": {
-            "get": {
-                "description": "Get a list of all employees with optional filtering",
+        "/borrowers/import": {
+            "post": {
+                "description": "Create borrowers from a CSV file with the columns full_name, email, phone_number, id_number and optionally credit_limit.\nRows are validated like a single borrower creation, invalid rows are reported with their errors and the valid ones inserted.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data",
+                    "text/csv"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "borrowers"
                 ],
-                "summary": "List all employees",
+                "summary": "Import borrowers from a CSV file",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Filter by full name",
-                        "name": "full_name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by email",
-                        "name": "email",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by phone number",
-                        "name": "phone_number",
-                        "in": "query"
+                        "type": "file",
+                        "description": "CSV file, or send it as the text/csv request body",
+                        "name": "file",
+                        "in": "formData"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by ID number",
-                        "name": "id_number",
+                        "type": "boolean",
+                        "description": "Only validate the rows, nothing is inserted",
+                        "name": "dry_run",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of employees",
+                        "description": "Import result with the errors per row",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/domain.PaginatedResponse"
+                                    "$ref": "#/definitions/response.APIResponse"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/employee.Employee"
-                                            }
+                                            "$ref": "#/definitions/importer.Result"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Unreadable file or missing columns",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -203,9 +295,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Register a new employee in the system",
+            }
+        },
+        "/borrowers/{id}/credit-limit": {
+            "put": {
+                "description": "Set the maximum outstanding loan amount of a borrower. Omit creditLimit to remove the limit.",
                 "consumes": [
                     "application/json"
                 ],
@@ -213,23 +307,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "borrowers"
                 ],
-                "summary": "Create a new employee",
+                "summary": "Update borrower credit limit",
                 "parameters": [
                     {
-                        "description": "Employee information",
-                        "name": "employee",
+                        "type": "string",
+                        "description": "Borrower ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Credit limit",
+                        "name": "creditLimit",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateEmployeeRequest"
+                            "$ref": "#/definitions/request.UpdateCreditLimitRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Employee created successfully",
+                    "200": {
+                        "description": "Credit limit updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -239,7 +340,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/employee.Employee"
+                                            "$ref": "#/definitions/borrower.Borrower"
                                         }
                                     }
                                 }
@@ -251,13 +352,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Borrower not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
                     }
                 }
             }
         },
-        "/lenders": {
+        "/borrowers/{id}/exposure": {
             "get": {
-                "description": "Get a list of all lenders with optional filtering",
+                "description": "Get the number and amount of a borrower's loans per status, the outstanding amount (loans not yet disbursed, rejected, cancelled or expired) and the remaining credit",
                 "consumes": [
                     "application/json"
                 ],
@@ -265,38 +372,89 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "lenders"
+                    "borrowers"
                 ],
-                "summary": "List all lenders",
+                "summary": "Get borrower exposure",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by full name",
-                        "name": "full_name",
-                        "in": "query"
+                        "description": "Borrower ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Borrower exposure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/loan.BorrowerExposure"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
+                    "404": {
+                        "description": "Borrower not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/borrowers/{id}/loans": {
+            "get": {
+                "description": "Get the loans of a borrower, newest first, with optional status filtering",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "borrowers"
+                ],
+                "summary": "List borrower loans",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by email",
-                        "name": "email",
-                        "in": "query"
+                        "description": "Borrower ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Filter by phone number",
-                        "name": "phone_number",
+                        "description": "Filter by loan status",
+                        "name": "status",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by ID number",
-                        "name": "id_number",
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of loans per page",
+                        "name": "page_size",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of lenders",
+                        "description": "List of loans",
                         "schema": {
                             "allOf": [
                                 {
@@ -308,7 +466,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/lender.Lender"
+                                                "$ref": "#/definitions/loan.Loan"
                                             }
                                         }
                                     }
@@ -316,6 +474,12 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "404": {
+                        "description": "Borrower not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -323,9 +487,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Register a new lender in the system",
+            }
+        },
+        "/borrowers/{id}/statement": {
+            "get": {
+                "description": "Get every loan of a borrower with its status, amount, rate, disbursement date and total repayment, and the total repayment due on disbursed loans",
                 "consumes": [
                     "application/json"
                 ],
@@ -333,23 +499,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "lenders"
+                    "borrowers"
                 ],
-                "summary": "Create a new lender",
+                "summary": "Get borrower statement",
                 "parameters": [
                     {
-                        "description": "Lender information",
-                        "name": "lender",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CreateLenderRequest"
-                        }
+                        "type": "string",
+                        "description": "Borrower ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Lender created successfully",
+                    "200": {
+                        "description": "Borrower statement",
                         "schema": {
                             "allOf": [
                                 {
@@ -359,15 +523,21 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/lender.Lender"
+                                            "$ref": "#/definitions/loan.Statement"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Invalid request or validation error",
+                    "404": {
+                        "description": "Borrower not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
@@ -375,9 +545,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/loans": {
+        "/employees": {
             "get": {
-                "description": "Get a list of all loans with optional filtering",
+                "description": "Get a list of all employees with optional filtering",
                 "consumes": [
                     "application/json"
                 ],
@@ -385,32 +555,65 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "loans"
+                    "employees"
                 ],
-                "summary": "List all loans",
+                "summary": "List all employees",
                 "parameters": [
                     {
-                        "type": "number",
-                        "description": "Maximum loan amount",
-                        "name": "max_amount",
+                        "type": "string",
+                        "description": "Search by name, email, phone number or ID number (partial, case-insensitive)",
+                        "name": "q",
                         "in": "query"
                     },
                     {
-                        "type": "number",
-                        "description": "Minimum loan amount",
-                        "name": "min_amount",
+                        "type": "string",
+                        "description": "Filter by full name (partial, case-insensitive)",
+                        "name": "full_name",
                         "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by email (case-insensitive)",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by phone number",
+                        "name": "phone_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by ID number",
+                        "name": "id_number",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of employees",
                         "schema": {
-                            "$ref": "#/definitions/domain.PaginatedResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/domain.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/employee.Employee"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
@@ -418,7 +621,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create a new loan with the provided details",
+                "description": "Register a new employee in the system",
                 "consumes": [
                     "application/json"
                 ],
@@ -426,29 +629,109 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "loans"
+                    "employees"
                 ],
-                "summary": "Create a new loan",
+                "summary": "Create a new employee",
                 "parameters": [
                     {
-                        "description": "Loan information",
-                        "name": "loan",
+                        "description": "Employee information",
+                        "name": "employee",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateLoanRequest"
+                            "$ref": "#/definitions/request.CreateEmployeeRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created",
+                        "description": "Employee created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/employee.Employee"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Email or ID number already registered",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/employees/import": {
+            "post": {
+                "description": "Create employees from a CSV file with the columns full_name, email, phone_number and id_number.\nRows are validated like a single employee creation, invalid rows are reported with their errors and the valid ones inserted.",
+                "consumes": [
+                    "multipart/form-data",
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Import employees from a CSV file",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file, or send it as the text/csv request body",
+                        "name": "file",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only validate the rows, nothing is inserted",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import result with the errors per row",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/importer.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Unreadable file or missing columns",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
@@ -456,9 +739,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/loans/{id}/{status}": {
-            "patch": {
-                "description": "Update a loan's status based on the provided status transition\n- For approve: { \"success\": true, \"message\": \"Loan status updated successfully\" }\n- For partial invest: { \"success\": true, \"data\": { \"remaining_amount\": 150000, \"invested_amount\": 50000, \"agreement_document\": null }, \"message\": \"loan invested successfully\" }\n- For full invest: { \"success\": true, \"data\": { \"remaining_amount\": 0, \"invested_amount\": 200000, \"agreement_document\": \"agreement_file.pdf\" }, \"message\": \"loan status updated to invested\" }\n- For disburse: { \"success\": true, \"data\": { \"field_officer_id\": \"emp-789\", \"agreement_file_name\": \"agreement.pdf\" }, \"message\": \"loan disbursed successfully\" }",
+        "/lenders": {
+            "get": {
+                "description": "Get a list of all lenders with optional filtering",
                 "consumes": [
                     "application/json"
                 ],
@@ -466,87 +749,1514 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "loans"
+                    "lenders"
                 ],
-                "summary": "Update loan status",
+                "summary": "List all lenders",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Loan ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Search by name, email, phone number or ID number (partial, case-insensitive)",
+                        "name": "q",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "New status",
-                        "name": "status",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter by full name (partial, case-insensitive)",
+                        "name": "full_name",
+                        "in": "query"
                     },
                     {
-                        "description": "Status update information",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object"
-                        }
+                        "type": "string",
+                        "description": "Filter by email (case-insensitive)",
+                        "name": "email",
+                        "in": "query"
                     },
                     {
-                        "description": "Approve request (when status=approve)",
-                        "name": "approveRequest",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ApproveSchema"
-                        }
+                        "type": "string",
+                        "description": "Filter by phone number",
+                        "name": "phone_number",
+                        "in": "query"
                     },
                     {
-                        "description": "Invest request (when status=invest)",
-                        "name": "investRequest",
-                        "in": "body",
+                        "type": "string",
+                        "description": "Filter by ID number",
+                        "name": "id_number",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of lenders",
                         "schema": {
-                            "$ref": "#/definitions/swagger.InvestSchema"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/domain.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/lender.Lender"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register a new lender in the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lenders"
+                ],
+                "summary": "Create a new lender",
+                "parameters": [
                     {
-                        "description": "Disburse request (when status=disburse)",
-                        "name": "disburseRequest",
+                        "description": "Lender information",
+                        "name": "lender",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.DisburseSchema"
+                            "$ref": "#/definitions/request.CreateLenderRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Successful status update with varying response structure based on status",
+                    "201": {
+                        "description": "Lender created successfully",
                         "schema": {
-                            "$ref": "#/definitions/response.APIResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/lender.Lender"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Invalid request or status transition",
+                        "description": "Invalid request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Email or ID number already registered",
                         "schema": {
                             "$ref": "#/definitions/response.APIResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "borrower.Borrower": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "email": {
-                    "type": "string"
-                },
-                "full_name": {
-                    "type": "string"
+        },
+        "/lenders/import": {
+            "post": {
+                "description": "Create lenders from a CSV file with the columns full_name, email, phone_number and id_number.\nRows are validated like a single lender creation, invalid rows are reported with their errors and the valid ones inserted.",
+                "consumes": [
+                    "multipart/form-data",
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lenders"
+                ],
+                "summary": "Import lenders from a CSV file",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file, or send it as the text/csv request body",
+                        "name": "file",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only validate the rows, nothing is inserted",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import result with the errors per row",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/importer.Result"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Unreadable file or missing columns",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/lenders/{id}/portfolio": {
+            "get": {
+                "description": "Get the total invested amount and expected return of a lender, broken down by loan status, with the lender's position in each loan",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lenders"
+                ],
+                "summary": "Get lender portfolio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lender ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page of positions",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of positions per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lender portfolio",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/loanlender.Portfolio"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Lender not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/lenders/{id}/wallet": {
+            "get": {
+                "description": "Get the wallet balance of a lender, including funds reserved for loans not yet disbursed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wallets"
+                ],
+                "summary": "Get lender wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lender ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lender wallet",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/wallet.Wallet"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Lender not found, or no funds deposited yet",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/lenders/{id}/wallet/deposit": {
+            "post": {
+                "description": "Add funds to the wallet of a lender, which is created on the first deposit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wallets"
+                ],
+                "summary": "Deposit funds",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lender ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Deposit information",
+                        "name": "deposit",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.WalletTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Funds deposited successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/wallet.Transaction"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Lender not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/lenders/{id}/wallet/transactions": {
+            "get": {
+                "description": "Get the transaction history of a lender wallet, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wallets"
+                ],
+                "summary": "List wallet transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lender ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by loan ID",
+                        "name": "loan_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by transaction type (deposit, withdraw, reserve, release, debit)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of wallet transactions",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/domain.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/wallet.Transaction"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Lender not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/lenders/{id}/wallet/withdraw": {
+            "post": {
+                "description": "Withdraw available (not reserved) funds from the wallet of a lender",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wallets"
+                ],
+                "summary": "Withdraw funds",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lender ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Withdrawal information",
+                        "name": "withdrawal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.WalletTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Funds withdrawn successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/wallet.Transaction"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or insufficient balance",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Lender not found, or no funds deposited yet",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loans": {
+            "get": {
+                "description": "Get a list of all loans with optional filtering",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "List all loans",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Maximum loan amount",
+                        "name": "max_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum loan amount",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PaginatedResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new loan with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "Create a new loan",
+                "parameters": [
+                    {
+                        "description": "Loan information",
+                        "name": "loan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateLoanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loans/transitions": {
+            "get": {
+                "description": "Get the status transitions of all loans, newest first, e.g. the loans approved by an employee last week",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "List loan status transitions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by loan ID",
+                        "name": "loan_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the status the loan moved to",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the ID of who performed the transition",
+                        "name": "performed_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only transitions at or after this time (RFC 3339 or YYYY-MM-DD)",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only transitions before this time (RFC 3339 or YYYY-MM-DD)",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of transitions per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of status transitions",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/domain.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/loan.StatusTransition"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid time filter",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loans/{id}/{status}": {
+            "patch": {
+                "description": "Update a loan's status based on the provided status transition\n- For approve: { \"success\": true, \"message\": \"Loan status updated successfully\" }\n- For partial invest: { \"success\": true, \"data\": { \"remaining_amount\": 150000, \"invested_amount\": 50000, \"agreement_document\": null }, \"message\": \"loan invested successfully\" }\n- For full invest: { \"success\": true, \"data\": { \"remaining_amount\": 0, \"invested_amount\": 200000, \"agreement_document\": \"agreement_file.pdf\" }, \"message\": \"loan status updated to invested\" }\n- For disburse: { \"success\": true, \"data\": { \"field_officer_id\": \"emp-789\", \"agreement_file_name\": \"agreement.pdf\" }, \"message\": \"loan disbursed successfully\" }\n- For reject, cancel and expire: { \"success\": true, \"message\": \"Loan status updated successfully\" }",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "Update loan status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "New status",
+                        "name": "status",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status update information",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    {
+                        "description": "Approve request (when status=approve)",
+                        "name": "approveRequest",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ApproveSchema"
+                        }
+                    },
+                    {
+                        "description": "Invest request (when status=invest)",
+                        "name": "investRequest",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InvestSchema"
+                        }
+                    },
+                    {
+                        "description": "Disburse request (when status=disburse)",
+                        "name": "disburseRequest",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.DisburseSchema"
+                        }
+                    },
+                    {
+                        "description": "Reject request (when status=reject)",
+                        "name": "rejectRequest",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.RejectSchema"
+                        }
+                    },
+                    {
+                        "description": "Cancel request (when status=cancel)",
+                        "name": "cancelRequest",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.CancelSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful status update with varying response structure based on status",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or status transition",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications": {
+            "get": {
+                "description": "Get the notifications sent to borrowers and lenders, newest first, with optional filtering",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "List notifications",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by recipient type (borrower, lender)",
+                        "name": "recipient_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by borrower or lender ID",
+                        "name": "recipient_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by loan ID",
+                        "name": "loan_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by event type",
+                        "name": "event_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (sent, failed)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of notifications per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of notifications",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/domain.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/notification.Notification"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "get": {
+                "description": "Get the products of the catalog, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "List loan products",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial, case-insensitive)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of products per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of products",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/domain.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/product.LoanProduct"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a product to the catalog. Loans are proposed from a product, within its amount, rate and ROI ranges and with one of its tenors.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Create a loan product",
+                "parameters": [
+                    {
+                        "description": "Product information",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.LoanProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Product created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.LoanProduct"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get a loan product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.LoanProduct"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the details of a product. The loans already proposed from it keep their amount, rates and terms.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Update a loan product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Product information",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.LoanProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.LoanProduct"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a product from the catalog, no loan can be proposed from it anymore. The loans already proposed from it keep its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Delete a loan product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "description": "Get the webhook subscriptions, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhook subscriptions",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Filter by active flag",
+                        "name": "active",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of subscriptions per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of subscriptions",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/domain.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/webhook.Subscription"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register a URL to receive loan events. Every delivery is signed with HMAC-SHA256 over \"\u003cX-Webhook-Timestamp\u003e.\u003cbody\u003e\" using the subscription secret, sent in the X-Webhook-Signature header as \"sha256=\u003chex\u003e\". The secret is only returned here.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Create a webhook subscription",
+                "parameters": [
+                    {
+                        "description": "Subscription information",
+                        "name": "subscription",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateWebhookSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Subscription created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.WebhookSubscriptionCreatedResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/deliveries/{id}/redeliver": {
+            "post": {
+                "description": "Send the payload of a past delivery again right away. A new delivery is recorded and retried with backoff if it fails.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Redeliver a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Redelivery attempted",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/webhook.Delivery"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "delete": {
+                "description": "Stop sending events to a subscription. Its delivery log is kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Deactivate a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Subscription deactivated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/webhook.Subscription"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Subscription not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "description": "Get the delivery log of a subscription, newest first, with the response code and error of the last attempt",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (pending, succeeded, failed, abandoned)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by event type",
+                        "name": "event_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of deliveries per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of deliveries",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/domain.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/webhook.Delivery"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.APIResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "audit.Entry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "create or the state machine event, e.g. approve",
+                    "type": "string"
+                },
+                "actor": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "loan_id": {
+                    "type": "string"
+                },
+                "outcome": {
+                    "$ref": "#/definitions/audit.Outcome"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "description": "Status after the attempt, equal to FromStatus on failure",
+                    "type": "string"
+                }
+            }
+        },
+        "audit.Outcome": {
+            "type": "string",
+            "enum": [
+                "success",
+                "failure"
+            ],
+            "x-enum-varnames": [
+                "OutcomeSuccess",
+                "OutcomeFailure"
+            ]
+        },
+        "borrower.Borrower": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "credit_limit": {
+                    "description": "Maximum outstanding loan amount, no limit when nil",
+                    "type": "number"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
@@ -562,6 +2272,23 @@ const docTemplate = `{
                 }
             }
         },
+        "document.Document": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
         "domain.PaginatedResponse": {
             "type": "object",
             "properties": {
@@ -588,54 +2315,514 @@ const docTemplate = `{
                 }
             }
         },
-        "employee.Employee": {
+        "employee.Employee": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "id_number": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "importer.Result": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/importer.RowError"
+                    }
+                },
+                "imported": {
+                    "description": "Always 0 on a dry run",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "type": "integer"
+                },
+                "valid_rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "importer.RowError": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "interest.DayCount": {
+            "type": "string",
+            "enum": [
+                "30/360",
+                "ACT/365"
+            ],
+            "x-enum-comments": {
+                "DayCount30360": "Every month counts 30 days and the year 360 (bond basis)",
+                "DayCountActual365": "Actual days over a 365 day year"
+            },
+            "x-enum-varnames": [
+                "DayCount30360",
+                "DayCountActual365"
+            ]
+        },
+        "interest.Method": {
+            "type": "string",
+            "enum": [
+                "flat",
+                "annuity",
+                "declining_balance"
+            ],
+            "x-enum-comments": {
+                "MethodAnnuity": "Monthly rate on the outstanding balance, equal installments",
+                "MethodDecliningBalance": "On the outstanding balance, equal principal repayments",
+                "MethodFlat": "On the original principal for the whole tenor, equal installments"
+            },
+            "x-enum-varnames": [
+                "MethodFlat",
+                "MethodAnnuity",
+                "MethodDecliningBalance"
+            ]
+        },
+        "lender.Lender": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "fullName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "idnumber": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "loan.BorrowerExposure": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "Remaining credit, nil when the borrower has no limit",
+                    "type": "number"
+                },
+                "borrower_id": {
+                    "type": "string"
+                },
+                "by_status": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/loan.StatusAmount"
+                    }
+                },
+                "credit_limit": {
+                    "type": "number"
+                },
+                "outstanding": {
+                    "type": "number"
+                }
+            }
+        },
+        "loan.Loan": {
+            "type": "object",
+            "properties": {
+                "agreement_document": {
+                    "$ref": "#/definitions/document.Document"
+                },
+                "agreement_document_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "approval_date": {
+                    "type": "string"
+                },
+                "approved_by": {
+                    "type": "string"
+                },
+                "borrower_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "day_count": {
+                    "$ref": "#/definitions/interest.DayCount"
+                },
+                "disbursed_by": {
+                    "type": "string"
+                },
+                "disbursement_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "interest_method": {
+                    "$ref": "#/definitions/interest.Method"
+                },
+                "investment_date": {
+                    "type": "string"
+                },
+                "product_id": {
+                    "description": "Unset on the loans proposed before the product catalog",
+                    "type": "string"
+                },
+                "rate": {
+                    "description": "Annual interest rate paid by the borrower, in percent",
+                    "type": "number"
+                },
+                "roi": {
+                    "description": "Annual return paid to the investors, in percent",
+                    "type": "number"
+                },
+                "status": {
+                    "$ref": "#/definitions/loan.Status"
+                },
+                "status_transitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/loan.StatusTransition"
+                    }
+                },
+                "survey_document": {
+                    "$ref": "#/definitions/document.Document"
+                },
+                "survey_document_id": {
+                    "type": "string"
+                },
+                "tenor_months": {
+                    "type": "integer"
+                },
+                "total_interest": {
+                    "description": "Interest over the tenor, projected from the creation until disbursed",
+                    "type": "number"
+                },
+                "total_repayment": {
+                    "description": "Principal plus interest",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "loan.Statement": {
+            "type": "object",
+            "properties": {
+                "borrower_id": {
+                    "type": "string"
+                },
+                "loans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/loan.StatementLine"
+                    }
+                },
+                "total_disbursed": {
+                    "type": "number"
+                },
+                "total_loans": {
+                    "type": "integer"
+                },
+                "total_repayment_due": {
+                    "description": "Repayment of the disbursed loans",
+                    "type": "number"
+                }
+            }
+        },
+        "loan.StatementLine": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "approval_date": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "disbursement_date": {
+                    "type": "string"
+                },
+                "loan_id": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "status": {
+                    "$ref": "#/definitions/loan.Status"
+                },
+                "total_repayment": {
+                    "description": "Principal plus interest the borrower repays once disbursed",
+                    "type": "number"
+                }
+            }
+        },
+        "loan.Status": {
+            "type": "string",
+            "enum": [
+                "proposed",
+                "approved",
+                "invested",
+                "disbursed",
+                "rejected",
+                "cancelled",
+                "expired"
+            ],
+            "x-enum-varnames": [
+                "StatusProposed",
+                "StatusApproved",
+                "StatusInvested",
+                "StatusDisbursed",
+                "StatusRejected",
+                "StatusCancelled",
+                "StatusExpired"
+            ]
+        },
+        "loan.StatusAmount": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/loan.Status"
+                }
+            }
+        },
+        "loan.StatusTransition": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "from": {
+                    "$ref": "#/definitions/loan.Status"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "loan_id": {
+                    "type": "string"
+                },
+                "performed_by": {
+                    "type": "string"
+                },
+                "to": {
+                    "$ref": "#/definitions/loan.Status"
+                }
+            }
+        },
+        "loanlender.Portfolio": {
+            "type": "object",
+            "properties": {
+                "by_status": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/loanlender.PortfolioStatus"
+                    }
+                },
+                "expected_return": {
+                    "type": "number"
+                },
+                "lender_id": {
+                    "type": "string"
+                },
+                "positions": {
+                    "$ref": "#/definitions/domain.PaginatedResponse"
+                },
+                "released": {
+                    "description": "Invested in cancelled or expired loans, not part of the totals",
+                    "type": "number"
+                },
+                "total_invested": {
+                    "type": "number"
+                }
+            }
+        },
+        "loanlender.PortfolioStatus": {
+            "type": "object",
+            "properties": {
+                "expected_return": {
+                    "type": "number"
+                },
+                "invested": {
+                    "type": "number"
+                },
+                "loans": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "notification.Notification": {
             "type": "object",
             "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "channel": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
                 "email": {
                     "type": "string"
                 },
-                "full_name": {
+                "error": {
+                    "type": "string"
+                },
+                "event_type": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "id_number": {
+                "loan_id": {
                     "type": "string"
                 },
-                "phone_number": {
+                "message_id": {
+                    "description": "Outbox message that triggered the notification",
                     "type": "string"
                 },
-                "updated_at": {
+                "recipient_id": {
+                    "type": "string"
+                },
+                "recipient_type": {
+                    "$ref": "#/definitions/notification.RecipientType"
+                },
+                "status": {
+                    "$ref": "#/definitions/notification.Status"
+                },
+                "subject": {
                     "type": "string"
                 }
             }
         },
-        "lender.Lender": {
+        "notification.RecipientType": {
+            "type": "string",
+            "enum": [
+                "borrower",
+                "lender"
+            ],
+            "x-enum-varnames": [
+                "RecipientBorrower",
+                "RecipientLender"
+            ]
+        },
+        "notification.Status": {
+            "type": "string",
+            "enum": [
+                "sent",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "StatusSent",
+                "StatusFailed"
+            ]
+        },
+        "product.LoanProduct": {
             "type": "object",
             "properties": {
-                "createdAt": {
+                "created_at": {
                     "type": "string"
                 },
-                "email": {
+                "id": {
                     "type": "string"
                 },
-                "fullName": {
-                    "type": "string"
+                "interest_method": {
+                    "$ref": "#/definitions/interest.Method"
                 },
-                "id": {
-                    "type": "string"
+                "max_amount": {
+                    "type": "number"
                 },
-                "idnumber": {
-                    "type": "string"
+                "max_rate": {
+                    "type": "number"
                 },
-                "phoneNumber": {
+                "max_roi": {
+                    "type": "number"
+                },
+                "min_amount": {
+                    "type": "number"
+                },
+                "min_rate": {
+                    "description": "Annual interest rate paid by the borrower, in percent",
+                    "type": "number"
+                },
+                "min_roi": {
+                    "description": "Annual return paid to the investors, in percent",
+                    "type": "number"
+                },
+                "name": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "required_documents": {
+                    "description": "e.g. id_card or payslip, for the field officers to collect; informational, not checked at approval",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tenor_months": {
+                    "description": "Tenors offered, the first one being the default",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
@@ -649,6 +2836,10 @@ const docTemplate = `{
                 "phoneNumber"
             ],
             "properties": {
+                "creditLimit": {
+                    "type": "number",
+                    "minimum": 0
+                },
                 "email": {
                     "type": "string"
                 },
@@ -714,6 +2905,7 @@ const docTemplate = `{
             "required": [
                 "amount",
                 "borrowerId",
+                "productId",
                 "rate",
                 "roi"
             ],
@@ -724,14 +2916,148 @@ const docTemplate = `{
                 "borrowerId": {
                     "type": "string"
                 },
+                "dayCount": {
+                    "type": "string",
+                    "enum": [
+                        "30/360",
+                        "ACT/365"
+                    ]
+                },
                 "description": {
                     "type": "string"
                 },
+                "interestMethod": {
+                    "type": "string",
+                    "enum": [
+                        "flat",
+                        "annuity",
+                        "declining_balance"
+                    ]
+                },
+                "productId": {
+                    "type": "string"
+                },
                 "rate": {
+                    "description": "Annual, in percent",
                     "type": "number"
                 },
                 "roi": {
+                    "description": "Annual, in percent",
+                    "type": "number"
+                },
+                "tenorMonths": {
+                    "description": "Interest terms, the first tenor and the interest method of the product on a 30/360 basis when unset",
+                    "type": "integer",
+                    "maximum": 360
+                }
+            }
+        },
+        "request.CreateWebhookSubscriptionRequest": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "event_types": {
+                    "description": "Empty subscribes to every event",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "description": "Generated when empty",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.LoanProductRequest": {
+            "type": "object",
+            "required": [
+                "interestMethod",
+                "maxAmount",
+                "maxRate",
+                "maxRoi",
+                "minAmount",
+                "minRate",
+                "minRoi",
+                "name",
+                "requiredDocuments",
+                "tenorMonths"
+            ],
+            "properties": {
+                "interestMethod": {
+                    "type": "string",
+                    "enum": [
+                        "flat",
+                        "annuity",
+                        "declining_balance"
+                    ]
+                },
+                "maxAmount": {
+                    "type": "number"
+                },
+                "maxRate": {
+                    "type": "number"
+                },
+                "maxRoi": {
+                    "type": "number"
+                },
+                "minAmount": {
+                    "type": "number"
+                },
+                "minRate": {
+                    "description": "Annual, in percent",
+                    "type": "number"
+                },
+                "minRoi": {
+                    "description": "Annual, in percent",
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "requiredDocuments": {
+                    "description": "Informational, not checked when a loan is approved",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tenorMonths": {
+                    "description": "The first one is the default",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "request.UpdateCreditLimitRequest": {
+            "type": "object",
+            "properties": {
+                "creditLimit": {
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
+        "request.WalletTransactionRequest": {
+            "type": "object",
+            "required": [
+                "amount"
+            ],
+            "properties": {
+                "amount": {
                     "type": "number"
+                },
+                "description": {
+                    "type": "string"
                 }
             }
         },
@@ -750,6 +3076,32 @@ const docTemplate = `{
                 }
             }
         },
+        "response.WebhookSubscriptionCreatedResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "swagger.ApproveSchema": {
             "type": "object",
             "properties": {
@@ -767,6 +3119,19 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.CancelSchema": {
+            "type": "object",
+            "properties": {
+                "cancelled_by": {
+                    "type": "string",
+                    "example": "emp-123"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Borrower withdrew the application"
+                }
+            }
+        },
         "swagger.DisburseSchema": {
             "type": "object",
             "properties": {
@@ -792,6 +3157,188 @@ const docTemplate = `{
                     "example": "lender-456"
                 }
             }
+        },
+        "swagger.RejectSchema": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "Incomplete survey"
+                },
+                "rejected_by": {
+                    "type": "string",
+                    "example": "emp-123"
+                }
+            }
+        },
+        "wallet.Transaction": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "balance_after": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lender_id": {
+                    "type": "string"
+                },
+                "loan_id": {
+                    "type": "string"
+                },
+                "reserved_after": {
+                    "type": "number"
+                },
+                "type": {
+                    "$ref": "#/definitions/wallet.TransactionType"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "wallet.TransactionType": {
+            "type": "string",
+            "enum": [
+                "deposit",
+                "withdraw",
+                "reserve",
+                "release",
+                "debit"
+            ],
+            "x-enum-varnames": [
+                "TransactionDeposit",
+                "TransactionWithdraw",
+                "TransactionReserve",
+                "TransactionRelease",
+                "TransactionDebit"
+            ]
+        },
+        "wallet.Wallet": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lender_id": {
+                    "type": "string"
+                },
+                "reserved": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "webhook.Delivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "message_id": {
+                    "description": "Outbox message the delivery was created from",
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "redelivery_of": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/webhook.DeliveryStatus"
+                },
+                "subscription_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "webhook.DeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "succeeded",
+                "failed",
+                "abandoned"
+            ],
+            "x-enum-comments": {
+                "DeliveryStatusAbandoned": "Not sent, the subscription was deactivated",
+                "DeliveryStatusFailed": "Gave up after MaxAttempts"
+            },
+            "x-enum-varnames": [
+                "DeliveryStatusPending",
+                "DeliveryStatusSucceeded",
+                "DeliveryStatusFailed",
+                "DeliveryStatusAbandoned"
+            ]
+        },
+        "webhook.Subscription": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "description": "Empty subscribes to every event",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
@@ -1,8 +1,13 @@
 package request
 
 type CreateBorrowerRequest struct {
-	FullName    string `json:"fullName" validate:"required"`
-	Email       string `json:"email" validate:"required,email"`
-	PhoneNumber string `json:"phoneNumber" validate:"required"`
-	IDNumber    string `json:"idNumber" validate:"required"`
+	FullName    string   `json:"fullName" validate:"required"`
+	Email       string   `json:"email" validate:"required,email"`
+	PhoneNumber string   `json:"phoneNumber" validate:"required"`
+	IDNumber    string   `json:"idNumber" validate:"required"`
+	CreditLimit *float64 `json:"creditLimit" validate:"omitempty,gte=0"`
+}
+
+type UpdateCreditLimitRequest struct {
+	CreditLimit *float64 `json:"creditLimit" validate:"omitempty,gte=0"`
 }
@@ -1,11 +1,17 @@
 package request
 
+// CreateLoanRequest proposes a loan from a product, which bounds the amount, the rates and the terms
 type CreateLoanRequest struct {
 	BorrowerID  string  `json:"borrowerId" validate:"required,uuid"`
+	ProductID   string  `json:"productId" validate:"required,uuid"`
 	Amount      float64 `json:"amount" validate:"required,gt=0"`
-	Rate        float64 `json:"rate" validate:"required,gt=0,lt=100"`
-	ROI         float64 `json:"roi" validate:"required,gt=0,lt=100,roiLessThanRate"`
+	Rate        float64 `json:"rate" validate:"required"` // Annual, in percent
+	ROI         float64 `json:"roi" validate:"required"`  // Annual, in percent
 	Description string  `json:"description"`
+	// Interest terms, the first tenor and the interest method of the product on a 30/360 basis when unset
+	TenorMonths    int    `json:"tenorMonths" validate:"omitempty,gt=0,lte=360"`
+	InterestMethod string `json:"interestMethod" validate:"omitempty,oneof=flat annuity declining_balance"`
+	DayCount       string `json:"dayCount" validate:"omitempty,oneof=30/360 ACT/365"`
 }
 
 type ApprovalRequest struct {
@@ -23,4 +29,9 @@ type StatusUpdateRequest struct {
 	// disburse
 	FieldOfficerID    string `json:"field_officer_id" validate:"required"`
 	AgreementFileName string `json:"agreement_file_name" validate:"required"`
+	// reject
+	RejectedBy string `json:"rejected_by" validate:"required"`
+	// cancel
+	CancelledBy string `json:"cancelled_by" validate:"required"`
+	Reason      string `json:"reason" validate:"required"` // Rejection or cancellation reason
 }
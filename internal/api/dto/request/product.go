@@ -0,0 +1,15 @@
+package request
+
+// LoanProductRequest creates a loan product or replaces its details
+type LoanProductRequest struct {
+	Name              string   `json:"name" validate:"required,max=100"`
+	MinAmount         float64  `json:"minAmount" validate:"required,gt=0"`
+	MaxAmount         float64  `json:"maxAmount" validate:"required,gtefield=MinAmount"`
+	MinRate           float64  `json:"minRate" validate:"required,gt=0,lt=100"` // Annual, in percent
+	MaxRate           float64  `json:"maxRate" validate:"required,gtefield=MinRate,lt=100"`
+	MinROI            float64  `json:"minRoi" validate:"required,gt=0,lt=100"` // Annual, in percent
+	MaxROI            float64  `json:"maxRoi" validate:"required,gtefield=MinROI,lt=100"`
+	TenorMonths       []int    `json:"tenorMonths" validate:"required,min=1,dive,gt=0,lte=360"` // The first one is the default
+	InterestMethod    string   `json:"interestMethod" validate:"required,oneof=flat annuity declining_balance"`
+	RequiredDocuments []string `json:"requiredDocuments" validate:"dive,required"` // Informational, not checked when a loan is approved
+}
@@ -0,0 +1,6 @@
+package request
+
+type WalletTransactionRequest struct {
+	Amount      float64 `json:"amount" validate:"required,gt=0"`
+	Description string  `json:"description"`
+}
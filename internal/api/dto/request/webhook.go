@@ -0,0 +1,7 @@
+package request
+
+type CreateWebhookSubscriptionRequest struct {
+	URL        string   `json:"url" validate:"required,url"`
+	EventTypes []string `json:"event_types"` // Empty subscribes to every event
+	Secret     string   `json:"secret"`      // Generated when empty
+}
@@ -30,9 +30,19 @@ type LoanLenderResponse struct {
 }
 
 type DisbursementResponse struct {
-	DisbursementDate  time.Time `json:"disbursement_date"`
-	DisbursedBy       string    `json:"disbursed_by"`
-	AgreementDocument *string   `json:"agreement_document"`
-	BorrowerRepayment float64   `json:"borrower_repayment"`
-	InvestorROI       float64   `json:"investor_roi"`
+	DisbursementDate  time.Time        `json:"disbursement_date"`
+	DisbursedBy       string           `json:"disbursed_by"`
+	AgreementDocument *string          `json:"agreement_document"`
+	BorrowerRepayment float64          `json:"borrower_repayment"`
+	InvestorROI       float64          `json:"investor_roi"` // Total paid out to the investors
+	Investors         []InvestorPayout `json:"investors"`
+}
+
+// InvestorPayout is what an investment in a disbursed loan returns to its lender
+type InvestorPayout struct {
+	InvestmentID   string  `json:"investment_id"`
+	LenderID       string  `json:"lender_id"`
+	Amount         float64 `json:"amount"`
+	ExpectedReturn float64 `json:"expected_return"`
+	Payout         float64 `json:"payout"`
 }
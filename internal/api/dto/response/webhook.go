@@ -0,0 +1,13 @@
+package response
+
+import "time"
+
+// WebhookSubscriptionCreatedResponse is the only response revealing the signing secret
+type WebhookSubscriptionCreatedResponse struct {
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Secret     string    `json:"secret"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -27,6 +27,18 @@ type DisburseSchema struct {
 	AgreementFileName string `json:"agreement_file_name" example:"loan_agreement.pdf"`
 }
 
+// RejectSchema defines the request structure for loan rejection (status=reject)
+type RejectSchema struct {
+	RejectedBy string `json:"rejected_by" example:"emp-123"`
+	Reason     string `json:"reason" example:"Incomplete survey"`
+}
+
+// CancelSchema defines the request structure for loan cancellation (status=cancel)
+type CancelSchema struct {
+	CancelledBy string `json:"cancelled_by" example:"emp-123"`
+	Reason      string `json:"reason" example:"Borrower withdrew the application"`
+}
+
 type SuccessResponse struct {
 	Success bool   `json:"success" example:"true"`
 	Message string `json:"message" example:"Loan status updated successfully"`
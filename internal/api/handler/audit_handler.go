@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
+)
+
+type AuditHandler struct {
+	auditService *audit.AuditService
+}
+
+func NewAuditHandler(auditService *audit.AuditService) *AuditHandler {
+	return &AuditHandler{
+		auditService: auditService,
+	}
+}
+
+// ListEntries godoc
+// @Summary List audit log entries
+// @Description Get every attempted loan action, successful or refused, newest first, with optional filtering
+// @Tags audit
+// @Accept json
+// @Produce json
+// @Param loan_id query string false "Filter by loan ID"
+// @Param actor query string false "Filter by actor (employee, lender or borrower ID, or system)"
+// @Param action query string false "Filter by action (create, approve, invest, disburse, cancel, expire)"
+// @Param outcome query string false "Filter by outcome (success, failure)"
+// @Param request_id query string false "Filter by API request ID"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Number of entries per page"
+// @Success 200 {object} domain.PaginatedResponse{data=[]audit.Entry} "List of audit log entries"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /audit [get]
+func (h *AuditHandler) ListEntries(c echo.Context) error {
+	loanID := c.QueryParam("loan_id")
+	actor := c.QueryParam("actor")
+	action := c.QueryParam("action")
+	outcome := audit.Outcome(c.QueryParam("outcome"))
+	requestID := c.QueryParam("request_id")
+
+	filter := audit.AuditFilter{
+		LoanID:    &loanID,
+		Actor:     &actor,
+		Action:    &action,
+		Outcome:   &outcome,
+		RequestID: &requestID,
+		Page:      queryInt(c, "page"),
+		PageSize:  queryInt(c, "page_size"),
+	}
+
+	entries, err := h.auditService.ListEntries(c.Request().Context(), filter)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, entries)
+}
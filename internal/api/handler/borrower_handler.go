@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-playground/validator/v10"
@@ -8,16 +9,19 @@ import (
 	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/request"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
 )
 
 type BorrowerHandler struct {
 	borrowerService *borrower.BorrowerService
+	loanService     *loan.LoanService
 	validate        *validator.Validate
 }
 
-func NewBorrowerHandler(borrowerService *borrower.BorrowerService, validate *validator.Validate) *BorrowerHandler {
+func NewBorrowerHandler(borrowerService *borrower.BorrowerService, loanService *loan.LoanService, validate *validator.Validate) *BorrowerHandler {
 	return &BorrowerHandler{
 		borrowerService: borrowerService,
+		loanService:     loanService,
 		validate:        validate,
 	}
 }
@@ -31,6 +35,7 @@ func NewBorrowerHandler(borrowerService *borrower.BorrowerService, validate *val
 // @Param borrower body request.CreateBorrowerRequest true "Borrower information"
 // @Success 201 {object} response.APIResponse{data=borrower.Borrower} "Borrower created successfully"
 // @Failure 400 {object} response.APIResponse "Invalid request or validation error"
+// @Failure 409 {object} response.APIResponse "Email or ID number already registered"
 // @Router /borrowers [post]
 func (h *BorrowerHandler) CreateBorrower(c echo.Context) error {
 	var req request.CreateBorrowerRequest
@@ -46,12 +51,15 @@ func (h *BorrowerHandler) CreateBorrower(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, response.Error(errorsMsg))
 	}
 
-	borrower, err := h.borrowerService.CreateBorrower(c.Request().Context(), req.FullName, req.Email, req.PhoneNumber, req.IDNumber)
+	borrowerEntity, err := h.borrowerService.CreateBorrower(c.Request().Context(), req.FullName, req.Email, req.PhoneNumber, req.IDNumber, req.CreditLimit)
 	if err != nil {
+		if errors.Is(err, borrower.ErrEmailTaken) || errors.Is(err, borrower.ErrIDNumberTaken) {
+			return c.JSON(http.StatusConflict, response.Error(err.Error()))
+		}
 		return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
 	}
 
-	return c.JSON(http.StatusCreated, response.Success(borrower, "Borrower created successfully"))
+	return c.JSON(http.StatusCreated, response.Success(borrowerEntity, "Borrower created successfully"))
 }
 
 // ListBorrowers godoc
@@ -60,8 +68,9 @@ func (h *BorrowerHandler) CreateBorrower(c echo.Context) error {
 // @Tags borrowers
 // @Accept json
 // @Produce json
-// @Param full_name query string false "Filter by full name"
-// @Param email query string false "Filter by email"
+// @Param q query string false "Search by name, email, phone number or ID number (partial, case-insensitive)"
+// @Param full_name query string false "Filter by full name (partial, case-insensitive)"
+// @Param email query string false "Filter by email (case-insensitive)"
 // @Param phone_number query string false "Filter by phone number"
 // @Param id_number query string false "Filter by ID number"
 // @Success 200 {object} domain.PaginatedResponse{data=[]borrower.Borrower} "List of borrowers"
@@ -73,12 +82,14 @@ func (h *BorrowerHandler) ListBorrowers(c echo.Context) error {
 	email := c.QueryParam("email")
 	phoneNumber := c.QueryParam("phone_number")
 	idNumber := c.QueryParam("id_number")
+	q := c.QueryParam("q")
 
 	filter := borrower.BorrowerFilter{
 		FullName:    &fullName,
 		Email:       &email,
 		PhoneNumber: &phoneNumber,
 		IDNumber:    &idNumber,
+		Query:       &q,
 	}
 
 	borrowers, err := h.borrowerService.ListBorrowers(c.Request().Context(), filter)
@@ -88,3 +99,116 @@ func (h *BorrowerHandler) ListBorrowers(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, borrowers)
 }
+
+// UpdateCreditLimit godoc
+// @Summary Update borrower credit limit
+// @Description Set the maximum outstanding loan amount of a borrower. Omit creditLimit to remove the limit.
+// @Tags borrowers
+// @Accept json
+// @Produce json
+// @Param id path string true "Borrower ID"
+// @Param creditLimit body request.UpdateCreditLimitRequest true "Credit limit"
+// @Success 200 {object} response.APIResponse{data=borrower.Borrower} "Credit limit updated successfully"
+// @Failure 400 {object} response.APIResponse "Invalid request or validation error"
+// @Failure 404 {object} response.APIResponse "Borrower not found"
+// @Router /borrowers/{id}/credit-limit [put]
+func (h *BorrowerHandler) UpdateCreditLimit(c echo.Context) error {
+	var req request.UpdateCreditLimitRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.Error("Invalid request"))
+	}
+
+	// Validate request
+	if err := h.validate.Struct(req); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		errorsMsg := formatValidationErrors(validationErrors)
+		return c.JSON(http.StatusBadRequest, response.Error(errorsMsg))
+	}
+
+	borrowerEntity, err := h.borrowerService.UpdateCreditLimit(c.Request().Context(), c.Param("id"), req.CreditLimit)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, response.Success(borrowerEntity, "Credit limit updated successfully"))
+}
+
+// GetExposure godoc
+// @Summary Get borrower exposure
+// @Description Get the number and amount of a borrower's loans per status, the outstanding amount (loans not yet disbursed, rejected, cancelled or expired) and the remaining credit
+// @Tags borrowers
+// @Accept json
+// @Produce json
+// @Param id path string true "Borrower ID"
+// @Success 200 {object} response.APIResponse{data=loan.BorrowerExposure} "Borrower exposure"
+// @Failure 404 {object} response.APIResponse "Borrower not found"
+// @Router /borrowers/{id}/exposure [get]
+func (h *BorrowerHandler) GetExposure(c echo.Context) error {
+	exposure, err := h.loanService.GetBorrowerExposure(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, response.Success(exposure))
+}
+
+// ListLoans godoc
+// @Summary List borrower loans
+// @Description Get the loans of a borrower, newest first, with optional status filtering
+// @Tags borrowers
+// @Accept json
+// @Produce json
+// @Param id path string true "Borrower ID"
+// @Param status query string false "Filter by loan status"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Number of loans per page"
+// @Success 200 {object} domain.PaginatedResponse{data=[]loan.Loan} "List of loans"
+// @Failure 404 {object} response.APIResponse "Borrower not found"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /borrowers/{id}/loans [get]
+func (h *BorrowerHandler) ListLoans(c echo.Context) error {
+	borrowerID := c.Param("id")
+
+	if _, err := h.borrowerService.GetByID(c.Request().Context(), borrowerID); err != nil {
+		return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+	}
+
+	status := loan.Status(c.QueryParam("status"))
+
+	filter := loan.LoanFilter{
+		BorrowerID: &borrowerID,
+		Status:     &status,
+		Page:       queryInt(c, "page"),
+		PageSize:   queryInt(c, "page_size"),
+	}
+
+	loans, err := h.loanService.ListLoans(c.Request().Context(), filter)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, loans)
+}
+
+// GetStatement godoc
+// @Summary Get borrower statement
+// @Description Get every loan of a borrower with its status, amount, rate, disbursement date and total repayment, and the total repayment due on disbursed loans
+// @Tags borrowers
+// @Accept json
+// @Produce json
+// @Param id path string true "Borrower ID"
+// @Success 200 {object} response.APIResponse{data=loan.Statement} "Borrower statement"
+// @Failure 404 {object} response.APIResponse "Borrower not found"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /borrowers/{id}/statement [get]
+func (h *BorrowerHandler) GetStatement(c echo.Context) error {
+	statement, err := h.loanService.GetBorrowerStatement(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, borrower.ErrBorrowerNotFound) {
+			return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+		}
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, response.Success(statement))
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-playground/validator/v10"
@@ -31,6 +32,7 @@ func NewEmployeeHandler(employeeService *employee.EmployeeService, validate *val
 // @Param employee body request.CreateEmployeeRequest true "Employee information"
 // @Success 201 {object} response.APIResponse{data=employee.Employee} "Employee created successfully"
 // @Failure 400 {object} response.APIResponse "Invalid request or validation error"
+// @Failure 409 {object} response.APIResponse "Email or ID number already registered"
 // @Router /employees [post]
 func (h *EmployeeHandler) CreateEmployee(c echo.Context) error {
 	var req request.CreateEmployeeRequest
@@ -46,12 +48,15 @@ func (h *EmployeeHandler) CreateEmployee(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, response.Error(errorsMsg))
 	}
 
-	employee, err := h.employeeService.CreateEmployee(c.Request().Context(), req.FullName, req.Email, req.PhoneNumber, req.IDNumber)
+	employeeEntity, err := h.employeeService.CreateEmployee(c.Request().Context(), req.FullName, req.Email, req.PhoneNumber, req.IDNumber)
 	if err != nil {
+		if errors.Is(err, employee.ErrEmailTaken) || errors.Is(err, employee.ErrIDNumberTaken) {
+			return c.JSON(http.StatusConflict, response.Error(err.Error()))
+		}
 		return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
 	}
 
-	return c.JSON(http.StatusCreated, response.Success(employee, "Employee created successfully"))
+	return c.JSON(http.StatusCreated, response.Success(employeeEntity, "Employee created successfully"))
 }
 
 // ListEmployees godoc
@@ -60,8 +65,9 @@ func (h *EmployeeHandler) CreateEmployee(c echo.Context) error {
 // @Tags employees
 // @Accept json
 // @Produce json
-// @Param full_name query string false "Filter by full name"
-// @Param email query string false "Filter by email"
+// @Param q query string false "Search by name, email, phone number or ID number (partial, case-insensitive)"
+// @Param full_name query string false "Filter by full name (partial, case-insensitive)"
+// @Param email query string false "Filter by email (case-insensitive)"
 // @Param phone_number query string false "Filter by phone number"
 // @Param id_number query string false "Filter by ID number"
 // @Success 200 {object} domain.PaginatedResponse{data=[]employee.Employee} "List of employees"
@@ -73,12 +79,14 @@ func (h *EmployeeHandler) ListEmployees(c echo.Context) error {
 	email := c.QueryParam("email")
 	phoneNumber := c.QueryParam("phone_number")
 	idNumber := c.QueryParam("id_number")
+	q := c.QueryParam("q")
 
 	filter := employee.EmployeeFilter{
 		FullName:    &fullName,
 		Email:       &email,
 		PhoneNumber: &phoneNumber,
 		IDNumber:    &idNumber,
+		Query:       &q,
 	}
 
 	employees, err := h.employeeService.ListEmployees(c.Request().Context(), filter)
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/health"
+)
+
+// HealthHandler serves the probes of the orchestrator. They are mounted at the root, outside
+// the versioned API, and are not part of the API documentation.
+type HealthHandler struct {
+	checker *health.Checker
+}
+
+func NewHealthHandler(checker *health.Checker) *HealthHandler {
+	return &HealthHandler{
+		checker: checker,
+	}
+}
+
+// Healthz answers as long as the process serves HTTP, without looking at its dependencies
+func (h *HealthHandler) Healthz(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]string{"status": health.StatusOK})
+}
+
+// Readyz answers 503 Service Unavailable when the service should not receive traffic, with
+// the result of every check
+func (h *HealthHandler) Readyz(c echo.Context) error {
+	report := h.checker.Ready(c.Request().Context())
+	if !report.Ready() {
+		return c.JSON(http.StatusServiceUnavailable, report)
+	}
+	return c.JSON(http.StatusOK, report)
+}
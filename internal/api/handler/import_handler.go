@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/importer"
+)
+
+type ImportHandler struct {
+	importService *importer.ImportService
+}
+
+func NewImportHandler(importService *importer.ImportService) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+	}
+}
+
+// ImportBorrowers godoc
+// @Summary Import borrowers from a CSV file
+// @Description Create borrowers from a CSV file with the columns full_name, email, phone_number, id_number and optionally credit_limit.
+// @Description Rows are validated like a single borrower creation, invalid rows are reported with their errors and the valid ones inserted.
+// @Tags borrowers
+// @Accept multipart/form-data
+// @Accept text/csv
+// @Produce json
+// @Param file formData file false "CSV file, or send it as the text/csv request body"
+// @Param dry_run query bool false "Only validate the rows, nothing is inserted"
+// @Success 200 {object} response.APIResponse{data=importer.Result} "Import result with the errors per row"
+// @Failure 400 {object} response.APIResponse "Unreadable file or missing columns"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /borrowers/import [post]
+func (h *ImportHandler) ImportBorrowers(c echo.Context) error {
+	return h.handleImport(c, "borrowers", h.importService.ImportBorrowers)
+}
+
+// ImportLenders godoc
+// @Summary Import lenders from a CSV file
+// @Description Create lenders from a CSV file with the columns full_name, email, phone_number and id_number.
+// @Description Rows are validated like a single lender creation, invalid rows are reported with their errors and the valid ones inserted.
+// @Tags lenders
+// @Accept multipart/form-data
+// @Accept text/csv
+// @Produce json
+// @Param file formData file false "CSV file, or send it as the text/csv request body"
+// @Param dry_run query bool false "Only validate the rows, nothing is inserted"
+// @Success 200 {object} response.APIResponse{data=importer.Result} "Import result with the errors per row"
+// @Failure 400 {object} response.APIResponse "Unreadable file or missing columns"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /lenders/import [post]
+func (h *ImportHandler) ImportLenders(c echo.Context) error {
+	return h.handleImport(c, "lenders", h.importService.ImportLenders)
+}
+
+// ImportEmployees godoc
+// @Summary Import employees from a CSV file
+// @Description Create employees from a CSV file with the columns full_name, email, phone_number and id_number.
+// @Description Rows are validated like a single employee creation, invalid rows are reported with their errors and the valid ones inserted.
+// @Tags employees
+// @Accept multipart/form-data
+// @Accept text/csv
+// @Produce json
+// @Param file formData file false "CSV file, or send it as the text/csv request body"
+// @Param dry_run query bool false "Only validate the rows, nothing is inserted"
+// @Success 200 {object} response.APIResponse{data=importer.Result} "Import result with the errors per row"
+// @Failure 400 {object} response.APIResponse "Unreadable file or missing columns"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /employees/import [post]
+func (h *ImportHandler) ImportEmployees(c echo.Context) error {
+	return h.handleImport(c, "employees", h.importService.ImportEmployees)
+}
+
+type importFunc func(ctx context.Context, r io.Reader, dryRun bool) (*importer.Result, error)
+
+func (h *ImportHandler) handleImport(c echo.Context, parties string, importRows importFunc) error {
+	dryRun := false
+	if value := c.QueryParam("dry_run"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, response.Error("invalid dry_run"))
+		}
+		dryRun = parsed
+	}
+
+	file, err := importFile(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
+	}
+	defer file.Close()
+
+	result, err := importRows(c.Request().Context(), file, dryRun)
+	if err != nil {
+		if errors.Is(err, importer.ErrInvalidFile) {
+			return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
+		}
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	message := fmt.Sprintf("%d of %d %s imported", result.Imported, result.TotalRows, parties)
+	if dryRun {
+		message = fmt.Sprintf("%d of %d %s valid, nothing imported", result.ValidRows, result.TotalRows, parties)
+	}
+	return c.JSON(http.StatusOK, response.Success(result, message))
+}
+
+// importFile returns the uploaded "file" form field, or the request body when it is sent as is
+func importFile(c echo.Context) (io.ReadCloser, error) {
+	if !strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		return c.Request().Body, nil
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		return nil, errors.New("file is required")
+	}
+
+	return header.Open()
+}
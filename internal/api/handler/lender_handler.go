@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-playground/validator/v10"
@@ -8,17 +9,20 @@ import (
 	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/request"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
 )
 
 type LenderHandler struct {
-	lenderService *lender.LenderService
-	validate      *validator.Validate
+	lenderService     *lender.LenderService
+	loanLenderService *loanlender.LoanLenderService
+	validate          *validator.Validate
 }
 
-func NewLenderHandler(lenderService *lender.LenderService, validate *validator.Validate) *LenderHandler {
+func NewLenderHandler(lenderService *lender.LenderService, loanLenderService *loanlender.LoanLenderService, validate *validator.Validate) *LenderHandler {
 	return &LenderHandler{
-		lenderService: lenderService,
-		validate:      validate,
+		lenderService:     lenderService,
+		loanLenderService: loanLenderService,
+		validate:          validate,
 	}
 }
 
@@ -31,6 +35,7 @@ func NewLenderHandler(lenderService *lender.LenderService, validate *validator.V
 // @Param lender body request.CreateLenderRequest true "Lender information"
 // @Success 201 {object} response.APIResponse{data=lender.Lender} "Lender created successfully"
 // @Failure 400 {object} response.APIResponse "Invalid request or validation error"
+// @Failure 409 {object} response.APIResponse "Email or ID number already registered"
 // @Router /lenders [post]
 func (h *LenderHandler) CreateLender(c echo.Context) error {
 	var req request.CreateLenderRequest
@@ -46,12 +51,15 @@ func (h *LenderHandler) CreateLender(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, response.Error(errorsMsg))
 	}
 
-	lender, err := h.lenderService.CreateLender(c.Request().Context(), req.FullName, req.Email, req.PhoneNumber, req.IDNumber)
+	lenderEntity, err := h.lenderService.CreateLender(c.Request().Context(), req.FullName, req.Email, req.PhoneNumber, req.IDNumber)
 	if err != nil {
+		if errors.Is(err, lender.ErrEmailTaken) || errors.Is(err, lender.ErrIDNumberTaken) {
+			return c.JSON(http.StatusConflict, response.Error(err.Error()))
+		}
 		return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
 	}
 
-	return c.JSON(http.StatusCreated, response.Success(lender, "Lender created successfully"))
+	return c.JSON(http.StatusCreated, response.Success(lenderEntity, "Lender created successfully"))
 }
 
 // ListLenders godoc
@@ -60,8 +68,9 @@ func (h *LenderHandler) CreateLender(c echo.Context) error {
 // @Tags lenders
 // @Accept json
 // @Produce json
-// @Param full_name query string false "Filter by full name"
-// @Param email query string false "Filter by email"
+// @Param q query string false "Search by name, email, phone number or ID number (partial, case-insensitive)"
+// @Param full_name query string false "Filter by full name (partial, case-insensitive)"
+// @Param email query string false "Filter by email (case-insensitive)"
 // @Param phone_number query string false "Filter by phone number"
 // @Param id_number query string false "Filter by ID number"
 // @Success 200 {object} domain.PaginatedResponse{data=[]lender.Lender} "List of lenders"
@@ -73,12 +82,14 @@ func (h *LenderHandler) ListLenders(c echo.Context) error {
 	email := c.QueryParam("email")
 	phoneNumber := c.QueryParam("phone_number")
 	idNumber := c.QueryParam("id_number")
+	q := c.QueryParam("q")
 
 	filter := lender.LenderFilter{
 		FullName:    &fullName,
 		Email:       &email,
 		PhoneNumber: &phoneNumber,
 		IDNumber:    &idNumber,
+		Query:       &q,
 	}
 
 	lenders, err := h.lenderService.ListLenders(c.Request().Context(), filter)
@@ -88,3 +99,37 @@ func (h *LenderHandler) ListLenders(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, lenders)
 }
+
+// GetPortfolio godoc
+// @Summary Get lender portfolio
+// @Description Get the total invested amount and expected return of a lender, broken down by loan status, with the lender's position in each loan
+// @Tags lenders
+// @Accept json
+// @Produce json
+// @Param id path string true "Lender ID"
+// @Param page query int false "Page of positions"
+// @Param page_size query int false "Number of positions per page"
+// @Success 200 {object} response.APIResponse{data=loanlender.Portfolio} "Lender portfolio"
+// @Failure 404 {object} response.APIResponse "Lender not found"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /lenders/{id}/portfolio [get]
+func (h *LenderHandler) GetPortfolio(c echo.Context) error {
+	lenderID := c.Param("id")
+
+	if _, err := h.lenderService.GetByID(c.Request().Context(), lenderID); err != nil {
+		return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+	}
+
+	filter := loanlender.PositionFilter{
+		LenderID: lenderID,
+		Page:     queryInt(c, "page"),
+		PageSize: queryInt(c, "page_size"),
+	}
+
+	portfolio, err := h.loanLenderService.GetPortfolio(c.Request().Context(), filter)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, response.Success(portfolio))
+}
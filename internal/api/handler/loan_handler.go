@@ -1,13 +1,17 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/request"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
 )
@@ -34,6 +38,8 @@ func NewLoanHandler(loanService *loan.LoanService, lenderService *lender.LenderS
 // @Produce json
 // @Param max_amount query number false "Maximum loan amount"
 // @Param min_amount query number false "Minimum loan amount"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
 // @Success 200 {object} domain.PaginatedResponse
 // @Failure 500 {object} response.APIResponse
 // @Router /loans [get]
@@ -61,6 +67,8 @@ func (h *LoanHandler) ListLoans(c echo.Context) error {
 	filter := loan.LoanFilter{
 		MaxAmount: maxAmount,
 		MinAmount: minAmount,
+		Page:      queryInt(c, "page"),
+		PageSize:  queryInt(c, "page_size"),
 	}
 
 	borrowers, err := h.loanService.ListLoans(c.Request().Context(), filter)
@@ -71,6 +79,55 @@ func (h *LoanHandler) ListLoans(c echo.Context) error {
 	return c.JSON(http.StatusOK, borrowers)
 }
 
+// ListTransitions godoc
+// @Summary List loan status transitions
+// @Description Get the status transitions of all loans, newest first, e.g. the loans approved by an employee last week
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Param loan_id query string false "Filter by loan ID"
+// @Param to query string false "Filter by the status the loan moved to"
+// @Param performed_by query string false "Filter by the ID of who performed the transition"
+// @Param since query string false "Only transitions at or after this time (RFC 3339 or YYYY-MM-DD)"
+// @Param until query string false "Only transitions before this time (RFC 3339 or YYYY-MM-DD)"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Number of transitions per page"
+// @Success 200 {object} domain.PaginatedResponse{data=[]loan.StatusTransition} "List of status transitions"
+// @Failure 400 {object} response.APIResponse "Invalid time filter"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /loans/transitions [get]
+func (h *LoanHandler) ListTransitions(c echo.Context) error {
+	loanID := c.QueryParam("loan_id")
+	to := loan.Status(c.QueryParam("to"))
+	performedBy := c.QueryParam("performed_by")
+
+	since, err := queryTime(c, "since")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.Error("since must be an RFC 3339 time or a YYYY-MM-DD date"))
+	}
+	until, err := queryTime(c, "until")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.Error("until must be an RFC 3339 time or a YYYY-MM-DD date"))
+	}
+
+	filter := loan.TransitionFilter{
+		LoanID:      &loanID,
+		To:          &to,
+		PerformedBy: &performedBy,
+		Since:       since,
+		Until:       until,
+		Page:        queryInt(c, "page"),
+		PageSize:    queryInt(c, "page_size"),
+	}
+
+	transitions, err := h.loanService.ListTransitions(c.Request().Context(), filter)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, transitions)
+}
+
 // CreateLoan godoc
 // @Summary Create a new loan
 // @Description Create a new loan with the provided details
@@ -95,7 +152,12 @@ func (h *LoanHandler) CreateLoan(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, response.Error(errorsMsg))
 	}
 
-	loan, err := h.loanService.CreateLoan(c.Request().Context(), req.BorrowerID, req.Amount, req.Rate, req.ROI)
+	terms := interest.Terms{
+		TenorMonths: req.TenorMonths,
+		Method:      interest.Method(req.InterestMethod),
+		DayCount:    interest.DayCount(req.DayCount),
+	}
+	loan, err := h.loanService.CreateLoan(c.Request().Context(), req.BorrowerID, req.ProductID, req.Amount, req.Rate, req.ROI, terms)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
 	}
@@ -110,6 +172,7 @@ func (h *LoanHandler) CreateLoan(c echo.Context) error {
 // @Description - For partial invest: { "success": true, "data": { "remaining_amount": 150000, "invested_amount": 50000, "agreement_document": null }, "message": "loan invested successfully" }
 // @Description - For full invest: { "success": true, "data": { "remaining_amount": 0, "invested_amount": 200000, "agreement_document": "agreement_file.pdf" }, "message": "loan status updated to invested" }
 // @Description - For disburse: { "success": true, "data": { "field_officer_id": "emp-789", "agreement_file_name": "agreement.pdf" }, "message": "loan disbursed successfully" }
+// @Description - For reject, cancel and expire: { "success": true, "message": "Loan status updated successfully" }
 // @Tags loans
 // @Accept json
 // @Produce json
@@ -119,27 +182,31 @@ func (h *LoanHandler) CreateLoan(c echo.Context) error {
 // @Param approveRequest body swagger.ApproveSchema false "Approve request (when status=approve)"
 // @Param investRequest body swagger.InvestSchema false "Invest request (when status=invest)"
 // @Param disburseRequest body swagger.DisburseSchema false "Disburse request (when status=disburse)"
+// @Param rejectRequest body swagger.RejectSchema false "Reject request (when status=reject)"
+// @Param cancelRequest body swagger.CancelSchema false "Cancel request (when status=cancel)"
 // @Success 200 {object} response.APIResponse "Successful status update with varying response structure based on status"
 // @Failure 400 {object} response.APIResponse "Invalid request or status transition"
 // @Router /loans/{id}/{status} [patch]
 func (h *LoanHandler) UpdateLoanStatus(c echo.Context) error {
+	ctx := c.Request().Context()
 	loanID := c.Param("id")
 	newStatus := c.Param("status")
 
 	// Validate the requested status
 	if !loan.IsValidStatus(newStatus) {
-		return c.JSON(http.StatusBadRequest, response.Error("invalid status"))
+		return h.refuseStatusUpdate(c, loanID, newStatus, "", "", errInvalidStatus)
 	}
 
 	var req request.StatusUpdateRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, response.Error("invalid request"))
+		return h.refuseStatusUpdate(c, loanID, newStatus, "", "", errInvalidRequest)
 	}
+	actor := statusUpdateActor(req, newStatus)
 
 	// Get loan with ID
-	loanEntity, entityErr := h.loanService.GetByID(c.Request().Context(), loanID)
+	loanEntity, entityErr := h.loanService.GetByID(ctx, loanID)
 	if entityErr != nil {
-		return c.JSON(http.StatusBadRequest, response.Error(entityErr.Error()))
+		return h.refuseStatusUpdate(c, loanID, newStatus, actor, "", entityErr)
 	}
 
 	var err error
@@ -147,19 +214,19 @@ func (h *LoanHandler) UpdateLoanStatus(c echo.Context) error {
 	// Handle different status transitions
 	switch newStatus {
 	case string(loan.EventApprove):
-		err = h.loanService.ApproveLoan(loanEntity, req.ApprovalEmployeeID, req.FileName)
+		err = h.loanService.ApproveLoan(ctx, loanEntity, req.ApprovalEmployeeID, req.FileName)
 	// TODO: Complete the statuses
 	case string(loan.EventInvest):
 		// check lender ID exists
 		if req.LenderID == "" {
-			return c.JSON(http.StatusBadRequest, response.Error("lender ID is required"))
+			return h.refuseStatusUpdate(c, loanID, newStatus, actor, loanEntity.Status, errLenderIDRequired)
 		}
 		// get lender
-		lender, lenderErr := h.lenderService.GetByID(c.Request().Context(), req.LenderID)
+		lender, lenderErr := h.lenderService.GetByID(ctx, req.LenderID)
 		if lenderErr != nil {
-			return c.JSON(http.StatusBadRequest, response.Error(lenderErr.Error()))
+			return h.refuseStatusUpdate(c, loanID, newStatus, actor, loanEntity.Status, lenderErr)
 		}
-		result, err := h.loanService.InvestLoan(loanEntity, lender, req.InvestAmount)
+		result, err := h.loanService.InvestLoan(ctx, loanEntity, lender, req.InvestAmount)
 		if err != nil {
 			return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
 		}
@@ -169,14 +236,20 @@ func (h *LoanHandler) UpdateLoanStatus(c echo.Context) error {
 			return c.JSON(http.StatusOK, response.Success(result, "loan status updated to invested"))
 		}
 	case string(loan.EventDisburse):
-		result, err := h.loanService.DisburseLoan(loanEntity, req.FieldOfficerID, req.AgreementFileName)
+		result, err := h.loanService.DisburseLoan(ctx, loanEntity, req.FieldOfficerID, req.AgreementFileName)
 		if err != nil {
 			return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
 		}
 		return c.JSON(http.StatusOK, response.Success(result, "loan disbursed successfully"))
+	case string(loan.EventReject):
+		err = h.loanService.RejectLoan(ctx, loanEntity, req.RejectedBy, req.Reason)
+	case string(loan.EventCancel):
+		err = h.loanService.CancelLoan(ctx, loanEntity, req.CancelledBy, req.Reason)
+	case string(loan.EventExpire):
+		err = h.loanService.ExpireLoan(ctx, loanEntity)
 
 	default:
-		return c.JSON(http.StatusBadRequest, response.Error("unsupported status transition"))
+		return h.refuseStatusUpdate(c, loanID, newStatus, actor, loanEntity.Status, errUnsupportedTransition)
 	}
 
 	if err != nil {
@@ -186,6 +259,40 @@ func (h *LoanHandler) UpdateLoanStatus(c echo.Context) error {
 	return c.JSON(http.StatusOK, response.Success(nil, "Loan status updated successfully"))
 }
 
+var (
+	errInvalidStatus         = errors.New("invalid status")
+	errInvalidRequest        = errors.New("invalid request")
+	errLenderIDRequired      = errors.New("lender ID is required")
+	errUnsupportedTransition = errors.New("unsupported status transition")
+)
+
+// statusUpdateActor returns who requested the status update, for the audit log
+func statusUpdateActor(req request.StatusUpdateRequest, status string) string {
+	switch status {
+	case loan.EventApprove:
+		return req.ApprovalEmployeeID
+	case loan.EventInvest:
+		return req.LenderID
+	case loan.EventDisburse:
+		return req.FieldOfficerID
+	case loan.EventReject:
+		return req.RejectedBy
+	case loan.EventCancel:
+		return req.CancelledBy
+	case loan.EventExpire:
+		return audit.ActorSystem
+	}
+	return ""
+}
+
+// refuseStatusUpdate records a status update refused before reaching the state machine in the
+// audit log, like the transitions refused by it, and responds with the error
+func (h *LoanHandler) refuseStatusUpdate(c echo.Context, loanID string, action string, actor string, status loan.Status, err error) error {
+	h.loanService.RecordRefusedAction(c.Request().Context(), loanID, action, actor, status, err)
+
+	return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
+}
+
 func formatValidationErrors(errors validator.ValidationErrors) string {
 	var errorMsg string
 	for _, err := range errors {
@@ -196,11 +303,47 @@ func formatValidationErrors(errors validator.ValidationErrors) string {
 			errorMsg += err.Field() + " must be greater than " + err.Param() + ". "
 		case "lt":
 			errorMsg += err.Field() + " must be less than " + err.Param() + ". "
-		case "roiLessThanRate":
-			errorMsg += "ROI must be less than Rate. "
+		case "gte":
+			errorMsg += err.Field() + " must be greater than or equal to " + err.Param() + ". "
+		case "lte":
+			errorMsg += err.Field() + " must be less than or equal to " + err.Param() + ". "
+		case "oneof":
+			errorMsg += err.Field() + " must be one of " + err.Param() + ". "
+		case "gtefield":
+			errorMsg += err.Field() + " must be greater than or equal to " + err.Param() + ". "
+		case "min":
+			errorMsg += err.Field() + " must have at least " + err.Param() + " item. "
+		case "url":
+			errorMsg += err.Field() + " must be a valid URL. "
 		default:
 			errorMsg += err.Field() + " is invalid. "
 		}
 	}
 	return errorMsg
 }
+
+// queryInt parses an integer query parameter, returning 0 when it is missing or invalid
+func queryInt(c echo.Context, name string) int {
+	val, err := strconv.Atoi(c.QueryParam(name))
+	if err != nil {
+		return 0
+	}
+	return val
+}
+
+// queryTime parses a time query parameter given as RFC 3339 or as a date, returning nil when it is missing
+func queryTime(c echo.Context, name string) (*time.Time, error) {
+	val := c.QueryParam(name)
+	if val == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		t, err = time.Parse(time.DateOnly, val)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &t, nil
+}
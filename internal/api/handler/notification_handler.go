@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/notification"
+)
+
+type NotificationHandler struct {
+	notificationService *notification.NotificationService
+}
+
+func NewNotificationHandler(notificationService *notification.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+// ListNotifications godoc
+// @Summary List notifications
+// @Description Get the notifications sent to borrowers and lenders, newest first, with optional filtering
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param recipient_type query string false "Filter by recipient type (borrower, lender)"
+// @Param recipient_id query string false "Filter by borrower or lender ID"
+// @Param loan_id query string false "Filter by loan ID"
+// @Param event_type query string false "Filter by event type"
+// @Param status query string false "Filter by status (sent, failed)"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Number of notifications per page"
+// @Success 200 {object} domain.PaginatedResponse{data=[]notification.Notification} "List of notifications"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /notifications [get]
+func (h *NotificationHandler) ListNotifications(c echo.Context) error {
+	recipientType := notification.RecipientType(c.QueryParam("recipient_type"))
+	recipientID := c.QueryParam("recipient_id")
+	loanID := c.QueryParam("loan_id")
+	eventType := c.QueryParam("event_type")
+	status := notification.Status(c.QueryParam("status"))
+
+	filter := notification.NotificationFilter{
+		RecipientType: &recipientType,
+		RecipientID:   &recipientID,
+		LoanID:        &loanID,
+		EventType:     &eventType,
+		Status:        &status,
+		Page:          queryInt(c, "page"),
+		PageSize:      queryInt(c, "page_size"),
+	}
+
+	notifications, err := h.notificationService.ListNotifications(c.Request().Context(), filter)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, notifications)
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/request"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
+)
+
+type ProductHandler struct {
+	productService *product.ProductService
+	validate       *validator.Validate
+}
+
+func NewProductHandler(productService *product.ProductService, validate *validator.Validate) *ProductHandler {
+	return &ProductHandler{
+		productService: productService,
+		validate:       validate,
+	}
+}
+
+// CreateProduct godoc
+// @Summary Create a loan product
+// @Description Add a product to the catalog. Loans are proposed from a product, within its amount, rate and ROI ranges and with one of its tenors.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param product body request.LoanProductRequest true "Product information"
+// @Success 201 {object} response.APIResponse{data=product.LoanProduct} "Product created successfully"
+// @Failure 400 {object} response.APIResponse "Invalid request or validation error"
+// @Router /products [post]
+func (h *ProductHandler) CreateProduct(c echo.Context) error {
+	details, errMsg := h.bindDetails(c)
+	if errMsg != "" {
+		return c.JSON(http.StatusBadRequest, response.Error(errMsg))
+	}
+
+	productEntity, err := h.productService.CreateProduct(c.Request().Context(), details)
+	if err != nil {
+		if errors.Is(err, product.ErrInvalidProduct) {
+			return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
+		}
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusCreated, response.Success(productEntity, "Product created successfully"))
+}
+
+// GetProduct godoc
+// @Summary Get a loan product
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Success 200 {object} response.APIResponse{data=product.LoanProduct} "Product"
+// @Failure 404 {object} response.APIResponse "Product not found"
+// @Router /products/{id} [get]
+func (h *ProductHandler) GetProduct(c echo.Context) error {
+	productEntity, err := h.productService.GetByID(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, product.ErrProductNotFound) {
+			return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+		}
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, response.Success(productEntity, "Product retrieved successfully"))
+}
+
+// ListProducts godoc
+// @Summary List loan products
+// @Description Get the products of the catalog, oldest first
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param name query string false "Filter by name (partial, case-insensitive)"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Number of products per page"
+// @Success 200 {object} domain.PaginatedResponse{data=[]product.LoanProduct} "List of products"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /products [get]
+func (h *ProductHandler) ListProducts(c echo.Context) error {
+	name := c.QueryParam("name")
+
+	filter := product.ProductFilter{
+		Name:     &name,
+		Page:     queryInt(c, "page"),
+		PageSize: queryInt(c, "page_size"),
+	}
+
+	products, err := h.productService.ListProducts(c.Request().Context(), filter)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, products)
+}
+
+// UpdateProduct godoc
+// @Summary Update a loan product
+// @Description Replace the details of a product. The loans already proposed from it keep their amount, rates and terms.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param product body request.LoanProductRequest true "Product information"
+// @Success 200 {object} response.APIResponse{data=product.LoanProduct} "Product updated successfully"
+// @Failure 400 {object} response.APIResponse "Invalid request or validation error"
+// @Failure 404 {object} response.APIResponse "Product not found"
+// @Router /products/{id} [put]
+func (h *ProductHandler) UpdateProduct(c echo.Context) error {
+	details, errMsg := h.bindDetails(c)
+	if errMsg != "" {
+		return c.JSON(http.StatusBadRequest, response.Error(errMsg))
+	}
+
+	productEntity, err := h.productService.UpdateProduct(c.Request().Context(), c.Param("id"), details)
+	if err != nil {
+		switch {
+		case errors.Is(err, product.ErrProductNotFound):
+			return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+		case errors.Is(err, product.ErrInvalidProduct):
+			return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
+		}
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, response.Success(productEntity, "Product updated successfully"))
+}
+
+// DeleteProduct godoc
+// @Summary Delete a loan product
+// @Description Remove a product from the catalog, no loan can be proposed from it anymore. The loans already proposed from it keep its ID.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Success 200 {object} response.APIResponse "Product deleted successfully"
+// @Failure 404 {object} response.APIResponse "Product not found"
+// @Router /products/{id} [delete]
+func (h *ProductHandler) DeleteProduct(c echo.Context) error {
+	if err := h.productService.DeleteProduct(c.Request().Context(), c.Param("id")); err != nil {
+		if errors.Is(err, product.ErrProductNotFound) {
+			return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+		}
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, response.Success(nil, "Product deleted successfully"))
+}
+
+// bindDetails reads and validates the product of the request body, returning the error message
+// when it is invalid
+func (h *ProductHandler) bindDetails(c echo.Context) (product.Details, string) {
+	var req request.LoanProductRequest
+	if err := c.Bind(&req); err != nil {
+		return product.Details{}, "Invalid request"
+	}
+
+	// Validate request
+	if err := h.validate.Struct(req); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		return product.Details{}, formatValidationErrors(validationErrors)
+	}
+
+	return product.Details{
+		Name:              req.Name,
+		MinAmount:         req.MinAmount,
+		MaxAmount:         req.MaxAmount,
+		MinRate:           req.MinRate,
+		MaxRate:           req.MaxRate,
+		MinROI:            req.MinROI,
+		MaxROI:            req.MaxROI,
+		TenorMonths:       req.TenorMonths,
+		InterestMethod:    interest.Method(req.InterestMethod),
+		RequiredDocuments: req.RequiredDocuments,
+	}, ""
+}
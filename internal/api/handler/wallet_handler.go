@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/request"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
+)
+
+type WalletHandler struct {
+	walletService *wallet.WalletService
+	lenderService *lender.LenderService
+	validate      *validator.Validate
+}
+
+func NewWalletHandler(walletService *wallet.WalletService, lenderService *lender.LenderService, validate *validator.Validate) *WalletHandler {
+	return &WalletHandler{
+		walletService: walletService,
+		lenderService: lenderService,
+		validate:      validate,
+	}
+}
+
+// GetWallet godoc
+// @Summary Get lender wallet
+// @Description Get the wallet balance of a lender, including funds reserved for loans not yet disbursed
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Param id path string true "Lender ID"
+// @Success 200 {object} response.APIResponse{data=wallet.Wallet} "Lender wallet"
+// @Failure 404 {object} response.APIResponse "Lender not found, or no funds deposited yet"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /lenders/{id}/wallet [get]
+func (h *WalletHandler) GetWallet(c echo.Context) error {
+	lenderID := c.Param("id")
+
+	if _, err := h.lenderService.GetByID(c.Request().Context(), lenderID); err != nil {
+		return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+	}
+
+	lenderWallet, err := h.walletService.GetByLenderID(c.Request().Context(), lenderID)
+	if errors.Is(err, wallet.ErrWalletNotFound) {
+		return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, response.Success(lenderWallet))
+}
+
+// Deposit godoc
+// @Summary Deposit funds
+// @Description Add funds to the wallet of a lender, which is created on the first deposit
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Param id path string true "Lender ID"
+// @Param deposit body request.WalletTransactionRequest true "Deposit information"
+// @Success 201 {object} response.APIResponse{data=wallet.Transaction} "Funds deposited successfully"
+// @Failure 400 {object} response.APIResponse "Invalid request or validation error"
+// @Failure 404 {object} response.APIResponse "Lender not found"
+// @Router /lenders/{id}/wallet/deposit [post]
+func (h *WalletHandler) Deposit(c echo.Context) error {
+	lenderID := c.Param("id")
+
+	var req request.WalletTransactionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.Error("Invalid request"))
+	}
+
+	// Validate request
+	if err := h.validate.Struct(req); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		errorsMsg := formatValidationErrors(validationErrors)
+		return c.JSON(http.StatusBadRequest, response.Error(errorsMsg))
+	}
+
+	if _, err := h.lenderService.GetByID(c.Request().Context(), lenderID); err != nil {
+		return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+	}
+
+	transaction, err := h.walletService.Deposit(c.Request().Context(), lenderID, req.Amount, req.Description)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusCreated, response.Success(transaction, "Funds deposited successfully"))
+}
+
+// Withdraw godoc
+// @Summary Withdraw funds
+// @Description Withdraw available (not reserved) funds from the wallet of a lender
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Param id path string true "Lender ID"
+// @Param withdrawal body request.WalletTransactionRequest true "Withdrawal information"
+// @Success 201 {object} response.APIResponse{data=wallet.Transaction} "Funds withdrawn successfully"
+// @Failure 400 {object} response.APIResponse "Invalid request or insufficient balance"
+// @Failure 404 {object} response.APIResponse "Lender not found, or no funds deposited yet"
+// @Router /lenders/{id}/wallet/withdraw [post]
+func (h *WalletHandler) Withdraw(c echo.Context) error {
+	lenderID := c.Param("id")
+
+	var req request.WalletTransactionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.Error("Invalid request"))
+	}
+
+	// Validate request
+	if err := h.validate.Struct(req); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		errorsMsg := formatValidationErrors(validationErrors)
+		return c.JSON(http.StatusBadRequest, response.Error(errorsMsg))
+	}
+
+	if _, err := h.lenderService.GetByID(c.Request().Context(), lenderID); err != nil {
+		return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+	}
+
+	transaction, err := h.walletService.Withdraw(c.Request().Context(), lenderID, req.Amount, req.Description)
+	if errors.Is(err, wallet.ErrWalletNotFound) {
+		return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusCreated, response.Success(transaction, "Funds withdrawn successfully"))
+}
+
+// ListTransactions godoc
+// @Summary List wallet transactions
+// @Description Get the transaction history of a lender wallet, newest first
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Param id path string true "Lender ID"
+// @Param loan_id query string false "Filter by loan ID"
+// @Param type query string false "Filter by transaction type (deposit, withdraw, reserve, release, debit)"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} domain.PaginatedResponse{data=[]wallet.Transaction} "List of wallet transactions"
+// @Failure 404 {object} response.APIResponse "Lender not found"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /lenders/{id}/wallet/transactions [get]
+func (h *WalletHandler) ListTransactions(c echo.Context) error {
+	lenderID := c.Param("id")
+
+	if _, err := h.lenderService.GetByID(c.Request().Context(), lenderID); err != nil {
+		return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+	}
+
+	// Extract query parameters for filtering
+	loanID := c.QueryParam("loan_id")
+	txType := wallet.TransactionType(c.QueryParam("type"))
+
+	filter := wallet.TransactionFilter{
+		LenderID: &lenderID,
+		LoanID:   &loanID,
+		Type:     &txType,
+		Page:     queryInt(c, "page"),
+		PageSize: queryInt(c, "page_size"),
+	}
+
+	transactions, err := h.walletService.ListTransactions(c.Request().Context(), filter)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, transactions)
+}
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/request"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/webhook"
+)
+
+type WebhookHandler struct {
+	webhookService *webhook.WebhookService
+	validate       *validator.Validate
+}
+
+func NewWebhookHandler(webhookService *webhook.WebhookService, validate *validator.Validate) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		validate:       validate,
+	}
+}
+
+// CreateSubscription godoc
+// @Summary Create a webhook subscription
+// @Description Register a URL to receive loan events. Every delivery is signed with HMAC-SHA256 over "<X-Webhook-Timestamp>.<body>" using the subscription secret, sent in the X-Webhook-Signature header as "sha256=<hex>". The secret is only returned here.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param subscription body request.CreateWebhookSubscriptionRequest true "Subscription information"
+// @Success 201 {object} response.APIResponse{data=response.WebhookSubscriptionCreatedResponse} "Subscription created successfully"
+// @Failure 400 {object} response.APIResponse "Invalid request or validation error"
+// @Router /webhooks [post]
+func (h *WebhookHandler) CreateSubscription(c echo.Context) error {
+	var req request.CreateWebhookSubscriptionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.Error("Invalid request"))
+	}
+
+	// Validate request
+	if err := h.validate.Struct(req); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		errorsMsg := formatValidationErrors(validationErrors)
+		return c.JSON(http.StatusBadRequest, response.Error(errorsMsg))
+	}
+
+	subscription, err := h.webhookService.CreateSubscription(c.Request().Context(), req.URL, req.EventTypes, req.Secret)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusCreated, response.Success(response.WebhookSubscriptionCreatedResponse{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		Secret:     subscription.Secret,
+		Active:     subscription.Active,
+		CreatedAt:  subscription.CreatedAt,
+	}, "Subscription created successfully"))
+}
+
+// ListSubscriptions godoc
+// @Summary List webhook subscriptions
+// @Description Get the webhook subscriptions, oldest first
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param active query bool false "Filter by active flag"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Number of subscriptions per page"
+// @Success 200 {object} domain.PaginatedResponse{data=[]webhook.Subscription} "List of subscriptions"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /webhooks [get]
+func (h *WebhookHandler) ListSubscriptions(c echo.Context) error {
+	filter := webhook.SubscriptionFilter{
+		Page:     queryInt(c, "page"),
+		PageSize: queryInt(c, "page_size"),
+	}
+
+	switch c.QueryParam("active") {
+	case "true":
+		active := true
+		filter.Active = &active
+	case "false":
+		active := false
+		filter.Active = &active
+	}
+
+	subscriptions, err := h.webhookService.ListSubscriptions(c.Request().Context(), filter)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, subscriptions)
+}
+
+// DeactivateSubscription godoc
+// @Summary Deactivate a webhook subscription
+// @Description Stop sending events to a subscription. Its delivery log is kept.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} response.APIResponse{data=webhook.Subscription} "Subscription deactivated successfully"
+// @Failure 404 {object} response.APIResponse "Subscription not found"
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) DeactivateSubscription(c echo.Context) error {
+	subscription, err := h.webhookService.DeactivateSubscription(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, webhook.ErrSubscriptionNotFound) {
+			return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+		}
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, response.Success(subscription, "Subscription deactivated successfully"))
+}
+
+// ListDeliveries godoc
+// @Summary List webhook deliveries
+// @Description Get the delivery log of a subscription, newest first, with the response code and error of the last attempt
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Param status query string false "Filter by status (pending, succeeded, failed, abandoned)"
+// @Param event_type query string false "Filter by event type"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Number of deliveries per page"
+// @Success 200 {object} domain.PaginatedResponse{data=[]webhook.Delivery} "List of deliveries"
+// @Failure 500 {object} response.APIResponse "Internal server error"
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(c echo.Context) error {
+	subscriptionID := c.Param("id")
+	status := webhook.DeliveryStatus(c.QueryParam("status"))
+	eventType := c.QueryParam("event_type")
+
+	filter := webhook.DeliveryFilter{
+		SubscriptionID: &subscriptionID,
+		Status:         &status,
+		EventType:      &eventType,
+		Page:           queryInt(c, "page"),
+		PageSize:       queryInt(c, "page_size"),
+	}
+
+	deliveries, err := h.webhookService.ListDeliveries(c.Request().Context(), filter)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, deliveries)
+}
+
+// Redeliver godoc
+// @Summary Redeliver a webhook
+// @Description Send the payload of a past delivery again right away. A new delivery is recorded and retried with backoff if it fails.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Delivery ID"
+// @Success 201 {object} response.APIResponse{data=webhook.Delivery} "Redelivery attempted"
+// @Failure 404 {object} response.APIResponse "Delivery not found"
+// @Router /webhooks/deliveries/{id}/redeliver [post]
+func (h *WebhookHandler) Redeliver(c echo.Context) error {
+	delivery, err := h.webhookService.Redeliver(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, webhook.ErrDeliveryNotFound) || errors.Is(err, webhook.ErrSubscriptionNotFound) {
+			return c.JSON(http.StatusNotFound, response.Error(err.Error()))
+		}
+		return c.JSON(http.StatusInternalServerError, response.Error(err.Error()))
+	}
+
+	return c.JSON(http.StatusCreated, response.Success(delivery, "Redelivery attempted"))
+}
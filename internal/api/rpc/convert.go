@@ -0,0 +1,120 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/rpc/loanv1"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toProtoLoan(l *loan.Loan) *loanv1.Loan {
+	transitions := make([]*loanv1.StatusTransition, 0, len(l.StatusTransitions))
+	for _, t := range l.StatusTransitions {
+		transitions = append(transitions, &loanv1.StatusTransition{
+			Id:          t.ID,
+			From:        string(t.From),
+			To:          string(t.To),
+			Date:        timestamppb.New(t.Date),
+			Description: t.Description,
+			PerformedBy: t.PerformedBy,
+		})
+	}
+
+	return &loanv1.Loan{
+		Id:                  l.ID,
+		BorrowerId:          l.BorrowerID,
+		ProductId:           l.ProductID,
+		Amount:              l.Amount,
+		Rate:                l.Rate,
+		Roi:                 l.ROI,
+		TenorMonths:         int32(l.TenorMonths),
+		InterestMethod:      string(l.InterestMethod),
+		DayCount:            string(l.DayCount),
+		TotalInterest:       l.TotalInterest,
+		TotalRepayment:      l.TotalRepayment,
+		Status:              string(l.Status),
+		SurveyDocumentId:    l.SurveyDocumentID,
+		ApprovalDate:        toProtoTime(l.ApprovalDate),
+		ApprovedBy:          l.ApprovedBy,
+		InvestmentDate:      toProtoTime(l.InvestmentDate),
+		DisbursementDate:    toProtoTime(l.DisbursementDate),
+		DisbursedBy:         l.DisbursedBy,
+		AgreementDocumentId: l.AgreementDocumentID,
+		StatusTransitions:   transitions,
+		CreatedAt:           timestamppb.New(l.CreatedAt),
+		UpdatedAt:           timestamppb.New(l.UpdatedAt),
+	}
+}
+
+func toProtoInvestorPayouts(payouts []response.InvestorPayout) []*loanv1.InvestorPayout {
+	investors := make([]*loanv1.InvestorPayout, 0, len(payouts))
+	for _, p := range payouts {
+		investors = append(investors, &loanv1.InvestorPayout{
+			InvestmentId:   p.InvestmentID,
+			LenderId:       p.LenderID,
+			Amount:         p.Amount,
+			ExpectedReturn: p.ExpectedReturn,
+			Payout:         p.Payout,
+		})
+	}
+	return investors
+}
+
+func toProtoBorrower(b *borrower.Borrower) *loanv1.Borrower {
+	return &loanv1.Borrower{
+		Id:          b.ID,
+		FullName:    b.FullName,
+		Email:       b.Email,
+		PhoneNumber: b.PhoneNumber,
+		IdNumber:    b.IDNumber,
+		CreditLimit: b.CreditLimit,
+		CreatedAt:   timestamppb.New(b.CreatedAt),
+		UpdatedAt:   timestamppb.New(b.UpdatedAt),
+	}
+}
+
+func toProtoLender(l *lender.Lender) *loanv1.Lender {
+	return &loanv1.Lender{
+		Id:          l.ID,
+		FullName:    l.FullName,
+		Email:       l.Email,
+		PhoneNumber: l.PhoneNumber,
+		IdNumber:    l.IDNumber,
+		CreatedAt:   timestamppb.New(l.CreatedAt),
+		UpdatedAt:   timestamppb.New(l.UpdatedAt),
+	}
+}
+
+func toProtoEmployee(e *employee.Employee) *loanv1.Employee {
+	return &loanv1.Employee{
+		Id:          e.ID,
+		FullName:    e.FullName,
+		Email:       e.Email,
+		PhoneNumber: e.PhoneNumber,
+		IdNumber:    e.IDNumber,
+		CreatedAt:   timestamppb.New(e.CreatedAt),
+		UpdatedAt:   timestamppb.New(e.UpdatedAt),
+	}
+}
+
+func toProtoPagination(p domain.PaginationInfo) *loanv1.Pagination {
+	return &loanv1.Pagination{
+		CurrentPage: int32(p.CurrentPage),
+		PageSize:    int32(p.PageSize),
+		TotalItems:  p.TotalItems,
+		TotalPages:  int32(p.TotalPages),
+	}
+}
+
+func toProtoTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
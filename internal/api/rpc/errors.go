@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/looplab/fsm"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusFromError maps a domain error to a gRPC status
+func statusFromError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	// A transition cancelled by a callback carries the reason, a refusal such as an unknown lender
+	// or a failure to store the loan
+	var canceled fsm.CanceledError
+	if errors.As(err, &canceled) && canceled.Err != nil {
+		err = canceled.Err
+	}
+
+	var transitionErr *loan.TransitionError
+	var refusalErr *loan.RefusalError
+	var invalidEventErr fsm.InvalidEventError
+	var validationErrs validator.ValidationErrors
+	var requiredErr *requiredFieldError
+
+	switch {
+	case errors.Is(err, loan.ErrLoanNotFound),
+		errors.Is(err, borrower.ErrBorrowerNotFound),
+		errors.Is(err, lender.ErrLenderNotFound),
+		errors.Is(err, employee.ErrEmployeeNotFound),
+		errors.Is(err, product.ErrProductNotFound):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, borrower.ErrEmailTaken), errors.Is(err, borrower.ErrIDNumberTaken),
+		errors.Is(err, lender.ErrEmailTaken), errors.Is(err, lender.ErrIDNumberTaken),
+		errors.Is(err, employee.ErrEmailTaken), errors.Is(err, employee.ErrIDNumberTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+
+	case errors.As(err, &validationErrs), errors.As(err, &requiredErr),
+		errors.Is(err, interest.ErrInvalidTenor), errors.Is(err, interest.ErrUnknownMethod),
+		errors.Is(err, interest.ErrUnknownDayCount),
+		errors.Is(err, product.ErrNotOffered):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.As(err, &transitionErr), errors.As(err, &invalidEventErr),
+		errors.As(err, &refusalErr),
+		errors.Is(err, loan.ErrCreditLimitExceeded),
+		errors.Is(err, wallet.ErrInsufficientBalance),
+		errors.Is(err, wallet.ErrInsufficientReserve):
+		// The loan or the parties are not in a state that allows the action
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// requiredFieldError names an empty required field of a request
+type requiredFieldError struct {
+	field string
+}
+
+func (e *requiredFieldError) Error() string {
+	return e.field + " is required"
+}
+
+// missingField returns a *requiredFieldError naming the first empty field, given as name and value pairs
+func missingField(namesAndValues ...string) error {
+	for i := 0; i+1 < len(namesAndValues); i += 2 {
+		if namesAndValues[i+1] == "" {
+			return &requiredFieldError{field: namesAndValues[i]}
+		}
+	}
+	return nil
+}
+
+// required returns an InvalidArgument status naming the first empty field, given as name and value pairs
+func required(namesAndValues ...string) error {
+	if err := missingField(namesAndValues...); err != nil {
+		return statusFromError(err)
+	}
+	return nil
+}
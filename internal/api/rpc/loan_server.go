@@ -0,0 +1,248 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/request"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/rpc/loanv1"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+// LoanServer exposes the loan service over gRPC
+type LoanServer struct {
+	loanv1.UnimplementedLoanServiceServer
+
+	loanService   *loan.LoanService
+	lenderService *lender.LenderService
+	validate      *validator.Validate
+}
+
+func NewLoanServer(loanService *loan.LoanService, lenderService *lender.LenderService, validate *validator.Validate) *LoanServer {
+	return &LoanServer{
+		loanService:   loanService,
+		lenderService: lenderService,
+		validate:      validate,
+	}
+}
+
+func (s *LoanServer) CreateLoan(ctx context.Context, req *loanv1.CreateLoanRequest) (*loanv1.Loan, error) {
+	// Same validation as the HTTP API
+	createReq := request.CreateLoanRequest{
+		BorrowerID:     req.GetBorrowerId(),
+		ProductID:      req.GetProductId(),
+		Amount:         req.GetAmount(),
+		Rate:           req.GetRate(),
+		ROI:            req.GetRoi(),
+		TenorMonths:    int(req.GetTenorMonths()),
+		InterestMethod: req.GetInterestMethod(),
+		DayCount:       req.GetDayCount(),
+	}
+	if err := s.validate.Struct(createReq); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	terms := interest.Terms{
+		TenorMonths: createReq.TenorMonths,
+		Method:      interest.Method(createReq.InterestMethod),
+		DayCount:    interest.DayCount(createReq.DayCount),
+	}
+	l, err := s.loanService.CreateLoan(ctx, createReq.BorrowerID, createReq.ProductID, createReq.Amount, createReq.Rate, createReq.ROI, terms)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoLoan(l), nil
+}
+
+func (s *LoanServer) GetLoan(ctx context.Context, req *loanv1.GetLoanRequest) (*loanv1.Loan, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	l, err := s.loanService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoLoan(l), nil
+}
+
+func (s *LoanServer) ListLoans(ctx context.Context, req *loanv1.ListLoansRequest) (*loanv1.ListLoansResponse, error) {
+	filter := loan.LoanFilter{
+		BorrowerID: req.BorrowerId,
+		MinAmount:  req.MinAmount,
+		MaxAmount:  req.MaxAmount,
+		Page:       int(req.GetPage()),
+		PageSize:   int(req.GetPageSize()),
+	}
+	if req.Status != nil {
+		status := loan.Status(req.GetStatus())
+		filter.Status = &status
+	}
+
+	result, err := s.loanService.ListLoans(ctx, filter)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	loans, _ := result.Data.([]*loan.Loan)
+	resp := &loanv1.ListLoansResponse{
+		Loans:      make([]*loanv1.Loan, 0, len(loans)),
+		Pagination: toProtoPagination(result.Pagination),
+	}
+	for _, l := range loans {
+		resp.Loans = append(resp.Loans, toProtoLoan(l))
+	}
+
+	return resp, nil
+}
+
+func (s *LoanServer) ApproveLoan(ctx context.Context, req *loanv1.ApproveLoanRequest) (*loanv1.Loan, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	l, err := s.loanService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, s.refuse(ctx, req.GetId(), loan.EventApprove, req.GetApprovalEmployeeId(), nil, err)
+	}
+	if err := missingField("approval_employee_id", req.GetApprovalEmployeeId(), "file_name", req.GetFileName()); err != nil {
+		return nil, s.refuse(ctx, req.GetId(), loan.EventApprove, req.GetApprovalEmployeeId(), l, err)
+	}
+
+	if err := s.loanService.ApproveLoan(ctx, l, req.GetApprovalEmployeeId(), req.GetFileName()); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoLoan(l), nil
+}
+
+func (s *LoanServer) InvestLoan(ctx context.Context, req *loanv1.InvestLoanRequest) (*loanv1.InvestLoanResponse, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	l, err := s.loanService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, s.refuse(ctx, req.GetId(), loan.EventInvest, req.GetLenderId(), nil, err)
+	}
+	if err := missingField("lender_id", req.GetLenderId()); err != nil {
+		return nil, s.refuse(ctx, req.GetId(), loan.EventInvest, req.GetLenderId(), l, err)
+	}
+
+	lenderEntity, err := s.lenderService.GetByID(ctx, req.GetLenderId())
+	if err != nil {
+		return nil, s.refuse(ctx, req.GetId(), loan.EventInvest, req.GetLenderId(), l, err)
+	}
+
+	result, err := s.loanService.InvestLoan(ctx, l, lenderEntity, req.GetAmount())
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &loanv1.InvestLoanResponse{
+		Loan:              toProtoLoan(l),
+		RemainingAmount:   result.RemainingAmount,
+		InvestedAmount:    result.InvestedAmount,
+		AgreementDocument: result.AgreementDocument,
+	}, nil
+}
+
+func (s *LoanServer) DisburseLoan(ctx context.Context, req *loanv1.DisburseLoanRequest) (*loanv1.DisburseLoanResponse, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	l, err := s.loanService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, s.refuse(ctx, req.GetId(), loan.EventDisburse, req.GetFieldOfficerId(), nil, err)
+	}
+	if err := missingField("field_officer_id", req.GetFieldOfficerId(), "agreement_file_name", req.GetAgreementFileName()); err != nil {
+		return nil, s.refuse(ctx, req.GetId(), loan.EventDisburse, req.GetFieldOfficerId(), l, err)
+	}
+
+	result, err := s.loanService.DisburseLoan(ctx, l, req.GetFieldOfficerId(), req.GetAgreementFileName())
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &loanv1.DisburseLoanResponse{
+		Loan:              toProtoLoan(l),
+		BorrowerRepayment: result.BorrowerRepayment,
+		InvestorRoi:       result.InvestorROI,
+		Investors:         toProtoInvestorPayouts(result.Investors),
+	}, nil
+}
+
+func (s *LoanServer) RejectLoan(ctx context.Context, req *loanv1.RejectLoanRequest) (*loanv1.Loan, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	l, err := s.loanService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, s.refuse(ctx, req.GetId(), loan.EventReject, req.GetRejectedBy(), nil, err)
+	}
+	if err := missingField("rejected_by", req.GetRejectedBy(), "reason", req.GetReason()); err != nil {
+		return nil, s.refuse(ctx, req.GetId(), loan.EventReject, req.GetRejectedBy(), l, err)
+	}
+
+	if err := s.loanService.RejectLoan(ctx, l, req.GetRejectedBy(), req.GetReason()); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoLoan(l), nil
+}
+
+func (s *LoanServer) CancelLoan(ctx context.Context, req *loanv1.CancelLoanRequest) (*loanv1.Loan, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	l, err := s.loanService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, s.refuse(ctx, req.GetId(), loan.EventCancel, req.GetCancelledBy(), nil, err)
+	}
+	if err := missingField("cancelled_by", req.GetCancelledBy(), "reason", req.GetReason()); err != nil {
+		return nil, s.refuse(ctx, req.GetId(), loan.EventCancel, req.GetCancelledBy(), l, err)
+	}
+
+	if err := s.loanService.CancelLoan(ctx, l, req.GetCancelledBy(), req.GetReason()); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoLoan(l), nil
+}
+
+func (s *LoanServer) ExpireLoan(ctx context.Context, req *loanv1.ExpireLoanRequest) (*loanv1.Loan, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	l, err := s.loanService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, s.refuse(ctx, req.GetId(), loan.EventExpire, audit.ActorSystem, nil, err)
+	}
+
+	if err := s.loanService.ExpireLoan(ctx, l); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoLoan(l), nil
+}
+
+// refuse records a transition refused before reaching the state machine in the audit log, like the
+// HTTP API does, and returns its status. l is nil when the loan was not found.
+func (s *LoanServer) refuse(ctx context.Context, loanID string, action string, actor string, l *loan.Loan, err error) error {
+	var loanStatus loan.Status
+	if l != nil {
+		loanStatus = l.Status
+	}
+	s.loanService.RecordRefusedAction(ctx, loanID, action, actor, loanStatus, err)
+
+	return statusFromError(err)
+}
@@ -0,0 +1,2332 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: loan/v1/loan.proto
+
+package loanv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalItems    int64                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_loan_v1_loan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pagination) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *Pagination) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type StatusTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	PerformedBy   string                 `protobuf:"bytes,6,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusTransition) Reset() {
+	*x = StatusTransition{}
+	mi := &file_loan_v1_loan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusTransition) ProtoMessage() {}
+
+func (x *StatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusTransition.ProtoReflect.Descriptor instead.
+func (*StatusTransition) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StatusTransition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *StatusTransition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetPerformedBy() string {
+	if x != nil {
+		return x.PerformedBy
+	}
+	return ""
+}
+
+type Loan struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BorrowerId          string                 `protobuf:"bytes,2,opt,name=borrower_id,json=borrowerId,proto3" json:"borrower_id,omitempty"`
+	Amount              float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Rate                float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	Roi                 float64                `protobuf:"fixed64,5,opt,name=roi,proto3" json:"roi,omitempty"`
+	Status              string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	SurveyDocumentId    *string                `protobuf:"bytes,7,opt,name=survey_document_id,json=surveyDocumentId,proto3,oneof" json:"survey_document_id,omitempty"`
+	ApprovalDate        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=approval_date,json=approvalDate,proto3" json:"approval_date,omitempty"`
+	ApprovedBy          *string                `protobuf:"bytes,9,opt,name=approved_by,json=approvedBy,proto3,oneof" json:"approved_by,omitempty"`
+	InvestmentDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=investment_date,json=investmentDate,proto3" json:"investment_date,omitempty"`
+	DisbursementDate    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=disbursement_date,json=disbursementDate,proto3" json:"disbursement_date,omitempty"`
+	DisbursedBy         *string                `protobuf:"bytes,12,opt,name=disbursed_by,json=disbursedBy,proto3,oneof" json:"disbursed_by,omitempty"`
+	AgreementDocumentId *string                `protobuf:"bytes,13,opt,name=agreement_document_id,json=agreementDocumentId,proto3,oneof" json:"agreement_document_id,omitempty"`
+	StatusTransitions   []*StatusTransition    `protobuf:"bytes,14,rep,name=status_transitions,json=statusTransitions,proto3" json:"status_transitions,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TenorMonths         int32                  `protobuf:"varint,17,opt,name=tenor_months,json=tenorMonths,proto3" json:"tenor_months,omitempty"`
+	InterestMethod      string                 `protobuf:"bytes,18,opt,name=interest_method,json=interestMethod,proto3" json:"interest_method,omitempty"`
+	DayCount            string                 `protobuf:"bytes,19,opt,name=day_count,json=dayCount,proto3" json:"day_count,omitempty"`
+	TotalInterest       float64                `protobuf:"fixed64,20,opt,name=total_interest,json=totalInterest,proto3" json:"total_interest,omitempty"`
+	TotalRepayment      float64                `protobuf:"fixed64,21,opt,name=total_repayment,json=totalRepayment,proto3" json:"total_repayment,omitempty"`
+	ProductId           *string                `protobuf:"bytes,22,opt,name=product_id,json=productId,proto3,oneof" json:"product_id,omitempty"` // Unset on the loans proposed before the product catalog
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Loan) Reset() {
+	*x = Loan{}
+	mi := &file_loan_v1_loan_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Loan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loan) ProtoMessage() {}
+
+func (x *Loan) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loan.ProtoReflect.Descriptor instead.
+func (*Loan) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Loan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Loan) GetBorrowerId() string {
+	if x != nil {
+		return x.BorrowerId
+	}
+	return ""
+}
+
+func (x *Loan) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Loan) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *Loan) GetRoi() float64 {
+	if x != nil {
+		return x.Roi
+	}
+	return 0
+}
+
+func (x *Loan) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Loan) GetSurveyDocumentId() string {
+	if x != nil && x.SurveyDocumentId != nil {
+		return *x.SurveyDocumentId
+	}
+	return ""
+}
+
+func (x *Loan) GetApprovalDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovalDate
+	}
+	return nil
+}
+
+func (x *Loan) GetApprovedBy() string {
+	if x != nil && x.ApprovedBy != nil {
+		return *x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *Loan) GetInvestmentDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InvestmentDate
+	}
+	return nil
+}
+
+func (x *Loan) GetDisbursementDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisbursementDate
+	}
+	return nil
+}
+
+func (x *Loan) GetDisbursedBy() string {
+	if x != nil && x.DisbursedBy != nil {
+		return *x.DisbursedBy
+	}
+	return ""
+}
+
+func (x *Loan) GetAgreementDocumentId() string {
+	if x != nil && x.AgreementDocumentId != nil {
+		return *x.AgreementDocumentId
+	}
+	return ""
+}
+
+func (x *Loan) GetStatusTransitions() []*StatusTransition {
+	if x != nil {
+		return x.StatusTransitions
+	}
+	return nil
+}
+
+func (x *Loan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Loan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Loan) GetTenorMonths() int32 {
+	if x != nil {
+		return x.TenorMonths
+	}
+	return 0
+}
+
+func (x *Loan) GetInterestMethod() string {
+	if x != nil {
+		return x.InterestMethod
+	}
+	return ""
+}
+
+func (x *Loan) GetDayCount() string {
+	if x != nil {
+		return x.DayCount
+	}
+	return ""
+}
+
+func (x *Loan) GetTotalInterest() float64 {
+	if x != nil {
+		return x.TotalInterest
+	}
+	return 0
+}
+
+func (x *Loan) GetTotalRepayment() float64 {
+	if x != nil {
+		return x.TotalRepayment
+	}
+	return 0
+}
+
+func (x *Loan) GetProductId() string {
+	if x != nil && x.ProductId != nil {
+		return *x.ProductId
+	}
+	return ""
+}
+
+type CreateLoanRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	BorrowerId string                 `protobuf:"bytes,1,opt,name=borrower_id,json=borrowerId,proto3" json:"borrower_id,omitempty"`
+	Amount     float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Rate       float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Roi        float64                `protobuf:"fixed64,4,opt,name=roi,proto3" json:"roi,omitempty"`
+	// Interest terms, the first tenor and the interest method of the product on a 30/360 basis when unset
+	TenorMonths    int32  `protobuf:"varint,5,opt,name=tenor_months,json=tenorMonths,proto3" json:"tenor_months,omitempty"`
+	InterestMethod string `protobuf:"bytes,6,opt,name=interest_method,json=interestMethod,proto3" json:"interest_method,omitempty"`
+	DayCount       string `protobuf:"bytes,7,opt,name=day_count,json=dayCount,proto3" json:"day_count,omitempty"`
+	// Product the loan is proposed from, bounding the amount, the rates and the terms
+	ProductId     string `protobuf:"bytes,8,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLoanRequest) Reset() {
+	*x = CreateLoanRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanRequest) ProtoMessage() {}
+
+func (x *CreateLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanRequest.ProtoReflect.Descriptor instead.
+func (*CreateLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateLoanRequest) GetBorrowerId() string {
+	if x != nil {
+		return x.BorrowerId
+	}
+	return ""
+}
+
+func (x *CreateLoanRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateLoanRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *CreateLoanRequest) GetRoi() float64 {
+	if x != nil {
+		return x.Roi
+	}
+	return 0
+}
+
+func (x *CreateLoanRequest) GetTenorMonths() int32 {
+	if x != nil {
+		return x.TenorMonths
+	}
+	return 0
+}
+
+func (x *CreateLoanRequest) GetInterestMethod() string {
+	if x != nil {
+		return x.InterestMethod
+	}
+	return ""
+}
+
+func (x *CreateLoanRequest) GetDayCount() string {
+	if x != nil {
+		return x.DayCount
+	}
+	return ""
+}
+
+func (x *CreateLoanRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type GetLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoanRequest) Reset() {
+	*x = GetLoanRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanRequest) ProtoMessage() {}
+
+func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetLoanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListLoansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BorrowerId    *string                `protobuf:"bytes,1,opt,name=borrower_id,json=borrowerId,proto3,oneof" json:"borrower_id,omitempty"`
+	Status        *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	MinAmount     *float64               `protobuf:"fixed64,3,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount     *float64               `protobuf:"fixed64,4,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoansRequest) Reset() {
+	*x = ListLoansRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansRequest) ProtoMessage() {}
+
+func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansRequest.ProtoReflect.Descriptor instead.
+func (*ListLoansRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListLoansRequest) GetBorrowerId() string {
+	if x != nil && x.BorrowerId != nil {
+		return *x.BorrowerId
+	}
+	return ""
+}
+
+func (x *ListLoansRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListLoansRequest) GetMinAmount() float64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *ListLoansRequest) GetMaxAmount() float64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *ListLoansRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLoansRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListLoansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Loans         []*Loan                `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoansResponse) Reset() {
+	*x = ListLoansResponse{}
+	mi := &file_loan_v1_loan_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansResponse) ProtoMessage() {}
+
+func (x *ListLoansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansResponse.ProtoReflect.Descriptor instead.
+func (*ListLoansResponse) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListLoansResponse) GetLoans() []*Loan {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+func (x *ListLoansResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ApproveLoanRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApprovalEmployeeId string                 `protobuf:"bytes,2,opt,name=approval_employee_id,json=approvalEmployeeId,proto3" json:"approval_employee_id,omitempty"`
+	FileName           string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ApproveLoanRequest) Reset() {
+	*x = ApproveLoanRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveLoanRequest) ProtoMessage() {}
+
+func (x *ApproveLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveLoanRequest.ProtoReflect.Descriptor instead.
+func (*ApproveLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApproveLoanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveLoanRequest) GetApprovalEmployeeId() string {
+	if x != nil {
+		return x.ApprovalEmployeeId
+	}
+	return ""
+}
+
+func (x *ApproveLoanRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type InvestLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LenderId      string                 `protobuf:"bytes,2,opt,name=lender_id,json=lenderId,proto3" json:"lender_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvestLoanRequest) Reset() {
+	*x = InvestLoanRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvestLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestLoanRequest) ProtoMessage() {}
+
+func (x *InvestLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestLoanRequest.ProtoReflect.Descriptor instead.
+func (*InvestLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InvestLoanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InvestLoanRequest) GetLenderId() string {
+	if x != nil {
+		return x.LenderId
+	}
+	return ""
+}
+
+func (x *InvestLoanRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type InvestLoanResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Loan              *Loan                  `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	RemainingAmount   float64                `protobuf:"fixed64,2,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	InvestedAmount    float64                `protobuf:"fixed64,3,opt,name=invested_amount,json=investedAmount,proto3" json:"invested_amount,omitempty"`
+	AgreementDocument *string                `protobuf:"bytes,4,opt,name=agreement_document,json=agreementDocument,proto3,oneof" json:"agreement_document,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InvestLoanResponse) Reset() {
+	*x = InvestLoanResponse{}
+	mi := &file_loan_v1_loan_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvestLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestLoanResponse) ProtoMessage() {}
+
+func (x *InvestLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestLoanResponse.ProtoReflect.Descriptor instead.
+func (*InvestLoanResponse) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InvestLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *InvestLoanResponse) GetRemainingAmount() float64 {
+	if x != nil {
+		return x.RemainingAmount
+	}
+	return 0
+}
+
+func (x *InvestLoanResponse) GetInvestedAmount() float64 {
+	if x != nil {
+		return x.InvestedAmount
+	}
+	return 0
+}
+
+func (x *InvestLoanResponse) GetAgreementDocument() string {
+	if x != nil && x.AgreementDocument != nil {
+		return *x.AgreementDocument
+	}
+	return ""
+}
+
+type DisburseLoanRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FieldOfficerId    string                 `protobuf:"bytes,2,opt,name=field_officer_id,json=fieldOfficerId,proto3" json:"field_officer_id,omitempty"`
+	AgreementFileName string                 `protobuf:"bytes,3,opt,name=agreement_file_name,json=agreementFileName,proto3" json:"agreement_file_name,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DisburseLoanRequest) Reset() {
+	*x = DisburseLoanRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisburseLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisburseLoanRequest) ProtoMessage() {}
+
+func (x *DisburseLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisburseLoanRequest.ProtoReflect.Descriptor instead.
+func (*DisburseLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DisburseLoanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DisburseLoanRequest) GetFieldOfficerId() string {
+	if x != nil {
+		return x.FieldOfficerId
+	}
+	return ""
+}
+
+func (x *DisburseLoanRequest) GetAgreementFileName() string {
+	if x != nil {
+		return x.AgreementFileName
+	}
+	return ""
+}
+
+type DisburseLoanResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Loan              *Loan                  `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	BorrowerRepayment float64                `protobuf:"fixed64,2,opt,name=borrower_repayment,json=borrowerRepayment,proto3" json:"borrower_repayment,omitempty"`
+	InvestorRoi       float64                `protobuf:"fixed64,3,opt,name=investor_roi,json=investorRoi,proto3" json:"investor_roi,omitempty"`
+	Investors         []*InvestorPayout      `protobuf:"bytes,4,rep,name=investors,proto3" json:"investors,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DisburseLoanResponse) Reset() {
+	*x = DisburseLoanResponse{}
+	mi := &file_loan_v1_loan_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisburseLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisburseLoanResponse) ProtoMessage() {}
+
+func (x *DisburseLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisburseLoanResponse.ProtoReflect.Descriptor instead.
+func (*DisburseLoanResponse) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DisburseLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *DisburseLoanResponse) GetBorrowerRepayment() float64 {
+	if x != nil {
+		return x.BorrowerRepayment
+	}
+	return 0
+}
+
+func (x *DisburseLoanResponse) GetInvestorRoi() float64 {
+	if x != nil {
+		return x.InvestorRoi
+	}
+	return 0
+}
+
+func (x *DisburseLoanResponse) GetInvestors() []*InvestorPayout {
+	if x != nil {
+		return x.Investors
+	}
+	return nil
+}
+
+type InvestorPayout struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InvestmentId   string                 `protobuf:"bytes,1,opt,name=investment_id,json=investmentId,proto3" json:"investment_id,omitempty"`
+	LenderId       string                 `protobuf:"bytes,2,opt,name=lender_id,json=lenderId,proto3" json:"lender_id,omitempty"`
+	Amount         float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExpectedReturn float64                `protobuf:"fixed64,4,opt,name=expected_return,json=expectedReturn,proto3" json:"expected_return,omitempty"`
+	Payout         float64                `protobuf:"fixed64,5,opt,name=payout,proto3" json:"payout,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InvestorPayout) Reset() {
+	*x = InvestorPayout{}
+	mi := &file_loan_v1_loan_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvestorPayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestorPayout) ProtoMessage() {}
+
+func (x *InvestorPayout) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestorPayout.ProtoReflect.Descriptor instead.
+func (*InvestorPayout) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InvestorPayout) GetInvestmentId() string {
+	if x != nil {
+		return x.InvestmentId
+	}
+	return ""
+}
+
+func (x *InvestorPayout) GetLenderId() string {
+	if x != nil {
+		return x.LenderId
+	}
+	return ""
+}
+
+func (x *InvestorPayout) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *InvestorPayout) GetExpectedReturn() float64 {
+	if x != nil {
+		return x.ExpectedReturn
+	}
+	return 0
+}
+
+func (x *InvestorPayout) GetPayout() float64 {
+	if x != nil {
+		return x.Payout
+	}
+	return 0
+}
+
+type RejectLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RejectedBy    string                 `protobuf:"bytes,2,opt,name=rejected_by,json=rejectedBy,proto3" json:"rejected_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectLoanRequest) Reset() {
+	*x = RejectLoanRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectLoanRequest) ProtoMessage() {}
+
+func (x *RejectLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectLoanRequest.ProtoReflect.Descriptor instead.
+func (*RejectLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RejectLoanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectLoanRequest) GetRejectedBy() string {
+	if x != nil {
+		return x.RejectedBy
+	}
+	return ""
+}
+
+func (x *RejectLoanRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CancelledBy   string                 `protobuf:"bytes,2,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelLoanRequest) Reset() {
+	*x = CancelLoanRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLoanRequest) ProtoMessage() {}
+
+func (x *CancelLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelLoanRequest.ProtoReflect.Descriptor instead.
+func (*CancelLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelLoanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelLoanRequest) GetCancelledBy() string {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return ""
+}
+
+func (x *CancelLoanRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ExpireLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireLoanRequest) Reset() {
+	*x = ExpireLoanRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireLoanRequest) ProtoMessage() {}
+
+func (x *ExpireLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireLoanRequest.ProtoReflect.Descriptor instead.
+func (*ExpireLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExpireLoanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Borrower struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	IdNumber      string                 `protobuf:"bytes,5,opt,name=id_number,json=idNumber,proto3" json:"id_number,omitempty"`
+	CreditLimit   *float64               `protobuf:"fixed64,6,opt,name=credit_limit,json=creditLimit,proto3,oneof" json:"credit_limit,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Borrower) Reset() {
+	*x = Borrower{}
+	mi := &file_loan_v1_loan_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Borrower) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Borrower) ProtoMessage() {}
+
+func (x *Borrower) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Borrower.ProtoReflect.Descriptor instead.
+func (*Borrower) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Borrower) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Borrower) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Borrower) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Borrower) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Borrower) GetIdNumber() string {
+	if x != nil {
+		return x.IdNumber
+	}
+	return ""
+}
+
+func (x *Borrower) GetCreditLimit() float64 {
+	if x != nil && x.CreditLimit != nil {
+		return *x.CreditLimit
+	}
+	return 0
+}
+
+func (x *Borrower) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Borrower) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Lender struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	IdNumber      string                 `protobuf:"bytes,5,opt,name=id_number,json=idNumber,proto3" json:"id_number,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lender) Reset() {
+	*x = Lender{}
+	mi := &file_loan_v1_loan_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lender) ProtoMessage() {}
+
+func (x *Lender) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lender.ProtoReflect.Descriptor instead.
+func (*Lender) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Lender) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Lender) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Lender) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Lender) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Lender) GetIdNumber() string {
+	if x != nil {
+		return x.IdNumber
+	}
+	return ""
+}
+
+func (x *Lender) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Lender) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Employee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	IdNumber      string                 `protobuf:"bytes,5,opt,name=id_number,json=idNumber,proto3" json:"id_number,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Employee) Reset() {
+	*x = Employee{}
+	mi := &file_loan_v1_loan_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Employee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Employee) ProtoMessage() {}
+
+func (x *Employee) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Employee.ProtoReflect.Descriptor instead.
+func (*Employee) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Employee) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Employee) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Employee) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Employee) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Employee) GetIdNumber() string {
+	if x != nil {
+		return x.IdNumber
+	}
+	return ""
+}
+
+func (x *Employee) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Employee) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreatePartyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullName      string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	IdNumber      string                 `protobuf:"bytes,4,opt,name=id_number,json=idNumber,proto3" json:"id_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartyRequest) Reset() {
+	*x = CreatePartyRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartyRequest) ProtoMessage() {}
+
+func (x *CreatePartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartyRequest.ProtoReflect.Descriptor instead.
+func (*CreatePartyRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreatePartyRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *CreatePartyRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreatePartyRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *CreatePartyRequest) GetIdNumber() string {
+	if x != nil {
+		return x.IdNumber
+	}
+	return ""
+}
+
+type CreateBorrowerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullName      string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	IdNumber      string                 `protobuf:"bytes,4,opt,name=id_number,json=idNumber,proto3" json:"id_number,omitempty"`
+	CreditLimit   *float64               `protobuf:"fixed64,5,opt,name=credit_limit,json=creditLimit,proto3,oneof" json:"credit_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBorrowerRequest) Reset() {
+	*x = CreateBorrowerRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBorrowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBorrowerRequest) ProtoMessage() {}
+
+func (x *CreateBorrowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBorrowerRequest.ProtoReflect.Descriptor instead.
+func (*CreateBorrowerRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateBorrowerRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *CreateBorrowerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateBorrowerRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *CreateBorrowerRequest) GetIdNumber() string {
+	if x != nil {
+		return x.IdNumber
+	}
+	return ""
+}
+
+func (x *CreateBorrowerRequest) GetCreditLimit() float64 {
+	if x != nil && x.CreditLimit != nil {
+		return *x.CreditLimit
+	}
+	return 0
+}
+
+type UpdateCreditLimitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The limit is removed when unset
+	CreditLimit   *float64 `protobuf:"fixed64,2,opt,name=credit_limit,json=creditLimit,proto3,oneof" json:"credit_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCreditLimitRequest) Reset() {
+	*x = UpdateCreditLimitRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCreditLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCreditLimitRequest) ProtoMessage() {}
+
+func (x *UpdateCreditLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCreditLimitRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCreditLimitRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateCreditLimitRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCreditLimitRequest) GetCreditLimit() float64 {
+	if x != nil && x.CreditLimit != nil {
+		return *x.CreditLimit
+	}
+	return 0
+}
+
+type GetPartyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartyRequest) Reset() {
+	*x = GetPartyRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartyRequest) ProtoMessage() {}
+
+func (x *GetPartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartyRequest.ProtoReflect.Descriptor instead.
+func (*GetPartyRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPartyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListPartiesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Matches name, email, phone number or ID number partially
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page          int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartiesRequest) Reset() {
+	*x = ListPartiesRequest{}
+	mi := &file_loan_v1_loan_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartiesRequest) ProtoMessage() {}
+
+func (x *ListPartiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartiesRequest.ProtoReflect.Descriptor instead.
+func (*ListPartiesRequest) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListPartiesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListPartiesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPartiesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListBorrowersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Borrowers     []*Borrower            `protobuf:"bytes,1,rep,name=borrowers,proto3" json:"borrowers,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBorrowersResponse) Reset() {
+	*x = ListBorrowersResponse{}
+	mi := &file_loan_v1_loan_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBorrowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBorrowersResponse) ProtoMessage() {}
+
+func (x *ListBorrowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBorrowersResponse.ProtoReflect.Descriptor instead.
+func (*ListBorrowersResponse) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListBorrowersResponse) GetBorrowers() []*Borrower {
+	if x != nil {
+		return x.Borrowers
+	}
+	return nil
+}
+
+func (x *ListBorrowersResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListLendersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lenders       []*Lender              `protobuf:"bytes,1,rep,name=lenders,proto3" json:"lenders,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLendersResponse) Reset() {
+	*x = ListLendersResponse{}
+	mi := &file_loan_v1_loan_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLendersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLendersResponse) ProtoMessage() {}
+
+func (x *ListLendersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLendersResponse.ProtoReflect.Descriptor instead.
+func (*ListLendersResponse) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListLendersResponse) GetLenders() []*Lender {
+	if x != nil {
+		return x.Lenders
+	}
+	return nil
+}
+
+func (x *ListLendersResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployeesResponse) Reset() {
+	*x = ListEmployeesResponse{}
+	mi := &file_loan_v1_loan_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeesResponse) ProtoMessage() {}
+
+func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_v1_loan_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ListEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_loan_v1_loan_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListEmployeesResponse) GetEmployees() []*Employee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+func (x *ListEmployeesResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_loan_v1_loan_proto protoreflect.FileDescriptor
+
+const file_loan_v1_loan_proto_rawDesc = "" +
+	"\n" +
+	"\x12loan/v1/loan.proto\x12\aloan.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8e\x01\n" +
+	"\n" +
+	"Pagination\x12!\n" +
+	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vtotal_items\x18\x03 \x01(\x03R\n" +
+	"totalItems\x12\x1f\n" +
+	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
+	"totalPages\"\xbb\x01\n" +
+	"\x10StatusTransition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12.\n" +
+	"\x04date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12!\n" +
+	"\fperformed_by\x18\x06 \x01(\tR\vperformedBy\"\x94\b\n" +
+	"\x04Loan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vborrower_id\x18\x02 \x01(\tR\n" +
+	"borrowerId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\x12\x10\n" +
+	"\x03roi\x18\x05 \x01(\x01R\x03roi\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x121\n" +
+	"\x12survey_document_id\x18\a \x01(\tH\x00R\x10surveyDocumentId\x88\x01\x01\x12?\n" +
+	"\rapproval_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fapprovalDate\x12$\n" +
+	"\vapproved_by\x18\t \x01(\tH\x01R\n" +
+	"approvedBy\x88\x01\x01\x12C\n" +
+	"\x0finvestment_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0einvestmentDate\x12G\n" +
+	"\x11disbursement_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x10disbursementDate\x12&\n" +
+	"\fdisbursed_by\x18\f \x01(\tH\x02R\vdisbursedBy\x88\x01\x01\x127\n" +
+	"\x15agreement_document_id\x18\r \x01(\tH\x03R\x13agreementDocumentId\x88\x01\x01\x12H\n" +
+	"\x12status_transitions\x18\x0e \x03(\v2\x19.loan.v1.StatusTransitionR\x11statusTransitions\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
+	"\ftenor_months\x18\x11 \x01(\x05R\vtenorMonths\x12'\n" +
+	"\x0finterest_method\x18\x12 \x01(\tR\x0einterestMethod\x12\x1b\n" +
+	"\tday_count\x18\x13 \x01(\tR\bdayCount\x12%\n" +
+	"\x0etotal_interest\x18\x14 \x01(\x01R\rtotalInterest\x12'\n" +
+	"\x0ftotal_repayment\x18\x15 \x01(\x01R\x0etotalRepayment\x12\"\n" +
+	"\n" +
+	"product_id\x18\x16 \x01(\tH\x04R\tproductId\x88\x01\x01B\x15\n" +
+	"\x13_survey_document_idB\x0e\n" +
+	"\f_approved_byB\x0f\n" +
+	"\r_disbursed_byB\x18\n" +
+	"\x16_agreement_document_idB\r\n" +
+	"\v_product_id\"\xfa\x01\n" +
+	"\x11CreateLoanRequest\x12\x1f\n" +
+	"\vborrower_id\x18\x01 \x01(\tR\n" +
+	"borrowerId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12\x10\n" +
+	"\x03roi\x18\x04 \x01(\x01R\x03roi\x12!\n" +
+	"\ftenor_months\x18\x05 \x01(\x05R\vtenorMonths\x12'\n" +
+	"\x0finterest_method\x18\x06 \x01(\tR\x0einterestMethod\x12\x1b\n" +
+	"\tday_count\x18\a \x01(\tR\bdayCount\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\b \x01(\tR\tproductId\" \n" +
+	"\x0eGetLoanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x87\x02\n" +
+	"\x10ListLoansRequest\x12$\n" +
+	"\vborrower_id\x18\x01 \x01(\tH\x00R\n" +
+	"borrowerId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tH\x01R\x06status\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"min_amount\x18\x03 \x01(\x01H\x02R\tminAmount\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_amount\x18\x04 \x01(\x01H\x03R\tmaxAmount\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSizeB\x0e\n" +
+	"\f_borrower_idB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_min_amountB\r\n" +
+	"\v_max_amount\"m\n" +
+	"\x11ListLoansResponse\x12#\n" +
+	"\x05loans\x18\x01 \x03(\v2\r.loan.v1.LoanR\x05loans\x123\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x13.loan.v1.PaginationR\n" +
+	"pagination\"s\n" +
+	"\x12ApproveLoanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x14approval_employee_id\x18\x02 \x01(\tR\x12approvalEmployeeId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\"X\n" +
+	"\x11InvestLoanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tlender_id\x18\x02 \x01(\tR\blenderId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\"\xd6\x01\n" +
+	"\x12InvestLoanResponse\x12!\n" +
+	"\x04loan\x18\x01 \x01(\v2\r.loan.v1.LoanR\x04loan\x12)\n" +
+	"\x10remaining_amount\x18\x02 \x01(\x01R\x0fremainingAmount\x12'\n" +
+	"\x0finvested_amount\x18\x03 \x01(\x01R\x0einvestedAmount\x122\n" +
+	"\x12agreement_document\x18\x04 \x01(\tH\x00R\x11agreementDocument\x88\x01\x01B\x15\n" +
+	"\x13_agreement_document\"\x7f\n" +
+	"\x13DisburseLoanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x10field_officer_id\x18\x02 \x01(\tR\x0efieldOfficerId\x12.\n" +
+	"\x13agreement_file_name\x18\x03 \x01(\tR\x11agreementFileName\"\xc2\x01\n" +
+	"\x14DisburseLoanResponse\x12!\n" +
+	"\x04loan\x18\x01 \x01(\v2\r.loan.v1.LoanR\x04loan\x12-\n" +
+	"\x12borrower_repayment\x18\x02 \x01(\x01R\x11borrowerRepayment\x12!\n" +
+	"\finvestor_roi\x18\x03 \x01(\x01R\vinvestorRoi\x125\n" +
+	"\tinvestors\x18\x04 \x03(\v2\x17.loan.v1.InvestorPayoutR\tinvestors\"\xab\x01\n" +
+	"\x0eInvestorPayout\x12#\n" +
+	"\rinvestment_id\x18\x01 \x01(\tR\finvestmentId\x12\x1b\n" +
+	"\tlender_id\x18\x02 \x01(\tR\blenderId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12'\n" +
+	"\x0fexpected_return\x18\x04 \x01(\x01R\x0eexpectedReturn\x12\x16\n" +
+	"\x06payout\x18\x05 \x01(\x01R\x06payout\"\\\n" +
+	"\x11RejectLoanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrejected_by\x18\x02 \x01(\tR\n" +
+	"rejectedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"^\n" +
+	"\x11CancelLoanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fcancelled_by\x18\x02 \x01(\tR\vcancelledBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"#\n" +
+	"\x11ExpireLoanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xbc\x02\n" +
+	"\bBorrower\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12!\n" +
+	"\fphone_number\x18\x04 \x01(\tR\vphoneNumber\x12\x1b\n" +
+	"\tid_number\x18\x05 \x01(\tR\bidNumber\x12&\n" +
+	"\fcredit_limit\x18\x06 \x01(\x01H\x00R\vcreditLimit\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0f\n" +
+	"\r_credit_limit\"\x81\x02\n" +
+	"\x06Lender\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12!\n" +
+	"\fphone_number\x18\x04 \x01(\tR\vphoneNumber\x12\x1b\n" +
+	"\tid_number\x18\x05 \x01(\tR\bidNumber\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x83\x02\n" +
+	"\bEmployee\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12!\n" +
+	"\fphone_number\x18\x04 \x01(\tR\vphoneNumber\x12\x1b\n" +
+	"\tid_number\x18\x05 \x01(\tR\bidNumber\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x87\x01\n" +
+	"\x12CreatePartyRequest\x12\x1b\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12!\n" +
+	"\fphone_number\x18\x03 \x01(\tR\vphoneNumber\x12\x1b\n" +
+	"\tid_number\x18\x04 \x01(\tR\bidNumber\"\xc3\x01\n" +
+	"\x15CreateBorrowerRequest\x12\x1b\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12!\n" +
+	"\fphone_number\x18\x03 \x01(\tR\vphoneNumber\x12\x1b\n" +
+	"\tid_number\x18\x04 \x01(\tR\bidNumber\x12&\n" +
+	"\fcredit_limit\x18\x05 \x01(\x01H\x00R\vcreditLimit\x88\x01\x01B\x0f\n" +
+	"\r_credit_limit\"c\n" +
+	"\x18UpdateCreditLimitRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\fcredit_limit\x18\x02 \x01(\x01H\x00R\vcreditLimit\x88\x01\x01B\x0f\n" +
+	"\r_credit_limit\"!\n" +
+	"\x0fGetPartyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"[\n" +
+	"\x12ListPartiesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"}\n" +
+	"\x15ListBorrowersResponse\x12/\n" +
+	"\tborrowers\x18\x01 \x03(\v2\x11.loan.v1.BorrowerR\tborrowers\x123\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x13.loan.v1.PaginationR\n" +
+	"pagination\"u\n" +
+	"\x13ListLendersResponse\x12)\n" +
+	"\alenders\x18\x01 \x03(\v2\x0f.loan.v1.LenderR\alenders\x123\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x13.loan.v1.PaginationR\n" +
+	"pagination\"}\n" +
+	"\x15ListEmployeesResponse\x12/\n" +
+	"\temployees\x18\x01 \x03(\v2\x11.loan.v1.EmployeeR\temployees\x123\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x13.loan.v1.PaginationR\n" +
+	"pagination2\xb7\x04\n" +
+	"\vLoanService\x127\n" +
+	"\n" +
+	"CreateLoan\x12\x1a.loan.v1.CreateLoanRequest\x1a\r.loan.v1.Loan\x121\n" +
+	"\aGetLoan\x12\x17.loan.v1.GetLoanRequest\x1a\r.loan.v1.Loan\x12B\n" +
+	"\tListLoans\x12\x19.loan.v1.ListLoansRequest\x1a\x1a.loan.v1.ListLoansResponse\x129\n" +
+	"\vApproveLoan\x12\x1b.loan.v1.ApproveLoanRequest\x1a\r.loan.v1.Loan\x12E\n" +
+	"\n" +
+	"InvestLoan\x12\x1a.loan.v1.InvestLoanRequest\x1a\x1b.loan.v1.InvestLoanResponse\x12K\n" +
+	"\fDisburseLoan\x12\x1c.loan.v1.DisburseLoanRequest\x1a\x1d.loan.v1.DisburseLoanResponse\x127\n" +
+	"\n" +
+	"RejectLoan\x12\x1a.loan.v1.RejectLoanRequest\x1a\r.loan.v1.Loan\x127\n" +
+	"\n" +
+	"CancelLoan\x12\x1a.loan.v1.CancelLoanRequest\x1a\r.loan.v1.Loan\x127\n" +
+	"\n" +
+	"ExpireLoan\x12\x1a.loan.v1.ExpireLoanRequest\x1a\r.loan.v1.Loan2\xb4\x05\n" +
+	"\fPartyService\x12C\n" +
+	"\x0eCreateBorrower\x12\x1e.loan.v1.CreateBorrowerRequest\x1a\x11.loan.v1.Borrower\x12:\n" +
+	"\vGetBorrower\x12\x18.loan.v1.GetPartyRequest\x1a\x11.loan.v1.Borrower\x12L\n" +
+	"\rListBorrowers\x12\x1b.loan.v1.ListPartiesRequest\x1a\x1e.loan.v1.ListBorrowersResponse\x12I\n" +
+	"\x11UpdateCreditLimit\x12!.loan.v1.UpdateCreditLimitRequest\x1a\x11.loan.v1.Borrower\x12<\n" +
+	"\fCreateLender\x12\x1b.loan.v1.CreatePartyRequest\x1a\x0f.loan.v1.Lender\x126\n" +
+	"\tGetLender\x12\x18.loan.v1.GetPartyRequest\x1a\x0f.loan.v1.Lender\x12H\n" +
+	"\vListLenders\x12\x1b.loan.v1.ListPartiesRequest\x1a\x1c.loan.v1.ListLendersResponse\x12@\n" +
+	"\x0eCreateEmployee\x12\x1b.loan.v1.CreatePartyRequest\x1a\x11.loan.v1.Employee\x12:\n" +
+	"\vGetEmployee\x12\x18.loan.v1.GetPartyRequest\x1a\x11.loan.v1.Employee\x12L\n" +
+	"\rListEmployees\x12\x1b.loan.v1.ListPartiesRequest\x1a\x1e.loan.v1.ListEmployeesResponseBTZRgithub.com/theodorusyoga/loan-service-state-machine/internal/api/rpc/loanv1;loanv1b\x06proto3"
+
+var (
+	file_loan_v1_loan_proto_rawDescOnce sync.Once
+	file_loan_v1_loan_proto_rawDescData []byte
+)
+
+func file_loan_v1_loan_proto_rawDescGZIP() []byte {
+	file_loan_v1_loan_proto_rawDescOnce.Do(func() {
+		file_loan_v1_loan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_loan_v1_loan_proto_rawDesc), len(file_loan_v1_loan_proto_rawDesc)))
+	})
+	return file_loan_v1_loan_proto_rawDescData
+}
+
+var file_loan_v1_loan_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_loan_v1_loan_proto_goTypes = []any{
+	(*Pagination)(nil),               // 0: loan.v1.Pagination
+	(*StatusTransition)(nil),         // 1: loan.v1.StatusTransition
+	(*Loan)(nil),                     // 2: loan.v1.Loan
+	(*CreateLoanRequest)(nil),        // 3: loan.v1.CreateLoanRequest
+	(*GetLoanRequest)(nil),           // 4: loan.v1.GetLoanRequest
+	(*ListLoansRequest)(nil),         // 5: loan.v1.ListLoansRequest
+	(*ListLoansResponse)(nil),        // 6: loan.v1.ListLoansResponse
+	(*ApproveLoanRequest)(nil),       // 7: loan.v1.ApproveLoanRequest
+	(*InvestLoanRequest)(nil),        // 8: loan.v1.InvestLoanRequest
+	(*InvestLoanResponse)(nil),       // 9: loan.v1.InvestLoanResponse
+	(*DisburseLoanRequest)(nil),      // 10: loan.v1.DisburseLoanRequest
+	(*DisburseLoanResponse)(nil),     // 11: loan.v1.DisburseLoanResponse
+	(*InvestorPayout)(nil),           // 12: loan.v1.InvestorPayout
+	(*RejectLoanRequest)(nil),        // 13: loan.v1.RejectLoanRequest
+	(*CancelLoanRequest)(nil),        // 14: loan.v1.CancelLoanRequest
+	(*ExpireLoanRequest)(nil),        // 15: loan.v1.ExpireLoanRequest
+	(*Borrower)(nil),                 // 16: loan.v1.Borrower
+	(*Lender)(nil),                   // 17: loan.v1.Lender
+	(*Employee)(nil),                 // 18: loan.v1.Employee
+	(*CreatePartyRequest)(nil),       // 19: loan.v1.CreatePartyRequest
+	(*CreateBorrowerRequest)(nil),    // 20: loan.v1.CreateBorrowerRequest
+	(*UpdateCreditLimitRequest)(nil), // 21: loan.v1.UpdateCreditLimitRequest
+	(*GetPartyRequest)(nil),          // 22: loan.v1.GetPartyRequest
+	(*ListPartiesRequest)(nil),       // 23: loan.v1.ListPartiesRequest
+	(*ListBorrowersResponse)(nil),    // 24: loan.v1.ListBorrowersResponse
+	(*ListLendersResponse)(nil),      // 25: loan.v1.ListLendersResponse
+	(*ListEmployeesResponse)(nil),    // 26: loan.v1.ListEmployeesResponse
+	(*timestamppb.Timestamp)(nil),    // 27: google.protobuf.Timestamp
+}
+var file_loan_v1_loan_proto_depIdxs = []int32{
+	27, // 0: loan.v1.StatusTransition.date:type_name -> google.protobuf.Timestamp
+	27, // 1: loan.v1.Loan.approval_date:type_name -> google.protobuf.Timestamp
+	27, // 2: loan.v1.Loan.investment_date:type_name -> google.protobuf.Timestamp
+	27, // 3: loan.v1.Loan.disbursement_date:type_name -> google.protobuf.Timestamp
+	1,  // 4: loan.v1.Loan.status_transitions:type_name -> loan.v1.StatusTransition
+	27, // 5: loan.v1.Loan.created_at:type_name -> google.protobuf.Timestamp
+	27, // 6: loan.v1.Loan.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: loan.v1.ListLoansResponse.loans:type_name -> loan.v1.Loan
+	0,  // 8: loan.v1.ListLoansResponse.pagination:type_name -> loan.v1.Pagination
+	2,  // 9: loan.v1.InvestLoanResponse.loan:type_name -> loan.v1.Loan
+	2,  // 10: loan.v1.DisburseLoanResponse.loan:type_name -> loan.v1.Loan
+	12, // 11: loan.v1.DisburseLoanResponse.investors:type_name -> loan.v1.InvestorPayout
+	27, // 12: loan.v1.Borrower.created_at:type_name -> google.protobuf.Timestamp
+	27, // 13: loan.v1.Borrower.updated_at:type_name -> google.protobuf.Timestamp
+	27, // 14: loan.v1.Lender.created_at:type_name -> google.protobuf.Timestamp
+	27, // 15: loan.v1.Lender.updated_at:type_name -> google.protobuf.Timestamp
+	27, // 16: loan.v1.Employee.created_at:type_name -> google.protobuf.Timestamp
+	27, // 17: loan.v1.Employee.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 18: loan.v1.ListBorrowersResponse.borrowers:type_name -> loan.v1.Borrower
+	0,  // 19: loan.v1.ListBorrowersResponse.pagination:type_name -> loan.v1.Pagination
+	17, // 20: loan.v1.ListLendersResponse.lenders:type_name -> loan.v1.Lender
+	0,  // 21: loan.v1.ListLendersResponse.pagination:type_name -> loan.v1.Pagination
+	18, // 22: loan.v1.ListEmployeesResponse.employees:type_name -> loan.v1.Employee
+	0,  // 23: loan.v1.ListEmployeesResponse.pagination:type_name -> loan.v1.Pagination
+	3,  // 24: loan.v1.LoanService.CreateLoan:input_type -> loan.v1.CreateLoanRequest
+	4,  // 25: loan.v1.LoanService.GetLoan:input_type -> loan.v1.GetLoanRequest
+	5,  // 26: loan.v1.LoanService.ListLoans:input_type -> loan.v1.ListLoansRequest
+	7,  // 27: loan.v1.LoanService.ApproveLoan:input_type -> loan.v1.ApproveLoanRequest
+	8,  // 28: loan.v1.LoanService.InvestLoan:input_type -> loan.v1.InvestLoanRequest
+	10, // 29: loan.v1.LoanService.DisburseLoan:input_type -> loan.v1.DisburseLoanRequest
+	13, // 30: loan.v1.LoanService.RejectLoan:input_type -> loan.v1.RejectLoanRequest
+	14, // 31: loan.v1.LoanService.CancelLoan:input_type -> loan.v1.CancelLoanRequest
+	15, // 32: loan.v1.LoanService.ExpireLoan:input_type -> loan.v1.ExpireLoanRequest
+	20, // 33: loan.v1.PartyService.CreateBorrower:input_type -> loan.v1.CreateBorrowerRequest
+	22, // 34: loan.v1.PartyService.GetBorrower:input_type -> loan.v1.GetPartyRequest
+	23, // 35: loan.v1.PartyService.ListBorrowers:input_type -> loan.v1.ListPartiesRequest
+	21, // 36: loan.v1.PartyService.UpdateCreditLimit:input_type -> loan.v1.UpdateCreditLimitRequest
+	19, // 37: loan.v1.PartyService.CreateLender:input_type -> loan.v1.CreatePartyRequest
+	22, // 38: loan.v1.PartyService.GetLender:input_type -> loan.v1.GetPartyRequest
+	23, // 39: loan.v1.PartyService.ListLenders:input_type -> loan.v1.ListPartiesRequest
+	19, // 40: loan.v1.PartyService.CreateEmployee:input_type -> loan.v1.CreatePartyRequest
+	22, // 41: loan.v1.PartyService.GetEmployee:input_type -> loan.v1.GetPartyRequest
+	23, // 42: loan.v1.PartyService.ListEmployees:input_type -> loan.v1.ListPartiesRequest
+	2,  // 43: loan.v1.LoanService.CreateLoan:output_type -> loan.v1.Loan
+	2,  // 44: loan.v1.LoanService.GetLoan:output_type -> loan.v1.Loan
+	6,  // 45: loan.v1.LoanService.ListLoans:output_type -> loan.v1.ListLoansResponse
+	2,  // 46: loan.v1.LoanService.ApproveLoan:output_type -> loan.v1.Loan
+	9,  // 47: loan.v1.LoanService.InvestLoan:output_type -> loan.v1.InvestLoanResponse
+	11, // 48: loan.v1.LoanService.DisburseLoan:output_type -> loan.v1.DisburseLoanResponse
+	2,  // 49: loan.v1.LoanService.RejectLoan:output_type -> loan.v1.Loan
+	2,  // 50: loan.v1.LoanService.CancelLoan:output_type -> loan.v1.Loan
+	2,  // 51: loan.v1.LoanService.ExpireLoan:output_type -> loan.v1.Loan
+	16, // 52: loan.v1.PartyService.CreateBorrower:output_type -> loan.v1.Borrower
+	16, // 53: loan.v1.PartyService.GetBorrower:output_type -> loan.v1.Borrower
+	24, // 54: loan.v1.PartyService.ListBorrowers:output_type -> loan.v1.ListBorrowersResponse
+	16, // 55: loan.v1.PartyService.UpdateCreditLimit:output_type -> loan.v1.Borrower
+	17, // 56: loan.v1.PartyService.CreateLender:output_type -> loan.v1.Lender
+	17, // 57: loan.v1.PartyService.GetLender:output_type -> loan.v1.Lender
+	25, // 58: loan.v1.PartyService.ListLenders:output_type -> loan.v1.ListLendersResponse
+	18, // 59: loan.v1.PartyService.CreateEmployee:output_type -> loan.v1.Employee
+	18, // 60: loan.v1.PartyService.GetEmployee:output_type -> loan.v1.Employee
+	26, // 61: loan.v1.PartyService.ListEmployees:output_type -> loan.v1.ListEmployeesResponse
+	43, // [43:62] is the sub-list for method output_type
+	24, // [24:43] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_loan_v1_loan_proto_init() }
+func file_loan_v1_loan_proto_init() {
+	if File_loan_v1_loan_proto != nil {
+		return
+	}
+	file_loan_v1_loan_proto_msgTypes[2].OneofWrappers = []any{}
+	file_loan_v1_loan_proto_msgTypes[5].OneofWrappers = []any{}
+	file_loan_v1_loan_proto_msgTypes[9].OneofWrappers = []any{}
+	file_loan_v1_loan_proto_msgTypes[16].OneofWrappers = []any{}
+	file_loan_v1_loan_proto_msgTypes[20].OneofWrappers = []any{}
+	file_loan_v1_loan_proto_msgTypes[21].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_loan_v1_loan_proto_rawDesc), len(file_loan_v1_loan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_loan_v1_loan_proto_goTypes,
+		DependencyIndexes: file_loan_v1_loan_proto_depIdxs,
+		MessageInfos:      file_loan_v1_loan_proto_msgTypes,
+	}.Build()
+	File_loan_v1_loan_proto = out.File
+	file_loan_v1_loan_proto_goTypes = nil
+	file_loan_v1_loan_proto_depIdxs = nil
+}
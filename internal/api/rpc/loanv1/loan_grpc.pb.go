@@ -0,0 +1,877 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: loan/v1/loan.proto
+
+package loanv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LoanService_CreateLoan_FullMethodName   = "/loan.v1.LoanService/CreateLoan"
+	LoanService_GetLoan_FullMethodName      = "/loan.v1.LoanService/GetLoan"
+	LoanService_ListLoans_FullMethodName    = "/loan.v1.LoanService/ListLoans"
+	LoanService_ApproveLoan_FullMethodName  = "/loan.v1.LoanService/ApproveLoan"
+	LoanService_InvestLoan_FullMethodName   = "/loan.v1.LoanService/InvestLoan"
+	LoanService_DisburseLoan_FullMethodName = "/loan.v1.LoanService/DisburseLoan"
+	LoanService_RejectLoan_FullMethodName   = "/loan.v1.LoanService/RejectLoan"
+	LoanService_CancelLoan_FullMethodName   = "/loan.v1.LoanService/CancelLoan"
+	LoanService_ExpireLoan_FullMethodName   = "/loan.v1.LoanService/ExpireLoan"
+)
+
+// LoanServiceClient is the client API for LoanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// LoanService manages loans and their state machine transitions
+type LoanServiceClient interface {
+	CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
+	ApproveLoan(ctx context.Context, in *ApproveLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	InvestLoan(ctx context.Context, in *InvestLoanRequest, opts ...grpc.CallOption) (*InvestLoanResponse, error)
+	DisburseLoan(ctx context.Context, in *DisburseLoanRequest, opts ...grpc.CallOption) (*DisburseLoanResponse, error)
+	RejectLoan(ctx context.Context, in *RejectLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	CancelLoan(ctx context.Context, in *CancelLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	ExpireLoan(ctx context.Context, in *ExpireLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+}
+
+type loanServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLoanServiceClient(cc grpc.ClientConnInterface) LoanServiceClient {
+	return &loanServiceClient{cc}
+}
+
+func (c *loanServiceClient) CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, LoanService_CreateLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, LoanService_GetLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoansResponse)
+	err := c.cc.Invoke(ctx, LoanService_ListLoans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ApproveLoan(ctx context.Context, in *ApproveLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, LoanService_ApproveLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) InvestLoan(ctx context.Context, in *InvestLoanRequest, opts ...grpc.CallOption) (*InvestLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvestLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_InvestLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) DisburseLoan(ctx context.Context, in *DisburseLoanRequest, opts ...grpc.CallOption) (*DisburseLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisburseLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_DisburseLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) RejectLoan(ctx context.Context, in *RejectLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, LoanService_RejectLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) CancelLoan(ctx context.Context, in *CancelLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, LoanService_CancelLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ExpireLoan(ctx context.Context, in *ExpireLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, LoanService_ExpireLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LoanServiceServer is the server API for LoanService service.
+// All implementations must embed UnimplementedLoanServiceServer
+// for forward compatibility.
+//
+// LoanService manages loans and their state machine transitions
+type LoanServiceServer interface {
+	CreateLoan(context.Context, *CreateLoanRequest) (*Loan, error)
+	GetLoan(context.Context, *GetLoanRequest) (*Loan, error)
+	ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error)
+	ApproveLoan(context.Context, *ApproveLoanRequest) (*Loan, error)
+	InvestLoan(context.Context, *InvestLoanRequest) (*InvestLoanResponse, error)
+	DisburseLoan(context.Context, *DisburseLoanRequest) (*DisburseLoanResponse, error)
+	RejectLoan(context.Context, *RejectLoanRequest) (*Loan, error)
+	CancelLoan(context.Context, *CancelLoanRequest) (*Loan, error)
+	ExpireLoan(context.Context, *ExpireLoanRequest) (*Loan, error)
+	mustEmbedUnimplementedLoanServiceServer()
+}
+
+// UnimplementedLoanServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLoanServiceServer struct{}
+
+func (UnimplementedLoanServiceServer) CreateLoan(context.Context, *CreateLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) GetLoan(context.Context, *GetLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoans not implemented")
+}
+func (UnimplementedLoanServiceServer) ApproveLoan(context.Context, *ApproveLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) InvestLoan(context.Context, *InvestLoanRequest) (*InvestLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvestLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) DisburseLoan(context.Context, *DisburseLoanRequest) (*DisburseLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisburseLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) RejectLoan(context.Context, *RejectLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) CancelLoan(context.Context, *CancelLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) ExpireLoan(context.Context, *ExpireLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) mustEmbedUnimplementedLoanServiceServer() {}
+func (UnimplementedLoanServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeLoanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LoanServiceServer will
+// result in compilation errors.
+type UnsafeLoanServiceServer interface {
+	mustEmbedUnimplementedLoanServiceServer()
+}
+
+func RegisterLoanServiceServer(s grpc.ServiceRegistrar, srv LoanServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLoanServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LoanService_ServiceDesc, srv)
+}
+
+func _LoanService_CreateLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).CreateLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_CreateLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).CreateLoan(ctx, req.(*CreateLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_GetLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_GetLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetLoan(ctx, req.(*GetLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ListLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ListLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ListLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ListLoans(ctx, req.(*ListLoansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ApproveLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ApproveLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ApproveLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ApproveLoan(ctx, req.(*ApproveLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_InvestLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvestLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).InvestLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_InvestLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).InvestLoan(ctx, req.(*InvestLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_DisburseLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisburseLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).DisburseLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_DisburseLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).DisburseLoan(ctx, req.(*DisburseLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_RejectLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).RejectLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_RejectLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).RejectLoan(ctx, req.(*RejectLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_CancelLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).CancelLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_CancelLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).CancelLoan(ctx, req.(*CancelLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ExpireLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ExpireLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ExpireLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ExpireLoan(ctx, req.(*ExpireLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LoanService_ServiceDesc is the grpc.ServiceDesc for LoanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LoanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "loan.v1.LoanService",
+	HandlerType: (*LoanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLoan",
+			Handler:    _LoanService_CreateLoan_Handler,
+		},
+		{
+			MethodName: "GetLoan",
+			Handler:    _LoanService_GetLoan_Handler,
+		},
+		{
+			MethodName: "ListLoans",
+			Handler:    _LoanService_ListLoans_Handler,
+		},
+		{
+			MethodName: "ApproveLoan",
+			Handler:    _LoanService_ApproveLoan_Handler,
+		},
+		{
+			MethodName: "InvestLoan",
+			Handler:    _LoanService_InvestLoan_Handler,
+		},
+		{
+			MethodName: "DisburseLoan",
+			Handler:    _LoanService_DisburseLoan_Handler,
+		},
+		{
+			MethodName: "RejectLoan",
+			Handler:    _LoanService_RejectLoan_Handler,
+		},
+		{
+			MethodName: "CancelLoan",
+			Handler:    _LoanService_CancelLoan_Handler,
+		},
+		{
+			MethodName: "ExpireLoan",
+			Handler:    _LoanService_ExpireLoan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "loan/v1/loan.proto",
+}
+
+const (
+	PartyService_CreateBorrower_FullMethodName    = "/loan.v1.PartyService/CreateBorrower"
+	PartyService_GetBorrower_FullMethodName       = "/loan.v1.PartyService/GetBorrower"
+	PartyService_ListBorrowers_FullMethodName     = "/loan.v1.PartyService/ListBorrowers"
+	PartyService_UpdateCreditLimit_FullMethodName = "/loan.v1.PartyService/UpdateCreditLimit"
+	PartyService_CreateLender_FullMethodName      = "/loan.v1.PartyService/CreateLender"
+	PartyService_GetLender_FullMethodName         = "/loan.v1.PartyService/GetLender"
+	PartyService_ListLenders_FullMethodName       = "/loan.v1.PartyService/ListLenders"
+	PartyService_CreateEmployee_FullMethodName    = "/loan.v1.PartyService/CreateEmployee"
+	PartyService_GetEmployee_FullMethodName       = "/loan.v1.PartyService/GetEmployee"
+	PartyService_ListEmployees_FullMethodName     = "/loan.v1.PartyService/ListEmployees"
+)
+
+// PartyServiceClient is the client API for PartyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PartyService manages borrowers, lenders and employees
+type PartyServiceClient interface {
+	CreateBorrower(ctx context.Context, in *CreateBorrowerRequest, opts ...grpc.CallOption) (*Borrower, error)
+	GetBorrower(ctx context.Context, in *GetPartyRequest, opts ...grpc.CallOption) (*Borrower, error)
+	ListBorrowers(ctx context.Context, in *ListPartiesRequest, opts ...grpc.CallOption) (*ListBorrowersResponse, error)
+	UpdateCreditLimit(ctx context.Context, in *UpdateCreditLimitRequest, opts ...grpc.CallOption) (*Borrower, error)
+	CreateLender(ctx context.Context, in *CreatePartyRequest, opts ...grpc.CallOption) (*Lender, error)
+	GetLender(ctx context.Context, in *GetPartyRequest, opts ...grpc.CallOption) (*Lender, error)
+	ListLenders(ctx context.Context, in *ListPartiesRequest, opts ...grpc.CallOption) (*ListLendersResponse, error)
+	CreateEmployee(ctx context.Context, in *CreatePartyRequest, opts ...grpc.CallOption) (*Employee, error)
+	GetEmployee(ctx context.Context, in *GetPartyRequest, opts ...grpc.CallOption) (*Employee, error)
+	ListEmployees(ctx context.Context, in *ListPartiesRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error)
+}
+
+type partyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPartyServiceClient(cc grpc.ClientConnInterface) PartyServiceClient {
+	return &partyServiceClient{cc}
+}
+
+func (c *partyServiceClient) CreateBorrower(ctx context.Context, in *CreateBorrowerRequest, opts ...grpc.CallOption) (*Borrower, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Borrower)
+	err := c.cc.Invoke(ctx, PartyService_CreateBorrower_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyServiceClient) GetBorrower(ctx context.Context, in *GetPartyRequest, opts ...grpc.CallOption) (*Borrower, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Borrower)
+	err := c.cc.Invoke(ctx, PartyService_GetBorrower_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyServiceClient) ListBorrowers(ctx context.Context, in *ListPartiesRequest, opts ...grpc.CallOption) (*ListBorrowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBorrowersResponse)
+	err := c.cc.Invoke(ctx, PartyService_ListBorrowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyServiceClient) UpdateCreditLimit(ctx context.Context, in *UpdateCreditLimitRequest, opts ...grpc.CallOption) (*Borrower, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Borrower)
+	err := c.cc.Invoke(ctx, PartyService_UpdateCreditLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyServiceClient) CreateLender(ctx context.Context, in *CreatePartyRequest, opts ...grpc.CallOption) (*Lender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lender)
+	err := c.cc.Invoke(ctx, PartyService_CreateLender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyServiceClient) GetLender(ctx context.Context, in *GetPartyRequest, opts ...grpc.CallOption) (*Lender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lender)
+	err := c.cc.Invoke(ctx, PartyService_GetLender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyServiceClient) ListLenders(ctx context.Context, in *ListPartiesRequest, opts ...grpc.CallOption) (*ListLendersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLendersResponse)
+	err := c.cc.Invoke(ctx, PartyService_ListLenders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyServiceClient) CreateEmployee(ctx context.Context, in *CreatePartyRequest, opts ...grpc.CallOption) (*Employee, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Employee)
+	err := c.cc.Invoke(ctx, PartyService_CreateEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyServiceClient) GetEmployee(ctx context.Context, in *GetPartyRequest, opts ...grpc.CallOption) (*Employee, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Employee)
+	err := c.cc.Invoke(ctx, PartyService_GetEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyServiceClient) ListEmployees(ctx context.Context, in *ListPartiesRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeesResponse)
+	err := c.cc.Invoke(ctx, PartyService_ListEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PartyServiceServer is the server API for PartyService service.
+// All implementations must embed UnimplementedPartyServiceServer
+// for forward compatibility.
+//
+// PartyService manages borrowers, lenders and employees
+type PartyServiceServer interface {
+	CreateBorrower(context.Context, *CreateBorrowerRequest) (*Borrower, error)
+	GetBorrower(context.Context, *GetPartyRequest) (*Borrower, error)
+	ListBorrowers(context.Context, *ListPartiesRequest) (*ListBorrowersResponse, error)
+	UpdateCreditLimit(context.Context, *UpdateCreditLimitRequest) (*Borrower, error)
+	CreateLender(context.Context, *CreatePartyRequest) (*Lender, error)
+	GetLender(context.Context, *GetPartyRequest) (*Lender, error)
+	ListLenders(context.Context, *ListPartiesRequest) (*ListLendersResponse, error)
+	CreateEmployee(context.Context, *CreatePartyRequest) (*Employee, error)
+	GetEmployee(context.Context, *GetPartyRequest) (*Employee, error)
+	ListEmployees(context.Context, *ListPartiesRequest) (*ListEmployeesResponse, error)
+	mustEmbedUnimplementedPartyServiceServer()
+}
+
+// UnimplementedPartyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPartyServiceServer struct{}
+
+func (UnimplementedPartyServiceServer) CreateBorrower(context.Context, *CreateBorrowerRequest) (*Borrower, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBorrower not implemented")
+}
+func (UnimplementedPartyServiceServer) GetBorrower(context.Context, *GetPartyRequest) (*Borrower, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBorrower not implemented")
+}
+func (UnimplementedPartyServiceServer) ListBorrowers(context.Context, *ListPartiesRequest) (*ListBorrowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBorrowers not implemented")
+}
+func (UnimplementedPartyServiceServer) UpdateCreditLimit(context.Context, *UpdateCreditLimitRequest) (*Borrower, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCreditLimit not implemented")
+}
+func (UnimplementedPartyServiceServer) CreateLender(context.Context, *CreatePartyRequest) (*Lender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLender not implemented")
+}
+func (UnimplementedPartyServiceServer) GetLender(context.Context, *GetPartyRequest) (*Lender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLender not implemented")
+}
+func (UnimplementedPartyServiceServer) ListLenders(context.Context, *ListPartiesRequest) (*ListLendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLenders not implemented")
+}
+func (UnimplementedPartyServiceServer) CreateEmployee(context.Context, *CreatePartyRequest) (*Employee, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmployee not implemented")
+}
+func (UnimplementedPartyServiceServer) GetEmployee(context.Context, *GetPartyRequest) (*Employee, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmployee not implemented")
+}
+func (UnimplementedPartyServiceServer) ListEmployees(context.Context, *ListPartiesRequest) (*ListEmployeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmployees not implemented")
+}
+func (UnimplementedPartyServiceServer) mustEmbedUnimplementedPartyServiceServer() {}
+func (UnimplementedPartyServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafePartyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PartyServiceServer will
+// result in compilation errors.
+type UnsafePartyServiceServer interface {
+	mustEmbedUnimplementedPartyServiceServer()
+}
+
+func RegisterPartyServiceServer(s grpc.ServiceRegistrar, srv PartyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPartyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PartyService_ServiceDesc, srv)
+}
+
+func _PartyService_CreateBorrower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBorrowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServiceServer).CreateBorrower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartyService_CreateBorrower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServiceServer).CreateBorrower(ctx, req.(*CreateBorrowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartyService_GetBorrower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServiceServer).GetBorrower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartyService_GetBorrower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServiceServer).GetBorrower(ctx, req.(*GetPartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartyService_ListBorrowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPartiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServiceServer).ListBorrowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartyService_ListBorrowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServiceServer).ListBorrowers(ctx, req.(*ListPartiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartyService_UpdateCreditLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCreditLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServiceServer).UpdateCreditLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartyService_UpdateCreditLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServiceServer).UpdateCreditLimit(ctx, req.(*UpdateCreditLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartyService_CreateLender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServiceServer).CreateLender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartyService_CreateLender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServiceServer).CreateLender(ctx, req.(*CreatePartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartyService_GetLender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServiceServer).GetLender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartyService_GetLender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServiceServer).GetLender(ctx, req.(*GetPartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartyService_ListLenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPartiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServiceServer).ListLenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartyService_ListLenders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServiceServer).ListLenders(ctx, req.(*ListPartiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartyService_CreateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServiceServer).CreateEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartyService_CreateEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServiceServer).CreateEmployee(ctx, req.(*CreatePartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartyService_GetEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServiceServer).GetEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartyService_GetEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServiceServer).GetEmployee(ctx, req.(*GetPartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartyService_ListEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPartiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServiceServer).ListEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartyService_ListEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServiceServer).ListEmployees(ctx, req.(*ListPartiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PartyService_ServiceDesc is the grpc.ServiceDesc for PartyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PartyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "loan.v1.PartyService",
+	HandlerType: (*PartyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBorrower",
+			Handler:    _PartyService_CreateBorrower_Handler,
+		},
+		{
+			MethodName: "GetBorrower",
+			Handler:    _PartyService_GetBorrower_Handler,
+		},
+		{
+			MethodName: "ListBorrowers",
+			Handler:    _PartyService_ListBorrowers_Handler,
+		},
+		{
+			MethodName: "UpdateCreditLimit",
+			Handler:    _PartyService_UpdateCreditLimit_Handler,
+		},
+		{
+			MethodName: "CreateLender",
+			Handler:    _PartyService_CreateLender_Handler,
+		},
+		{
+			MethodName: "GetLender",
+			Handler:    _PartyService_GetLender_Handler,
+		},
+		{
+			MethodName: "ListLenders",
+			Handler:    _PartyService_ListLenders_Handler,
+		},
+		{
+			MethodName: "CreateEmployee",
+			Handler:    _PartyService_CreateEmployee_Handler,
+		},
+		{
+			MethodName: "GetEmployee",
+			Handler:    _PartyService_GetEmployee_Handler,
+		},
+		{
+			MethodName: "ListEmployees",
+			Handler:    _PartyService_ListEmployees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "loan/v1/loan.proto",
+}
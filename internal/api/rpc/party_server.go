@@ -0,0 +1,213 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/request"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/rpc/loanv1"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+)
+
+// PartyServer exposes the borrower, lender and employee services over gRPC
+type PartyServer struct {
+	loanv1.UnimplementedPartyServiceServer
+
+	borrowerService *borrower.BorrowerService
+	lenderService   *lender.LenderService
+	employeeService *employee.EmployeeService
+	validate        *validator.Validate
+}
+
+func NewPartyServer(borrowerService *borrower.BorrowerService, lenderService *lender.LenderService, employeeService *employee.EmployeeService, validate *validator.Validate) *PartyServer {
+	return &PartyServer{
+		borrowerService: borrowerService,
+		lenderService:   lenderService,
+		employeeService: employeeService,
+		validate:        validate,
+	}
+}
+
+func (s *PartyServer) CreateBorrower(ctx context.Context, req *loanv1.CreateBorrowerRequest) (*loanv1.Borrower, error) {
+	createReq := request.CreateBorrowerRequest{
+		FullName:    req.GetFullName(),
+		Email:       req.GetEmail(),
+		PhoneNumber: req.GetPhoneNumber(),
+		IDNumber:    req.GetIdNumber(),
+		CreditLimit: req.CreditLimit,
+	}
+	if err := s.validate.Struct(createReq); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	b, err := s.borrowerService.CreateBorrower(ctx, createReq.FullName, createReq.Email, createReq.PhoneNumber, createReq.IDNumber, createReq.CreditLimit)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoBorrower(b), nil
+}
+
+func (s *PartyServer) GetBorrower(ctx context.Context, req *loanv1.GetPartyRequest) (*loanv1.Borrower, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	b, err := s.borrowerService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoBorrower(b), nil
+}
+
+func (s *PartyServer) ListBorrowers(ctx context.Context, req *loanv1.ListPartiesRequest) (*loanv1.ListBorrowersResponse, error) {
+	query := req.GetQuery()
+	result, err := s.borrowerService.ListBorrowers(ctx, borrower.BorrowerFilter{
+		Query:    &query,
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	borrowers, _ := result.Data.([]*borrower.Borrower)
+	resp := &loanv1.ListBorrowersResponse{
+		Borrowers:  make([]*loanv1.Borrower, 0, len(borrowers)),
+		Pagination: toProtoPagination(result.Pagination),
+	}
+	for _, b := range borrowers {
+		resp.Borrowers = append(resp.Borrowers, toProtoBorrower(b))
+	}
+
+	return resp, nil
+}
+
+func (s *PartyServer) UpdateCreditLimit(ctx context.Context, req *loanv1.UpdateCreditLimitRequest) (*loanv1.Borrower, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+	if err := s.validate.Struct(request.UpdateCreditLimitRequest{CreditLimit: req.CreditLimit}); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	b, err := s.borrowerService.UpdateCreditLimit(ctx, req.GetId(), req.CreditLimit)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoBorrower(b), nil
+}
+
+func (s *PartyServer) CreateLender(ctx context.Context, req *loanv1.CreatePartyRequest) (*loanv1.Lender, error) {
+	createReq := request.CreateLenderRequest{
+		FullName:    req.GetFullName(),
+		Email:       req.GetEmail(),
+		PhoneNumber: req.GetPhoneNumber(),
+		IDNumber:    req.GetIdNumber(),
+	}
+	if err := s.validate.Struct(createReq); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	l, err := s.lenderService.CreateLender(ctx, createReq.FullName, createReq.Email, createReq.PhoneNumber, createReq.IDNumber)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoLender(l), nil
+}
+
+func (s *PartyServer) GetLender(ctx context.Context, req *loanv1.GetPartyRequest) (*loanv1.Lender, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	l, err := s.lenderService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoLender(l), nil
+}
+
+func (s *PartyServer) ListLenders(ctx context.Context, req *loanv1.ListPartiesRequest) (*loanv1.ListLendersResponse, error) {
+	query := req.GetQuery()
+	result, err := s.lenderService.ListLenders(ctx, lender.LenderFilter{
+		Query:    &query,
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	lenders, _ := result.Data.([]*lender.Lender)
+	resp := &loanv1.ListLendersResponse{
+		Lenders:    make([]*loanv1.Lender, 0, len(lenders)),
+		Pagination: toProtoPagination(result.Pagination),
+	}
+	for _, l := range lenders {
+		resp.Lenders = append(resp.Lenders, toProtoLender(l))
+	}
+
+	return resp, nil
+}
+
+func (s *PartyServer) CreateEmployee(ctx context.Context, req *loanv1.CreatePartyRequest) (*loanv1.Employee, error) {
+	createReq := request.CreateEmployeeRequest{
+		FullName:    req.GetFullName(),
+		Email:       req.GetEmail(),
+		PhoneNumber: req.GetPhoneNumber(),
+		IDNumber:    req.GetIdNumber(),
+	}
+	if err := s.validate.Struct(createReq); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	e, err := s.employeeService.CreateEmployee(ctx, createReq.FullName, createReq.Email, createReq.PhoneNumber, createReq.IDNumber)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoEmployee(e), nil
+}
+
+func (s *PartyServer) GetEmployee(ctx context.Context, req *loanv1.GetPartyRequest) (*loanv1.Employee, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	e, err := s.employeeService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return toProtoEmployee(e), nil
+}
+
+func (s *PartyServer) ListEmployees(ctx context.Context, req *loanv1.ListPartiesRequest) (*loanv1.ListEmployeesResponse, error) {
+	query := req.GetQuery()
+	result, err := s.employeeService.ListEmployees(ctx, employee.EmployeeFilter{
+		Query:    &query,
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	employees, _ := result.Data.([]*employee.Employee)
+	resp := &loanv1.ListEmployeesResponse{
+		Employees:  make([]*loanv1.Employee, 0, len(employees)),
+		Pagination: toProtoPagination(result.Pagination),
+	}
+	for _, e := range employees {
+		resp.Employees = append(resp.Employees, toProtoEmployee(e))
+	}
+
+	return resp, nil
+}
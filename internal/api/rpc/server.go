@@ -0,0 +1,46 @@
+// Package rpc serves the loan service over gRPC, next to the HTTP API.
+// The loanv1 package is generated from proto/loan/v1/loan.proto.
+package rpc
+
+//go:generate protoc --proto_path=../../../proto --go_out=../../.. --go_opt=module=github.com/theodorusyoga/loan-service-state-machine --go-grpc_out=../../.. --go-grpc_opt=module=github.com/theodorusyoga/loan-service-state-machine loan/v1/loan.proto
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/rpc/loanv1"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+)
+
+// RequestIDMetadataKey is the metadata key carrying the request ID, like the X-Request-ID HTTP header
+const RequestIDMetadataKey = "x-request-id"
+
+func NewServer(loanServer *LoanServer, partyServer *PartyServer) *grpc.Server {
+	server := grpc.NewServer(grpc.UnaryInterceptor(requestIDInterceptor))
+
+	loanv1.RegisterLoanServiceServer(server, loanServer)
+	loanv1.RegisterPartyServiceServer(server, partyServer)
+	reflection.Register(server)
+
+	return server
+}
+
+// requestIDInterceptor takes the request ID from the metadata, or generates one, and returns it in the response header
+func requestIDInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDMetadataKey); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, id))
+
+	return handler(requestid.WithContext(ctx, id), req)
+}
@@ -0,0 +1,259 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/looplab/fsm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/rpc"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/rpc/loanv1"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/memory"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/test/mocks"
+	fxpkg "github.com/theodorusyoga/loan-service-state-machine/pkg/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// stubCallbacks runs the loan state machine with fixed callbacks, without side effects when empty
+type stubCallbacks struct {
+	callbacks fsm.Callbacks
+}
+
+func (r stubCallbacks) GetCallbacks() fsm.Callbacks {
+	if r.callbacks == nil {
+		return fsm.Callbacks{}
+	}
+	return r.callbacks
+}
+
+type testServer struct {
+	loanRepo     *mocks.MockLoanRepository
+	borrowerRepo *mocks.MockBorrowerRepository
+	productRepo  *mocks.MockProductRepository
+	auditRepo    *mocks.MockAuditRepository
+	loans        loanv1.LoanServiceClient
+	parties      loanv1.PartyServiceClient
+}
+
+func newTestServer(t *testing.T) *testServer {
+	return newTestServerWithCallbacks(t, nil)
+}
+
+func newTestServerWithCallbacks(t *testing.T, callbacks fsm.Callbacks) *testServer {
+	ts := &testServer{
+		loanRepo:     mocks.NewMockLoanRepository(),
+		borrowerRepo: mocks.NewMockBorrowerRepository(),
+		productRepo:  mocks.NewMockProductRepository(),
+		auditRepo:    mocks.NewMockAuditRepository(),
+	}
+	lenderRepo := mocks.NewMockLenderRepository()
+	employeeRepo := mocks.NewMockEmployeeRepository()
+	validate := fxpkg.ProvideValidator()
+
+	loanService := loan.NewLoanService(ts.loanRepo, ts.borrowerRepo, nil, employeeRepo, ts.productRepo, ts.auditRepo, memory.NewTransactor(), stubCallbacks{callbacks: callbacks})
+	lenderService := lender.NewLenderService(lenderRepo)
+	server := rpc.NewServer(
+		rpc.NewLoanServer(loanService, lenderService, validate),
+		rpc.NewPartyServer(borrower.NewBorrowerService(ts.borrowerRepo), lenderService, employee.NewEmployeeService(employeeRepo), validate),
+	)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	ts.loans = loanv1.NewLoanServiceClient(conn)
+	ts.parties = loanv1.NewPartyServiceClient(conn)
+	return ts
+}
+
+func TestLoanServer(t *testing.T) {
+	ctx := context.Background()
+	borrowerID := "2f1c3f7e-4a43-4c1f-9c55-0c6a3f1d5e11"
+	productID := "8d0f7f5e-2c1b-4b8e-9f57-3e6b1c2d4a90"
+
+	t.Run("should return NotFound for an unknown loan", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.loanRepo.On("Get", mock.Anything, "loan-123").Return(nil, loan.ErrLoanNotFound)
+
+		_, err := ts.loans.GetLoan(ctx, &loanv1.GetLoanRequest{Id: "loan-123"})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("should return InvalidArgument for an ROI not below the rate", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.productRepo.On("Get", mock.Anything, productID).Return(&product.LoanProduct{ID: productID, Details: product.Details{
+			MinAmount: 500, MaxAmount: 5000, MinRate: 8, MaxRate: 12, MinROI: 6, MaxROI: 12,
+			TenorMonths: []int{12}, InterestMethod: interest.MethodFlat,
+		}}, nil)
+		ts.auditRepo.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := ts.loans.CreateLoan(ctx, &loanv1.CreateLoanRequest{BorrowerId: borrowerID, ProductId: productID, Amount: 1000, Rate: 10, Roi: 12})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "ROI must be below the rate")
+		ts.borrowerRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return InvalidArgument for a loan the product does not offer", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.productRepo.On("Get", mock.Anything, productID).Return(&product.LoanProduct{ID: productID, Details: product.Details{
+			MinAmount: 500, MaxAmount: 5000, MinRate: 8, MaxRate: 12, MinROI: 6, MaxROI: 10,
+			TenorMonths: []int{12}, InterestMethod: interest.MethodFlat,
+		}}, nil)
+		ts.auditRepo.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := ts.loans.CreateLoan(ctx, &loanv1.CreateLoanRequest{BorrowerId: borrowerID, ProductId: productID, Amount: 10000, Rate: 10, Roi: 8})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "loan not offered by the product: amount must be between 500 and 5000", status.Convert(err).Message())
+		ts.borrowerRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return NotFound for an unknown product", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.productRepo.On("Get", mock.Anything, productID).Return(nil, product.ErrProductNotFound)
+		ts.auditRepo.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := ts.loans.CreateLoan(ctx, &loanv1.CreateLoanRequest{BorrowerId: borrowerID, ProductId: productID, Amount: 1000, Rate: 10, Roi: 8})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("should return InvalidArgument for a missing transition field", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.loanRepo.On("Get", mock.Anything, "loan-123").Return(&loan.Loan{ID: "loan-123", Status: loan.StatusProposed}, nil)
+		ts.auditRepo.On("Append", mock.Anything, mock.MatchedBy(func(e *audit.Entry) bool {
+			return e.Action == loan.EventCancel && e.Actor == "employee-123" && e.Outcome == audit.OutcomeFailure &&
+				e.FromStatus == string(loan.StatusProposed) && *e.Error == "reason is required"
+		})).Return(nil)
+
+		_, err := ts.loans.CancelLoan(ctx, &loanv1.CancelLoanRequest{Id: "loan-123", CancelledBy: "employee-123"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "reason is required", status.Convert(err).Message())
+		ts.auditRepo.AssertExpectations(t)
+	})
+
+	t.Run("should audit a transition of an unknown loan", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.loanRepo.On("Get", mock.Anything, "loan-123").Return(nil, loan.ErrLoanNotFound)
+		ts.auditRepo.On("Append", mock.Anything, mock.MatchedBy(func(e *audit.Entry) bool {
+			return e.LoanID == "loan-123" && e.Action == loan.EventApprove && e.Outcome == audit.OutcomeFailure
+		})).Return(nil)
+
+		_, err := ts.loans.ApproveLoan(ctx, &loanv1.ApproveLoanRequest{Id: "loan-123", ApprovalEmployeeId: "employee-123"})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		ts.auditRepo.AssertExpectations(t)
+	})
+
+	t.Run("should return FailedPrecondition for a transition not allowed in the loan status", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.loanRepo.On("Get", mock.Anything, "loan-123").Return(&loan.Loan{ID: "loan-123", Status: loan.StatusProposed}, nil)
+		ts.auditRepo.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := ts.loans.ExpireLoan(ctx, &loanv1.ExpireLoanRequest{Id: "loan-123"})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("should reject a proposed loan", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.loanRepo.On("Get", mock.Anything, "loan-123").Return(&loan.Loan{ID: "loan-123", Status: loan.StatusProposed}, nil)
+		ts.auditRepo.On("Append", mock.Anything, mock.MatchedBy(func(e *audit.Entry) bool {
+			return e.Action == loan.EventReject && e.Actor == "employee-123" && e.Outcome == audit.OutcomeSuccess
+		})).Return(nil)
+
+		_, err := ts.loans.RejectLoan(ctx, &loanv1.RejectLoanRequest{Id: "loan-123", RejectedBy: "employee-123", Reason: "Incomplete documents"})
+
+		require.NoError(t, err)
+		ts.auditRepo.AssertExpectations(t)
+	})
+
+	t.Run("should return FailedPrecondition for a transition refused by its checks", func(t *testing.T) {
+		ts := newTestServerWithCallbacks(t, fsm.Callbacks{
+			"before_" + loan.EventReject: func(_ context.Context, e *fsm.Event) {
+				e.Cancel(loan.Refuse("employee not found"))
+			},
+		})
+		ts.loanRepo.On("Get", mock.Anything, "loan-123").Return(&loan.Loan{ID: "loan-123", Status: loan.StatusProposed}, nil)
+		ts.auditRepo.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := ts.loans.RejectLoan(ctx, &loanv1.RejectLoanRequest{Id: "loan-123", RejectedBy: "employee-123", Reason: "Incomplete documents"})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Equal(t, "employee not found", status.Convert(err).Message())
+	})
+
+	t.Run("should return Internal for a transition that failed to store the loan", func(t *testing.T) {
+		ts := newTestServerWithCallbacks(t, fsm.Callbacks{
+			"after_" + loan.EventReject: func(_ context.Context, e *fsm.Event) {
+				e.Cancel(errors.New("error updating loan status"))
+			},
+		})
+		ts.loanRepo.On("Get", mock.Anything, "loan-123").Return(&loan.Loan{ID: "loan-123", Status: loan.StatusProposed}, nil)
+		ts.auditRepo.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := ts.loans.RejectLoan(ctx, &loanv1.RejectLoanRequest{Id: "loan-123", RejectedBy: "employee-123", Reason: "Incomplete documents"})
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, "error updating loan status", status.Convert(err).Message())
+	})
+
+	t.Run("should record the request ID from the metadata", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.loanRepo.On("Get", mock.Anything, "loan-123").Return(&loan.Loan{ID: "loan-123", Status: loan.StatusApproved}, nil)
+		ts.auditRepo.On("Append", mock.Anything, mock.MatchedBy(func(e *audit.Entry) bool {
+			return e.RequestID == "req-123" && e.Outcome == audit.OutcomeSuccess
+		})).Return(nil)
+
+		var header metadata.MD
+		_, err := ts.loans.ExpireLoan(metadata.AppendToOutgoingContext(ctx, rpc.RequestIDMetadataKey, "req-123"),
+			&loanv1.ExpireLoanRequest{Id: "loan-123"}, grpc.Header(&header))
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"req-123"}, header.Get(rpc.RequestIDMetadataKey))
+		ts.auditRepo.AssertExpectations(t)
+	})
+}
+
+func TestPartyServer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should return AlreadyExists for a registered email", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.borrowerRepo.On("Count", mock.Anything, mock.Anything).Return(int64(1), nil)
+
+		_, err := ts.parties.CreateBorrower(ctx, &loanv1.CreateBorrowerRequest{
+			FullName:    "Jane Doe",
+			Email:       "jane@example.com",
+			PhoneNumber: "08123456789",
+			IdNumber:    "3171234567890001",
+		})
+
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+}
@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+)
+
+// ActorSystem is the actor of actions not performed by a person, such as expiring a loan
+const ActorSystem = "system"
+
+// ActionCreate is the action of a loan application, other actions are the state machine events
+const ActionCreate = "create"
+
+// ActionUnknown is recorded for a requested action that is not a state machine event
+const ActionUnknown = "unknown"
+
+// Entry records an attempted action on a loan, whether it succeeded or not
+type Entry struct {
+	ID         string    `json:"id"`
+	LoanID     string    `json:"loan_id"`
+	Action     string    `json:"action"` // create, unknown or the state machine event, e.g. approve
+	Actor      string    `json:"actor"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"` // Status after the attempt, equal to FromStatus on failure
+	Outcome    Outcome   `json:"outcome"`
+	Error      *string   `json:"error"`
+	RequestID  string    `json:"request_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// NewEntry records the outcome of an action, err is nil when it succeeded
+func NewEntry(loanID, action, actor, fromStatus, toStatus, requestID string, err error) *Entry {
+	entry := &Entry{
+		ID:         uuid.New().String(),
+		LoanID:     loanID,
+		Action:     action,
+		Actor:      actor,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		Outcome:    OutcomeSuccess,
+		RequestID:  requestID,
+		CreatedAt:  time.Now(),
+	}
+
+	if err != nil {
+		errMsg := err.Error()
+		entry.Outcome = OutcomeFailure
+		entry.Error = &errMsg
+	}
+
+	return entry
+}
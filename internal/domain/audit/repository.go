@@ -0,0 +1,33 @@
+package audit
+
+import (
+	"context"
+)
+
+// Repository defines the data access interface for the audit log.
+// The log is append-only, entries are never updated or deleted.
+type Repository interface {
+	Append(ctx context.Context, entry *Entry) error
+	List(ctx context.Context, filter AuditFilter) ([]*Entry, error)
+	Count(ctx context.Context, filter AuditFilter) (int64, error)
+}
+
+type AuditFilter struct {
+	LoanID    *string
+	Actor     *string
+	Action    *string
+	Outcome   *Outcome
+	RequestID *string
+	Page      int
+	PageSize  int
+}
+
+func (f *AuditFilter) WithDefaults() *AuditFilter {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	if f.PageSize <= 0 {
+		f.PageSize = 10
+	}
+	return f
+}
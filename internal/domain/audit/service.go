@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
+)
+
+type AuditService struct {
+	repository Repository
+}
+
+func NewAuditService(r Repository) *AuditService {
+	return &AuditService{
+		repository: r,
+	}
+}
+
+func (s *AuditService) ListEntries(ctx context.Context, filter AuditFilter) (*domain.PaginatedResponse, error) {
+	filter.WithDefaults()
+	entries, err := s.repository.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the total count
+	totalItems, err := s.repository.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate total pages
+	totalPages := 0
+	if filter.PageSize > 0 {
+		totalPages = int((totalItems + int64(filter.PageSize) - 1) / int64(filter.PageSize))
+	}
+
+	return &domain.PaginatedResponse{
+		Data: entries,
+		Pagination: domain.PaginationInfo{
+			CurrentPage: filter.Page,
+			PageSize:    filter.PageSize,
+			TotalItems:  totalItems,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
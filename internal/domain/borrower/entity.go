@@ -13,6 +13,7 @@ type Borrower struct {
 	Email       string    `json:"email"`
 	PhoneNumber string    `json:"phone_number"`
 	IDNumber    string    `json:"id_number"`
+	CreditLimit *float64  `json:"credit_limit"` // Maximum outstanding loan amount, no limit when nil
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
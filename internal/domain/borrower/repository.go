@@ -2,13 +2,19 @@ package borrower
 
 import (
 	"context"
+	"errors"
 )
 
+// ErrBorrowerNotFound is returned when no borrower has the requested ID
+var ErrBorrowerNotFound = errors.New("borrower not found")
+
 // Repository defines the data access interface for borrowers
 type Repository interface {
 	Get(ctx context.Context, id string) (*Borrower, error)
 	Save(ctx context.Context, borrower *Borrower) error
 	Create(ctx context.Context, borrower *Borrower) error
+	// CreateBatch inserts all the borrowers in one transaction, none of them when one fails
+	CreateBatch(ctx context.Context, borrowers []*Borrower) error
 	// TODO: Implement the following methods
 	List(ctx context.Context, filter BorrowerFilter) ([]*Borrower, error)
 	// Delete(ctx context.Context, id string) error
@@ -20,6 +26,7 @@ type BorrowerFilter struct {
 	Email       *string
 	PhoneNumber *string
 	IDNumber    *string
+	Query       *string // Matches name, email, phone number or ID number partially
 	Page        int
 	PageSize    int
 }
@@ -2,13 +2,20 @@ package borrower
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
 )
 
+var (
+	ErrEmailTaken    = errors.New("email is already registered")
+	ErrIDNumberTaken = errors.New("ID number is already registered")
+)
+
 // Service provides borrower business operations
 type Service interface {
-	Create(ctx context.Context, fullName, email, phoneNumber, idNumber string) (*Borrower, error)
+	Create(ctx context.Context, fullName, email, phoneNumber, idNumber string, creditLimit *float64) (*Borrower, error)
 	ListBorrowers(ctx context.Context, filter BorrowerFilter) ([]*Borrower, error)
 }
 
@@ -22,8 +29,13 @@ func NewBorrowerService(r Repository) *BorrowerService {
 	}
 }
 
-func (s *BorrowerService) CreateBorrower(ctx context.Context, fullName, email, phoneNumber, idNumber string) (*Borrower, error) {
+func (s *BorrowerService) CreateBorrower(ctx context.Context, fullName, email, phoneNumber, idNumber string, creditLimit *float64) (*Borrower, error) {
+	if err := s.checkUniqueness(ctx, email, idNumber); err != nil {
+		return nil, err
+	}
+
 	borrower := NewBorrower(fullName, email, phoneNumber, idNumber)
+	borrower.CreditLimit = creditLimit
 
 	if err := s.repository.Create(ctx, borrower); err != nil {
 		return nil, err
@@ -32,6 +44,23 @@ func (s *BorrowerService) CreateBorrower(ctx context.Context, fullName, email, p
 	return borrower, nil
 }
 
+// UpdateCreditLimit changes the maximum outstanding amount of a borrower, nil removes the limit
+func (s *BorrowerService) UpdateCreditLimit(ctx context.Context, id string, creditLimit *float64) (*Borrower, error) {
+	borrower, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	borrower.CreditLimit = creditLimit
+	borrower.UpdatedAt = time.Now()
+
+	if err := s.repository.Save(ctx, borrower); err != nil {
+		return nil, err
+	}
+
+	return borrower, nil
+}
+
 func (s *BorrowerService) GetByID(ctx context.Context, id string) (*Borrower, error) {
 	return s.repository.Get(ctx, id)
 }
@@ -65,3 +94,24 @@ func (s *BorrowerService) ListBorrowers(ctx context.Context, filter BorrowerFilt
 		},
 	}, nil
 }
+
+// checkUniqueness makes sure no other borrower is registered with the same email or ID number
+func (s *BorrowerService) checkUniqueness(ctx context.Context, email, idNumber string) error {
+	count, err := s.repository.Count(ctx, BorrowerFilter{Email: &email})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrEmailTaken
+	}
+
+	count, err = s.repository.Count(ctx, BorrowerFilter{IDNumber: &idNumber})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrIDNumberTaken
+	}
+
+	return nil
+}
@@ -2,13 +2,19 @@ package employee
 
 import (
 	"context"
+	"errors"
 )
 
+// ErrEmployeeNotFound is returned when no employee has the requested ID
+var ErrEmployeeNotFound = errors.New("employee not found")
+
 // Repository defines the data access interface for employees
 type Repository interface {
 	Get(ctx context.Context, id string) (*Employee, error)
 	Save(ctx context.Context, employee *Employee) error
 	Create(ctx context.Context, Employee *Employee) error
+	// CreateBatch inserts all the employees in one transaction, none of them when one fails
+	CreateBatch(ctx context.Context, employees []*Employee) error
 	// TODO: Implement the following methods
 	List(ctx context.Context, filter EmployeeFilter) ([]*Employee, error)
 	// Delete(ctx context.Context, id string) error
@@ -20,6 +26,7 @@ type EmployeeFilter struct {
 	Email       *string
 	PhoneNumber *string
 	IDNumber    *string
+	Query       *string // Matches name, email, phone number or ID number partially
 	Page        int
 	PageSize    int
 }
@@ -2,10 +2,16 @@ package employee
 
 import (
 	"context"
+	"errors"
 
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
 )
 
+var (
+	ErrEmailTaken    = errors.New("email is already registered")
+	ErrIDNumberTaken = errors.New("ID number is already registered")
+)
+
 // Service provides employee business operations
 type Service interface {
 	CreateEmployee(ctx context.Context, fullName, email, phoneNumber, idNumber string) (*Employee, error)
@@ -24,6 +30,10 @@ func NewEmployeeService(r Repository) *EmployeeService {
 }
 
 func (s *EmployeeService) CreateEmployee(ctx context.Context, fullName, email, phoneNumber, idNumber string) (*Employee, error) {
+	if err := s.checkUniqueness(ctx, email, idNumber); err != nil {
+		return nil, err
+	}
+
 	employee := NewEmployee(fullName, email, phoneNumber, idNumber)
 
 	if err := s.repository.Create(ctx, employee); err != nil {
@@ -66,3 +76,24 @@ func (s *EmployeeService) ListEmployees(ctx context.Context, filter EmployeeFilt
 		},
 	}, nil
 }
+
+// checkUniqueness makes sure no other employee is registered with the same email or ID number
+func (s *EmployeeService) checkUniqueness(ctx context.Context, email, idNumber string) error {
+	count, err := s.repository.Count(ctx, EmployeeFilter{Email: &email})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrEmailTaken
+	}
+
+	count, err = s.repository.Count(ctx, EmployeeFilter{IDNumber: &idNumber})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrIDNumberTaken
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Columns of the CSV files, matched case-insensitively against the header row.
+// Other columns are ignored.
+const (
+	ColumnFullName    = "full_name"
+	ColumnEmail       = "email"
+	ColumnPhoneNumber = "phone_number"
+	ColumnIDNumber    = "id_number"
+	ColumnCreditLimit = "credit_limit" // Borrowers only, optional
+)
+
+// ErrInvalidFile is returned when the file cannot be read at all, as opposed to errors in some rows
+var ErrInvalidFile = errors.New("invalid CSV file")
+
+// partyColumns are required in the files of every party
+var partyColumns = []string{ColumnFullName, ColumnEmail, ColumnPhoneNumber, ColumnIDNumber}
+
+// record is a data row of the file with its values by column
+type record struct {
+	row    int
+	values map[string]string
+}
+
+func (r record) get(column string) string {
+	return r.values[column]
+}
+
+// readRecords reads the header and the data rows of a CSV file, failing when a required column is missing
+func readRecords(r io.Reader, required []string) ([]record, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: no header row", ErrInvalidFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	columns := make([]string, len(header))
+	present := make(map[string]bool, len(header))
+	for i, name := range header {
+		// Spreadsheet exports may start with a byte order mark
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		columns[i] = name
+		present[name] = true
+	}
+
+	var missing []string
+	for _, column := range required {
+		if !present[column] {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: missing columns %s", ErrInvalidFile, strings.Join(missing, ", "))
+	}
+
+	var records []record
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		rec := record{row: line, values: make(map[string]string, len(columns))}
+		for i, value := range fields {
+			if i < len(columns) {
+				rec.values[columns[i]] = strings.TrimSpace(value)
+			}
+		}
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
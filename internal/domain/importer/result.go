@@ -0,0 +1,16 @@
+package importer
+
+// Result reports the outcome of an import, rows are numbered as in the file (the header is row 1)
+type Result struct {
+	DryRun    bool       `json:"dry_run"`
+	TotalRows int        `json:"total_rows"`
+	ValidRows int        `json:"valid_rows"`
+	Imported  int        `json:"imported"` // Always 0 on a dry run
+	Errors    []RowError `json:"errors"`
+}
+
+// RowError lists why a row was not imported
+type RowError struct {
+	Row    int      `json:"row"`
+	Errors []string `json:"errors"`
+}
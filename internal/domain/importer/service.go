@@ -0,0 +1,283 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/request"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+)
+
+// DefaultBatchSize is the number of rows inserted per transaction
+const DefaultBatchSize = 100
+
+// ImportService creates borrowers, lenders and employees from CSV files. Rows are validated
+// with the rules of the API requests, invalid rows are reported and the valid ones inserted in batches.
+type ImportService struct {
+	borrowerRepository borrower.Repository
+	lenderRepository   lender.Repository
+	employeeRepository employee.Repository
+	validate           *validator.Validate
+	batchSize          int
+}
+
+func NewImportService(b borrower.Repository, l lender.Repository, e employee.Repository, v *validator.Validate) *ImportService {
+	return &ImportService{
+		borrowerRepository: b,
+		lenderRepository:   l,
+		employeeRepository: e,
+		validate:           v,
+		batchSize:          DefaultBatchSize,
+	}
+}
+
+// WithBatchSize changes the number of rows inserted per transaction
+func (s *ImportService) WithBatchSize(size int) *ImportService {
+	if size > 0 {
+		s.batchSize = size
+	}
+	return s
+}
+
+func (s *ImportService) ImportBorrowers(ctx context.Context, r io.Reader, dryRun bool) (*Result, error) {
+	records, err := readRecords(r, partyColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	return importRows(ctx, s, records, dryRun, importer[*borrower.Borrower]{
+		build: func(rec record) (any, *borrower.Borrower, []string) {
+			req := request.CreateBorrowerRequest{
+				FullName:    rec.get(ColumnFullName),
+				Email:       rec.get(ColumnEmail),
+				PhoneNumber: rec.get(ColumnPhoneNumber),
+				IDNumber:    rec.get(ColumnIDNumber),
+			}
+
+			if value := rec.get(ColumnCreditLimit); value != "" {
+				creditLimit, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, nil, []string{ColumnCreditLimit + " must be a number"}
+				}
+				req.CreditLimit = &creditLimit
+			}
+
+			b := borrower.NewBorrower(req.FullName, req.Email, req.PhoneNumber, req.IDNumber)
+			b.CreditLimit = req.CreditLimit
+			return req, b, nil
+		},
+		count: func(ctx context.Context, email, idNumber *string) (int64, error) {
+			return s.borrowerRepository.Count(ctx, borrower.BorrowerFilter{Email: email, IDNumber: idNumber})
+		},
+		createBatch: s.borrowerRepository.CreateBatch,
+	})
+}
+
+func (s *ImportService) ImportLenders(ctx context.Context, r io.Reader, dryRun bool) (*Result, error) {
+	records, err := readRecords(r, partyColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	return importRows(ctx, s, records, dryRun, importer[*lender.Lender]{
+		build: func(rec record) (any, *lender.Lender, []string) {
+			req := request.CreateLenderRequest{
+				FullName:    rec.get(ColumnFullName),
+				Email:       rec.get(ColumnEmail),
+				PhoneNumber: rec.get(ColumnPhoneNumber),
+				IDNumber:    rec.get(ColumnIDNumber),
+			}
+			return req, lender.NewLender(req.FullName, req.Email, req.PhoneNumber, req.IDNumber), nil
+		},
+		count: func(ctx context.Context, email, idNumber *string) (int64, error) {
+			return s.lenderRepository.Count(ctx, lender.LenderFilter{Email: email, IDNumber: idNumber})
+		},
+		createBatch: s.lenderRepository.CreateBatch,
+	})
+}
+
+func (s *ImportService) ImportEmployees(ctx context.Context, r io.Reader, dryRun bool) (*Result, error) {
+	records, err := readRecords(r, partyColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	return importRows(ctx, s, records, dryRun, importer[*employee.Employee]{
+		build: func(rec record) (any, *employee.Employee, []string) {
+			req := request.CreateEmployeeRequest{
+				FullName:    rec.get(ColumnFullName),
+				Email:       rec.get(ColumnEmail),
+				PhoneNumber: rec.get(ColumnPhoneNumber),
+				IDNumber:    rec.get(ColumnIDNumber),
+			}
+			return req, employee.NewEmployee(req.FullName, req.Email, req.PhoneNumber, req.IDNumber), nil
+		},
+		count: func(ctx context.Context, email, idNumber *string) (int64, error) {
+			return s.employeeRepository.Count(ctx, employee.EmployeeFilter{Email: email, IDNumber: idNumber})
+		},
+		createBatch: s.employeeRepository.CreateBatch,
+	})
+}
+
+// importer holds what differs between the parties
+type importer[T any] struct {
+	// build turns a row into the API request to validate and the entity to insert,
+	// or returns the errors of values that cannot be converted
+	build func(rec record) (any, T, []string)
+	// count returns the number of parties already registered with the email or ID number
+	count       func(ctx context.Context, email, idNumber *string) (int64, error)
+	createBatch func(ctx context.Context, entities []T) error
+}
+
+// candidate is a valid row waiting to be inserted
+type candidate[T any] struct {
+	row    int
+	entity T
+}
+
+func importRows[T any](ctx context.Context, s *ImportService, records []record, dryRun bool, imp importer[T]) (*Result, error) {
+	result := &Result{DryRun: dryRun, TotalRows: len(records), Errors: []RowError{}}
+
+	// Rows where an email or ID number was first seen, as they must be unique within the file too
+	emailRows := map[string]int{}
+	idNumberRows := map[string]int{}
+	var candidates []candidate[T]
+
+	for _, rec := range records {
+		req, entity, errs := imp.build(rec)
+		if len(errs) == 0 {
+			errs = s.validateRequest(req)
+		}
+
+		if len(errs) == 0 {
+			email := strings.ToLower(rec.get(ColumnEmail))
+			idNumber := rec.get(ColumnIDNumber)
+
+			if row, ok := emailRows[email]; ok {
+				errs = append(errs, fmt.Sprintf("%s is repeated from row %d", ColumnEmail, row))
+			} else {
+				emailRows[email] = rec.row
+			}
+			if row, ok := idNumberRows[idNumber]; ok {
+				errs = append(errs, fmt.Sprintf("%s is repeated from row %d", ColumnIDNumber, row))
+			} else {
+				idNumberRows[idNumber] = rec.row
+			}
+
+			taken, err := s.registered(ctx, imp.count, email, idNumber)
+			if err != nil {
+				return nil, err
+			}
+			errs = append(errs, taken...)
+		}
+
+		if len(errs) > 0 {
+			result.Errors = append(result.Errors, RowError{Row: rec.row, Errors: errs})
+			continue
+		}
+		candidates = append(candidates, candidate[T]{row: rec.row, entity: entity})
+	}
+
+	result.ValidRows = len(candidates)
+	if dryRun {
+		return result, nil
+	}
+
+	for start := 0; start < len(candidates); start += s.batchSize {
+		batch := candidates[start:min(start+s.batchSize, len(candidates))]
+
+		entities := make([]T, 0, len(batch))
+		for _, c := range batch {
+			entities = append(entities, c.entity)
+		}
+
+		// A failed batch is rolled back as a whole, e.g. when a party registered meanwhile
+		if err := imp.createBatch(ctx, entities); err != nil {
+			for _, c := range batch {
+				result.Errors = append(result.Errors, RowError{Row: c.row, Errors: []string{"not imported: " + err.Error()}})
+			}
+			continue
+		}
+		result.Imported += len(batch)
+	}
+
+	sort.Slice(result.Errors, func(i, j int) bool {
+		return result.Errors[i].Row < result.Errors[j].Row
+	})
+
+	return result, nil
+}
+
+// registered reports the email and ID number already belonging to a party
+func (s *ImportService) registered(ctx context.Context, count func(ctx context.Context, email, idNumber *string) (int64, error), email, idNumber string) ([]string, error) {
+	var errs []string
+
+	n, err := count(ctx, &email, nil)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		errs = append(errs, ColumnEmail+" is already registered")
+	}
+
+	n, err = count(ctx, nil, &idNumber)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		errs = append(errs, ColumnIDNumber+" is already registered")
+	}
+
+	return errs, nil
+}
+
+// requestColumns maps the fields of the API requests to the columns of the file
+var requestColumns = map[string]string{
+	"FullName":    ColumnFullName,
+	"Email":       ColumnEmail,
+	"PhoneNumber": ColumnPhoneNumber,
+	"IDNumber":    ColumnIDNumber,
+	"CreditLimit": ColumnCreditLimit,
+}
+
+// validateRequest applies the validation rules of the API request and describes the failures by column
+func (s *ImportService) validateRequest(req any) []string {
+	err := s.validate.Struct(req)
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		if err != nil {
+			return []string{err.Error()}
+		}
+		return nil
+	}
+
+	errs := make([]string, 0, len(validationErrors))
+	for _, fieldErr := range validationErrors {
+		column, ok := requestColumns[fieldErr.Field()]
+		if !ok {
+			column = fieldErr.Field()
+		}
+
+		switch fieldErr.Tag() {
+		case "required":
+			errs = append(errs, column+" is required")
+		case "email":
+			errs = append(errs, column+" must be a valid email address")
+		case "gte":
+			errs = append(errs, column+" must be greater than or equal to "+fieldErr.Param())
+		default:
+			errs = append(errs, column+" is invalid")
+		}
+	}
+
+	return errs
+}
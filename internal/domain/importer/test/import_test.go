@@ -0,0 +1,139 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/importer"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/test/mocks"
+	fxpkg "github.com/theodorusyoga/loan-service-state-machine/pkg/fx"
+)
+
+const borrowersCSV = `Full_Name,Email,Phone_Number,ID_Number,Credit_Limit,Notes
+Jane Doe,jane@example.com,0812000001,3171000000000001,50000,
+John Doe,not-an-email,0812000002,3171000000000002,,
+,jim@example.com,0812000003,3171000000000003,,missing name
+Jill Doe,JANE@example.com,0812000004,3171000000000004,,same email as row 2
+Jack Doe,jack@example.com,0812000005,3171000000000005,-1,
+Joan Doe,joan@example.com,0812000006,3171000000000006,lots,
+Jake Doe,taken@example.com,0812000007,3171000000000007,,
+Joe Doe,joe@example.com,0812000008,3171000000000008,,
+June Doe,june@example.com,0812000009,3171000000000009,,
+`
+
+func newService(borrowerRepo *mocks.MockBorrowerRepository, employeeRepo *mocks.MockEmployeeRepository) *importer.ImportService {
+	return importer.NewImportService(borrowerRepo, mocks.NewMockLenderRepository(), employeeRepo, fxpkg.ProvideValidator()).WithBatchSize(2)
+}
+
+// registeredEmail makes the borrower repository report the email as taken
+func registeredEmail(repo *mocks.MockBorrowerRepository, email string) {
+	repo.On("Count", mock.Anything, mock.MatchedBy(func(f borrower.BorrowerFilter) bool {
+		return f.Email != nil && *f.Email == email
+	})).Return(int64(1), nil)
+	repo.On("Count", mock.Anything, mock.Anything).Return(int64(0), nil)
+}
+
+func TestImportBorrowers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should report invalid rows and insert the valid ones in batches", func(t *testing.T) {
+		borrowerRepo := mocks.NewMockBorrowerRepository()
+		registeredEmail(borrowerRepo, "taken@example.com")
+
+		var batches [][]*borrower.Borrower
+		borrowerRepo.On("CreateBatch", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			batches = append(batches, args.Get(1).([]*borrower.Borrower))
+		}).Return(nil)
+
+		result, err := newService(borrowerRepo, nil).ImportBorrowers(ctx, strings.NewReader(borrowersCSV), false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 9, result.TotalRows)
+		assert.Equal(t, 3, result.ValidRows)
+		assert.Equal(t, 3, result.Imported)
+		assert.Equal(t, []importer.RowError{
+			{Row: 3, Errors: []string{"email must be a valid email address"}},
+			{Row: 4, Errors: []string{"full_name is required"}},
+			{Row: 5, Errors: []string{"email is repeated from row 2"}},
+			{Row: 6, Errors: []string{"credit_limit must be greater than or equal to 0"}},
+			{Row: 7, Errors: []string{"credit_limit must be a number"}},
+			{Row: 8, Errors: []string{"email is already registered"}},
+		}, result.Errors)
+
+		require.Len(t, batches, 2)
+		assert.Len(t, batches[0], 2)
+		assert.Len(t, batches[1], 1)
+		assert.Equal(t, "jane@example.com", batches[0][0].Email)
+		assert.Equal(t, 50000.0, *batches[0][0].CreditLimit)
+		assert.Nil(t, batches[0][1].CreditLimit)
+	})
+
+	t.Run("should report the rows of a failed batch", func(t *testing.T) {
+		borrowerRepo := mocks.NewMockBorrowerRepository()
+		registeredEmail(borrowerRepo, "taken@example.com")
+		borrowerRepo.On("CreateBatch", mock.Anything, mock.Anything).Return(errors.New("connection lost")).Once()
+		borrowerRepo.On("CreateBatch", mock.Anything, mock.Anything).Return(nil)
+
+		result, err := newService(borrowerRepo, nil).ImportBorrowers(ctx, strings.NewReader(borrowersCSV), false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Imported)
+		assert.Contains(t, result.Errors, importer.RowError{Row: 2, Errors: []string{"not imported: connection lost"}})
+		assert.Contains(t, result.Errors, importer.RowError{Row: 9, Errors: []string{"not imported: connection lost"}})
+	})
+
+	t.Run("should only validate on a dry run", func(t *testing.T) {
+		borrowerRepo := mocks.NewMockBorrowerRepository()
+		registeredEmail(borrowerRepo, "taken@example.com")
+
+		result, err := newService(borrowerRepo, nil).ImportBorrowers(ctx, strings.NewReader(borrowersCSV), true)
+
+		require.NoError(t, err)
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 3, result.ValidRows)
+		assert.Equal(t, 0, result.Imported)
+		assert.Len(t, result.Errors, 6)
+		borrowerRepo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+}
+
+func TestImportFile(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should refuse a file without the required columns", func(t *testing.T) {
+		employeeRepo := mocks.NewMockEmployeeRepository()
+
+		_, err := newService(nil, employeeRepo).ImportEmployees(ctx, strings.NewReader("full_name,email\nJane Doe,jane@example.com\n"), false)
+
+		assert.ErrorIs(t, err, importer.ErrInvalidFile)
+		assert.ErrorContains(t, err, "missing columns phone_number, id_number")
+	})
+
+	t.Run("should refuse an empty file", func(t *testing.T) {
+		_, err := newService(nil, mocks.NewMockEmployeeRepository()).ImportEmployees(ctx, strings.NewReader(""), false)
+
+		assert.ErrorIs(t, err, importer.ErrInvalidFile)
+	})
+
+	t.Run("should import employees", func(t *testing.T) {
+		employeeRepo := mocks.NewMockEmployeeRepository()
+		employeeRepo.On("Count", mock.Anything, mock.Anything).Return(int64(0), nil)
+		employeeRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(employees []*employee.Employee) bool {
+			return len(employees) == 1 && employees[0].FullName == "Jane Doe" && employees[0].ID != ""
+		})).Return(nil)
+
+		result, err := newService(nil, employeeRepo).ImportEmployees(ctx, strings.NewReader("\ufefffull_name,email,phone_number,id_number\nJane Doe, jane@example.com ,0812,3171\n"), false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Imported)
+		assert.Empty(t, result.Errors)
+		employeeRepo.AssertExpectations(t)
+	})
+}
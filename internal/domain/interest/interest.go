@@ -0,0 +1,187 @@
+package interest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Method is how the interest of a loan accrues over its installments
+type Method string
+
+const (
+	MethodFlat             Method = "flat"              // On the original principal for the whole tenor, equal installments
+	MethodAnnuity          Method = "annuity"           // Monthly rate on the outstanding balance, equal installments
+	MethodDecliningBalance Method = "declining_balance" // On the outstanding balance, equal principal repayments
+)
+
+// DayCount is the convention turning the days between two dates into a fraction of a year
+type DayCount string
+
+const (
+	DayCount30360     DayCount = "30/360"  // Every month counts 30 days and the year 360 (bond basis)
+	DayCountActual365 DayCount = "ACT/365" // Actual days over a 365 day year
+)
+
+const (
+	DefaultTenorMonths = 12
+	MaxTenorMonths     = 360
+)
+
+var (
+	ErrInvalidTenor    = fmt.Errorf("tenor must be between 1 and %d months", MaxTenorMonths)
+	ErrUnknownMethod   = errors.New("unknown interest method")
+	ErrUnknownDayCount = errors.New("unknown day count convention")
+)
+
+// Terms are how a loan is repaid, independently of its principal and rate.
+// The defaults, a flat rate over 12 months on a 30/360 basis, charge the annual rate once.
+type Terms struct {
+	TenorMonths int      `json:"tenor_months"` // Number of monthly installments
+	Method      Method   `json:"method"`
+	DayCount    DayCount `json:"day_count"`
+}
+
+// WithDefaults fills the terms left unset
+func (t Terms) WithDefaults() Terms {
+	if t.TenorMonths == 0 {
+		t.TenorMonths = DefaultTenorMonths
+	}
+	if t.Method == "" {
+		t.Method = MethodFlat
+	}
+	if t.DayCount == "" {
+		t.DayCount = DayCount30360
+	}
+	return t
+}
+
+// Validate returns an error when the terms cannot be used to calculate a schedule
+func (t Terms) Validate() error {
+	if t.TenorMonths < 1 || t.TenorMonths > MaxTenorMonths {
+		return ErrInvalidTenor
+	}
+
+	switch t.Method {
+	case MethodFlat, MethodAnnuity, MethodDecliningBalance:
+	default:
+		return fmt.Errorf("%w %q", ErrUnknownMethod, t.Method)
+	}
+
+	switch t.DayCount {
+	case DayCount30360, DayCountActual365:
+	default:
+		return fmt.Errorf("%w %q", ErrUnknownDayCount, t.DayCount)
+	}
+
+	return nil
+}
+
+// Installment is one monthly repayment, the balance being the principal left after it
+type Installment struct {
+	Number    int       `json:"number"`
+	DueDate   time.Time `json:"due_date"`
+	Principal float64   `json:"principal"`
+	Interest  float64   `json:"interest"`
+	Payment   float64   `json:"payment"`
+	Balance   float64   `json:"balance"`
+}
+
+// Schedule is the repayment of a principal over the tenor
+type Schedule struct {
+	Installments   []Installment `json:"installments"`
+	TotalInterest  float64       `json:"total_interest"`
+	TotalRepayment float64       `json:"total_repayment"` // Principal plus interest
+}
+
+// Calculate returns the schedule repaying principal at the annual rate, a percentage, with the
+// first installment due a month after start. Amounts are rounded to the cent, the last installment
+// repaying what is left of the principal. The terms must be valid.
+func Calculate(principal float64, annualRate float64, terms Terms, start time.Time) *Schedule {
+	rate := annualRate / 100
+	n := terms.TenorMonths
+	schedule := &Schedule{Installments: make([]Installment, 0, n)}
+
+	// Flat interest accrues on the original principal from start to maturity, spread evenly
+	flatInterest := round(principal * rate * YearFraction(terms.DayCount, start, addMonths(start, n)))
+
+	// Annuity interest and payment are at the monthly rate whatever the day count, so that the
+	// installments are equal
+	monthly := rate / 12
+	payment := principal / float64(n)
+	if monthly > 0 {
+		payment = principal * monthly / (1 - math.Pow(1+monthly, -float64(n)))
+	}
+
+	balance := principal
+	previous := start
+	for number := 1; number <= n; number++ {
+		due := addMonths(start, number)
+		last := number == n
+
+		var principalPart, interestPart float64
+		switch terms.Method {
+		case MethodAnnuity:
+			interestPart = round(balance * monthly)
+			principalPart = round(payment) - interestPart
+		case MethodDecliningBalance:
+			interestPart = round(balance * rate * YearFraction(terms.DayCount, previous, due))
+			principalPart = round(principal / float64(n))
+		default:
+			interestPart = round(flatInterest / float64(n))
+			if last {
+				interestPart = round(flatInterest - schedule.TotalInterest)
+			}
+			principalPart = round(principal / float64(n))
+		}
+		if last || principalPart > balance {
+			principalPart = balance
+		}
+
+		balance = round(balance - principalPart)
+		schedule.TotalInterest = round(schedule.TotalInterest + interestPart)
+		schedule.Installments = append(schedule.Installments, Installment{
+			Number:    number,
+			DueDate:   due,
+			Principal: principalPart,
+			Interest:  interestPart,
+			Payment:   round(principalPart + interestPart),
+			Balance:   balance,
+		})
+		previous = due
+	}
+
+	schedule.TotalRepayment = round(principal + schedule.TotalInterest)
+	return schedule
+}
+
+// YearFraction returns the fraction of a year between from and to under the day count convention
+func YearFraction(dayCount DayCount, from time.Time, to time.Time) float64 {
+	if dayCount == DayCountActual365 {
+		return math.Round(to.Sub(from).Hours()/24) / 365
+	}
+
+	y1, m1, d1 := from.Date()
+	y2, m2, d2 := to.Date()
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+	days := 360*(y2-y1) + 30*(int(m2)-int(m1)) + (d2 - d1)
+	return float64(days) / 360
+}
+
+// addMonths moves t by months, keeping its day unless the month is shorter, e.g. from the
+// 31st of January to the 28th of February
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	lastDay := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	return time.Date(year, month+time.Month(months), min(day, lastDay), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+func round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
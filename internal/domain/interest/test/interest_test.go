@@ -0,0 +1,119 @@
+package interest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalculate(t *testing.T) {
+	start := date(2025, time.January, 15)
+
+	t.Run("should charge the annual rate once with the default terms", func(t *testing.T) {
+		schedule := interest.Calculate(1000, 10, interest.Terms{}.WithDefaults(), start)
+
+		require.Len(t, schedule.Installments, 12)
+		assert.Equal(t, 100.0, schedule.TotalInterest)
+		assert.Equal(t, 1100.0, schedule.TotalRepayment)
+
+		first, last := schedule.Installments[0], schedule.Installments[11]
+		assert.Equal(t, date(2025, time.February, 15), first.DueDate)
+		assert.Equal(t, 83.33, first.Principal)
+		assert.Equal(t, 8.33, first.Interest)
+		// The last installment takes the rounding differences
+		assert.Equal(t, 83.37, last.Principal)
+		assert.Equal(t, 8.37, last.Interest)
+		assert.Equal(t, 0.0, last.Balance)
+	})
+
+	t.Run("should repay equal installments with the annuity method", func(t *testing.T) {
+		terms := interest.Terms{TenorMonths: 12, Method: interest.MethodAnnuity, DayCount: interest.DayCount30360}
+		schedule := interest.Calculate(12000, 12, terms, start)
+
+		first, last := schedule.Installments[0], schedule.Installments[11]
+		assert.Equal(t, 120.0, first.Interest)
+		assert.Equal(t, 946.19, first.Principal)
+		assert.Equal(t, 1066.19, first.Payment)
+		assert.Equal(t, 10.56, last.Interest)
+		assert.Equal(t, 0.0, last.Balance)
+		assert.Equal(t, 794.23, schedule.TotalInterest)
+		assert.Equal(t, 12794.23, schedule.TotalRepayment)
+	})
+
+	t.Run("should repay equal annuity installments with ACT/365", func(t *testing.T) {
+		terms := interest.Terms{TenorMonths: 12, Method: interest.MethodAnnuity, DayCount: interest.DayCountActual365}
+		schedule := interest.Calculate(12000, 12, terms, start)
+
+		// February is shorter than January, but the installments stay the same
+		for _, installment := range schedule.Installments[:11] {
+			assert.Equal(t, 1066.19, installment.Payment)
+		}
+		// The last installment takes the rounding differences
+		assert.InDelta(t, 1066.19, schedule.Installments[11].Payment, 0.05)
+		assert.Equal(t, 0.0, schedule.Installments[11].Balance)
+		assert.Equal(t, 794.23, schedule.TotalInterest)
+	})
+
+	t.Run("should charge interest on the outstanding balance with the declining balance method", func(t *testing.T) {
+		terms := interest.Terms{TenorMonths: 12, Method: interest.MethodDecliningBalance, DayCount: interest.DayCount30360}
+		schedule := interest.Calculate(12000, 12, terms, start)
+
+		for i, installment := range schedule.Installments {
+			assert.Equal(t, 1000.0, installment.Principal)
+			assert.Equal(t, float64(120-10*i), installment.Interest)
+		}
+		assert.Equal(t, 780.0, schedule.TotalInterest)
+	})
+
+	t.Run("should count the actual days with ACT/365", func(t *testing.T) {
+		terms := interest.Terms{TenorMonths: 12, Method: interest.MethodFlat, DayCount: interest.DayCountActual365}
+
+		assert.Equal(t, 100.0, interest.Calculate(1000, 10, terms, date(2025, time.January, 1)).TotalInterest)
+		// 2024 is a leap year
+		assert.Equal(t, 100.27, interest.Calculate(1000, 10, terms, date(2024, time.January, 1)).TotalInterest)
+	})
+
+	t.Run("should keep the due dates within shorter months", func(t *testing.T) {
+		schedule := interest.Calculate(1000, 10, interest.Terms{}.WithDefaults(), date(2025, time.January, 31))
+
+		assert.Equal(t, date(2025, time.February, 28), schedule.Installments[0].DueDate)
+		assert.Equal(t, date(2025, time.March, 31), schedule.Installments[1].DueDate)
+		assert.Equal(t, 100.0, schedule.TotalInterest)
+	})
+}
+
+func TestYearFraction(t *testing.T) {
+	tests := []struct {
+		name     string
+		dayCount interest.DayCount
+		from     time.Time
+		to       time.Time
+		want     float64
+	}{
+		{"30/360 month", interest.DayCount30360, date(2025, time.February, 1), date(2025, time.March, 1), 30.0 / 360},
+		{"30/360 from the 31st", interest.DayCount30360, date(2025, time.January, 31), date(2025, time.March, 31), 60.0 / 360},
+		{"30/360 year", interest.DayCount30360, date(2024, time.March, 15), date(2025, time.March, 15), 1},
+		{"ACT/365 February", interest.DayCountActual365, date(2025, time.February, 1), date(2025, time.March, 1), 28.0 / 365},
+		{"ACT/365 leap February", interest.DayCountActual365, date(2024, time.February, 1), date(2024, time.March, 1), 29.0 / 365},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, interest.YearFraction(tt.dayCount, tt.from, tt.to), 1e-12)
+		})
+	}
+}
+
+func TestTermsValidate(t *testing.T) {
+	assert.NoError(t, interest.Terms{}.WithDefaults().Validate())
+	assert.ErrorIs(t, interest.Terms{TenorMonths: 361, Method: interest.MethodFlat, DayCount: interest.DayCount30360}.Validate(), interest.ErrInvalidTenor)
+	assert.ErrorIs(t, interest.Terms{TenorMonths: 12, Method: "compound", DayCount: interest.DayCount30360}.Validate(), interest.ErrUnknownMethod)
+	assert.ErrorIs(t, interest.Terms{TenorMonths: 12, Method: interest.MethodFlat, DayCount: "ACT/360"}.Validate(), interest.ErrUnknownDayCount)
+}
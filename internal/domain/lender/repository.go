@@ -2,13 +2,19 @@ package lender
 
 import (
 	"context"
+	"errors"
 )
 
+// ErrLenderNotFound is returned when no lender has the requested ID
+var ErrLenderNotFound = errors.New("lender not found")
+
 // Repository defines the data access interface for borrowers
 type Repository interface {
 	Get(ctx context.Context, id string) (*Lender, error)
 	Save(ctx context.Context, borrower *Lender) error
 	Create(ctx context.Context, borrower *Lender) error
+	// CreateBatch inserts all the lenders in one transaction, none of them when one fails
+	CreateBatch(ctx context.Context, lenders []*Lender) error
 	// TODO: Implement the following methods
 	List(ctx context.Context, filter LenderFilter) ([]*Lender, error)
 	// Delete(ctx context.Context, id string) error
@@ -20,6 +26,7 @@ type LenderFilter struct {
 	Email       *string
 	PhoneNumber *string
 	IDNumber    *string
+	Query       *string // Matches name, email, phone number or ID number partially
 	Page        int
 	PageSize    int
 }
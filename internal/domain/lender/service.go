@@ -2,10 +2,16 @@ package lender
 
 import (
 	"context"
+	"errors"
 
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
 )
 
+var (
+	ErrEmailTaken    = errors.New("email is already registered")
+	ErrIDNumberTaken = errors.New("ID number is already registered")
+)
+
 // Service provides lender business operations
 type Service interface {
 	Create(ctx context.Context, fullName, email, phoneNumber, idNumber string) (*Lender, error)
@@ -23,6 +29,10 @@ func NewLenderService(r Repository) *LenderService {
 }
 
 func (s *LenderService) CreateLender(ctx context.Context, fullName, email, phoneNumber, idNumber string) (*Lender, error) {
+	if err := s.checkUniqueness(ctx, email, idNumber); err != nil {
+		return nil, err
+	}
+
 	lender := NewLender(fullName, email, phoneNumber, idNumber)
 
 	if err := s.repository.Create(ctx, lender); err != nil {
@@ -65,3 +75,24 @@ func (s *LenderService) ListLenders(ctx context.Context, filter LenderFilter) (*
 		},
 	}, nil
 }
+
+// checkUniqueness makes sure no other lender is registered with the same email or ID number
+func (s *LenderService) checkUniqueness(ctx context.Context, email, idNumber string) error {
+	count, err := s.repository.Count(ctx, LenderFilter{Email: &email})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrEmailTaken
+	}
+
+	count, err = s.repository.Count(ctx, LenderFilter{IDNumber: &idNumber})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrIDNumberTaken
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package loan
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+)
+
+// Snapshot is the state of a loan after the event at Version was applied,
+// so that rebuilding the loan only replays the events that followed
+type Snapshot struct {
+	LoanID  string
+	Version int
+	Loan    *Loan
+}
+
+// RebuildLoan replays events on top of a snapshot, or from the beginning of the stream when
+// the snapshot is nil. It returns the loan and the version of the last applied event.
+func RebuildLoan(snapshot *Snapshot, events []DomainEvent) (*Loan, int, error) {
+	var l *Loan
+	version := 0
+	if snapshot != nil {
+		copied := *snapshot.Loan
+		copied.StatusTransitions = append([]StatusTransition(nil), snapshot.Loan.StatusTransitions...)
+		l = &copied
+		version = snapshot.Version
+		// Snapshots taken before loans had interest terms get the default ones
+		if l.TenorMonths == 0 {
+			l.SetTerms(interest.Terms{})
+		}
+	}
+
+	for _, event := range events {
+		if event.Version <= version {
+			continue
+		}
+		if l == nil {
+			if event.Type != EventTypeLoanCreated {
+				return nil, 0, fmt.Errorf("event stream of loan %s does not start with %s", event.LoanID, EventTypeLoanCreated)
+			}
+			l = &Loan{ID: event.LoanID}
+		}
+		if err := l.Apply(event); err != nil {
+			return nil, 0, err
+		}
+		version = event.Version
+	}
+
+	if l == nil {
+		return nil, 0, nil
+	}
+	return l, version, nil
+}
+
+// Apply changes the loan as described by the event, which must follow the events already applied.
+// The status transitions rebuilt from events take the event ID.
+func (l *Loan) Apply(event DomainEvent) error {
+	at := event.OccurredAt
+
+	switch payload := event.Payload.(type) {
+	case LoanCreatedPayload:
+		l.BorrowerID = payload.BorrowerID
+		if payload.ProductID != "" {
+			productID := payload.ProductID
+			l.ProductID = &productID
+		}
+		l.Amount = payload.Amount
+		l.Rate = payload.Rate
+		l.ROI = payload.ROI
+		l.CreatedAt = at
+		l.SetTerms(interest.Terms{TenorMonths: payload.TenorMonths, Method: payload.InterestMethod, DayCount: payload.DayCount})
+		l.applyTransition(event, StatusProposed, at, "Loan created", "system")
+
+	case LoanApprovedPayload:
+		approvedBy := payload.ApprovedBy
+		surveyDocumentID := payload.SurveyDocumentID
+		approvalDate := payload.ApprovalDate
+		l.ApprovedBy = &approvedBy
+		l.SurveyDocumentID = &surveyDocumentID
+		l.ApprovalDate = &approvalDate
+		l.applyTransition(event, StatusApproved, approvalDate, "Loan approved", approvedBy)
+
+	case LoanInvestmentReceivedPayload:
+		// Investments are recorded as loan lenders, the loan itself only changes once fully funded
+
+	case LoanFullyFundedPayload:
+		investmentDate := payload.InvestmentDate
+		agreementDocumentID := payload.AgreementDocumentID
+		l.InvestmentDate = &investmentDate
+		l.AgreementDocumentID = &agreementDocumentID
+		l.applyTransition(event, StatusInvested, investmentDate, "Loan fully invested", payload.FundedBy)
+
+	case LoanDisbursedPayload:
+		disbursedBy := payload.DisbursedBy
+		agreementDocumentID := payload.AgreementDocumentID
+		disbursementDate := payload.DisbursementDate
+		l.DisbursedBy = &disbursedBy
+		l.AgreementDocumentID = &agreementDocumentID
+		l.DisbursementDate = &disbursementDate
+		l.UpdateTotals()
+		l.applyTransition(event, StatusDisbursed, disbursementDate, "Loan disbursed", disbursedBy)
+
+	case LoanRejectedPayload:
+		l.applyTransition(event, StatusRejected, at, "Loan rejected: "+payload.Reason, payload.RejectedBy)
+
+	case LoanCancelledPayload:
+		l.applyTransition(event, StatusCancelled, at, "Loan cancelled: "+payload.Reason, payload.CancelledBy)
+
+	case LoanExpiredPayload:
+		l.applyTransition(event, StatusExpired, at, "Loan funding expired", "system")
+
+	default:
+		return fmt.Errorf("cannot apply %s event with payload %T to loan %s", event.Type, event.Payload, l.ID)
+	}
+
+	l.UpdatedAt = at
+	return nil
+}
+
+func (l *Loan) applyTransition(event DomainEvent, to Status, date time.Time, description string, performedBy string) {
+	l.StatusTransitions = append(l.StatusTransitions, StatusTransition{
+		ID:          event.ID,
+		LoanID:      l.ID,
+		From:        l.Status,
+		To:          to,
+		Date:        date,
+		Description: description,
+		PerformedBy: performedBy,
+	})
+	l.Status = to
+}
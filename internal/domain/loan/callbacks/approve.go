@@ -3,6 +3,7 @@ package callbacks
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/looplab/fsm"
@@ -22,26 +23,45 @@ func (p *CallbackProvider) BeforeApproval(ctx context.Context, e *fsm.Event) {
 	fileName := e.Args[2].(string)
 
 	if fileName == "" {
-		e.Cancel(errors.New("document is required"))
+		e.Cancel(loan.Refuse("document is required"))
 		return
 	}
 
 	if approvedBy == "" {
-		e.Cancel(errors.New("approved by is required"))
+		e.Cancel(loan.Refuse("approved by is required"))
 		return
 	}
 
 	// validate transition
 	err := p.Validator.Validate(loanObj, loan.Status(e.Src), loan.Status(e.Dst))
 	if err != nil {
-		e.Cancel(errors.New(err.Error()))
+		e.Cancel(loan.Refuse(err.Error()))
 		return
 	}
 
 	// check employee exists in DB
-	_, err = p.EmployeeRepository.Get(context.Background(), approvedBy)
+	_, err = p.EmployeeRepository.Get(ctx, approvedBy)
 	if err != nil {
-		e.Cancel(errors.New("employee not found"))
+		e.Cancel(loan.Refuse("employee not found"))
+		return
+	}
+
+	// the borrower's limit may have been lowered since the loan was proposed
+	borrowerObj, err := p.BorrowerRepository.Get(ctx, loanObj.BorrowerID)
+	if err != nil {
+		e.Cancel(loan.Refuse("borrower not found"))
+		return
+	}
+
+	byStatus, err := p.LoanRepository.SumByStatus(ctx, loanObj.BorrowerID)
+	if err != nil {
+		e.Cancel(fmt.Errorf("error calculating borrower exposure: %w", err))
+		return
+	}
+
+	// the loan being approved is already part of the outstanding amount
+	if err := loan.NewBorrowerExposure(borrowerObj, byStatus).CheckCreditLimit(0); err != nil {
+		e.Cancel(err)
 		return
 	}
 }
@@ -57,7 +77,7 @@ func (p *CallbackProvider) AfterApproval(ctx context.Context, e *fsm.Event) {
 	// insert document
 	doc := document.NewDocument(loanObj.ID, fileName)
 	// create document
-	docId, docErr := p.DocumentRepository.Create(context.Background(), doc)
+	docId, docErr := p.DocumentRepository.Create(ctx, doc)
 	if docErr != nil {
 		e.Cancel(errors.New("error creating document"))
 		return
@@ -77,9 +97,16 @@ func (p *CallbackProvider) AfterApproval(ctx context.Context, e *fsm.Event) {
 		PerformedBy: approvedBy,
 	})
 
+	loanObj.RecordEvent(loan.EventTypeLoanApproved, loan.LoanApprovedPayload{
+		BorrowerID:       loanObj.BorrowerID,
+		ApprovedBy:       approvedBy,
+		SurveyDocumentID: docId,
+		ApprovalDate:     now,
+	})
+
 	// update to DB
 	// TODO: Should be in transaction
-	err := p.LoanRepository.Save(context.Background(), loanObj)
+	err := p.LoanRepository.Save(ctx, loanObj)
 	if err != nil {
 		e.Cancel(errors.New("error updating loan status"))
 		return
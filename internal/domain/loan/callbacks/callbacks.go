@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"github.com/looplab/fsm"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/document"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
 	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
 )
 
 type LoanCallbackProvider interface {
@@ -24,33 +26,48 @@ type LoanCallbackProvider interface {
 
 	BeforeDisburse(ctx context.Context, e *fsm.Event)
 	AfterDisburse(ctx context.Context, e *fsm.Event)
+
+	BeforeReject(ctx context.Context, e *fsm.Event)
+	AfterReject(ctx context.Context, e *fsm.Event)
+
+	BeforeCancel(ctx context.Context, e *fsm.Event)
+	AfterCancel(ctx context.Context, e *fsm.Event)
+
+	BeforeExpire(ctx context.Context, e *fsm.Event)
+	AfterExpire(ctx context.Context, e *fsm.Event)
 }
 
 // CallbackProvider provides callback functions for the loan state machine
 type CallbackProvider struct {
+	BorrowerRepository   borrower.Repository
 	LenderRepository     lender.Repository
 	LoanRepository       loan.Repository
 	LoanLenderRepository loanlender.Repository
 	EmployeeRepository   employee.Repository
 	DocumentRepository   document.Repository
+	WalletRepository     wallet.Repository
 	Validator            loan.DefaultStatusValidator
 }
 
 var _ LoanCallbackProvider = (*CallbackProvider)(nil)
 
 func New(
+	borrowerRepo borrower.Repository,
 	lenderRepo lender.Repository,
 	loanRepo loan.Repository,
 	loanLenderRepo loanlender.Repository,
 	empRepo employee.Repository,
 	docRepo document.Repository,
+	walletRepo wallet.Repository,
 ) *CallbackProvider {
 	return &CallbackProvider{
+		BorrowerRepository:   borrowerRepo,
 		LenderRepository:     lenderRepo,
 		LoanRepository:       loanRepo,
 		LoanLenderRepository: loanLenderRepo,
 		EmployeeRepository:   empRepo,
 		DocumentRepository:   docRepo,
+		WalletRepository:     walletRepo,
 		Validator:            *loan.NewDefaultStatusValidator(),
 	}
 }
@@ -67,5 +84,10 @@ func (p *CallbackProvider) GetCallbacks() fsm.Callbacks {
 	// Add disburse callbacks
 	p.registerDisburseCallbacks(callbacks)
 
+	// Add reject, cancel and expire callbacks
+	p.registerRejectCallbacks(callbacks)
+	p.registerCancelCallbacks(callbacks)
+	p.registerExpireCallbacks(callbacks)
+
 	return callbacks
 }
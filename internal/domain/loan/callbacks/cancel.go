@@ -0,0 +1,108 @@
+package callbacks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/looplab/fsm"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+func (p *CallbackProvider) registerCancelCallbacks(callbacks fsm.Callbacks) {
+	callbacks["before_"+loan.EventCancel] = p.BeforeCancel
+	callbacks["after_"+loan.EventCancel] = p.AfterCancel
+}
+
+func (p *CallbackProvider) BeforeCancel(ctx context.Context, e *fsm.Event) {
+	loanObj := e.Args[0].(*loan.Loan)
+	cancelledBy := e.Args[1].(string)
+	reason := e.Args[2].(string)
+
+	if cancelledBy == "" {
+		e.Cancel(loan.Refuse("cancelled by is required"))
+		return
+	}
+
+	if reason == "" {
+		e.Cancel(loan.Refuse("cancellation reason is required"))
+		return
+	}
+
+	// validate transition
+	err := p.Validator.Validate(loanObj, loan.Status(e.Src), loan.Status(e.Dst))
+	if err != nil {
+		e.Cancel(loan.Refuse(err.Error()))
+		return
+	}
+
+	// check employee exists in DB
+	_, err = p.EmployeeRepository.Get(ctx, cancelledBy)
+	if err != nil {
+		e.Cancel(loan.Refuse("employee not found"))
+		return
+	}
+}
+
+func (p *CallbackProvider) AfterCancel(ctx context.Context, e *fsm.Event) {
+	loanObj := e.Args[0].(*loan.Loan)
+	cancelledBy := e.Args[1].(string)
+	reason := e.Args[2].(string)
+	now := time.Now()
+
+	// Give the reserved funds back to the investors
+	if err := p.releaseReservations(ctx, loanObj, "Loan cancelled: "+reason); err != nil {
+		e.Cancel(err)
+		return
+	}
+
+	loanObj.Status = loan.Status(e.Dst)
+	loanObj.UpdatedAt = now
+
+	loanObj.StatusTransitions = append(loanObj.StatusTransitions, loan.StatusTransition{
+		From:        loan.Status(e.Src),
+		To:          loan.Status(e.Dst),
+		Date:        now,
+		Description: "Loan cancelled: " + reason,
+		PerformedBy: cancelledBy,
+	})
+
+	loanObj.RecordEvent(loan.EventTypeLoanCancelled, loan.LoanCancelledPayload{
+		From:        loan.Status(e.Src),
+		CancelledBy: cancelledBy,
+		Reason:      reason,
+	})
+
+	err := p.LoanRepository.Save(ctx, loanObj)
+	if err != nil {
+		e.Cancel(errors.New("error updating loan status"))
+		return
+	}
+}
+
+// releaseReservations returns every investment of the loan to the lenders' available balance
+func (p *CallbackProvider) releaseReservations(ctx context.Context, loanObj *loan.Loan, description string) error {
+	investments, err := p.LoanLenderRepository.GetByLoanID(ctx, loanObj.ID)
+	if err != nil {
+		return fmt.Errorf("error fetching investments: %w", err)
+	}
+
+	for _, investment := range investments {
+		lenderWallet, err := p.WalletRepository.GetByLenderID(ctx, investment.LenderID)
+		if err != nil {
+			return fmt.Errorf("error fetching lender wallet: %w", err)
+		}
+
+		transaction, err := lenderWallet.Release(loanObj.ID, investment.Amount, description)
+		if err != nil {
+			return err
+		}
+
+		if err := p.WalletRepository.Apply(ctx, lenderWallet, transaction); err != nil {
+			return fmt.Errorf("error releasing reserved funds: %w", err)
+		}
+	}
+
+	return nil
+}
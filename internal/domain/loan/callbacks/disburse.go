@@ -3,12 +3,15 @@ package callbacks
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/looplab/fsm"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/document"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
 )
 
 func (p *CallbackProvider) registerDisburseCallbacks(callbacks fsm.Callbacks) {
@@ -22,25 +25,25 @@ func (p *CallbackProvider) BeforeDisburse(ctx context.Context, e *fsm.Event) {
 	agreementDocFileName := e.Args[2].(string)
 
 	if agreementDocFileName == "" {
-		e.Cancel(errors.New("loan agreement document is required"))
+		e.Cancel(loan.Refuse("loan agreement document is required"))
 		return
 	}
 
 	if fieldOfficerId == "" {
-		e.Cancel(errors.New("field officer ID is required"))
+		e.Cancel(loan.Refuse("field officer ID is required"))
 		return
 	}
 
 	// Validate transition
 	err := p.Validator.Validate(loanObj, loan.Status(e.Src), loan.Status(e.Dst))
 	if err != nil {
-		e.Cancel(errors.New(err.Error()))
+		e.Cancel(loan.Refuse(err.Error()))
 		return
 	}
 
-	_, err = p.EmployeeRepository.Get(context.Background(), fieldOfficerId)
+	_, err = p.EmployeeRepository.Get(ctx, fieldOfficerId)
 	if err != nil {
-		e.Cancel(errors.New("field officer not found"))
+		e.Cancel(loan.Refuse("field officer not found"))
 		return
 	}
 
@@ -50,17 +53,17 @@ func (p *CallbackProvider) BeforeDisburse(ctx context.Context, e *fsm.Event) {
 		return
 	}
 	if len(loanlenders) == 0 {
-		e.Cancel(errors.New("loan must have at least one investor before disbursement"))
+		e.Cancel(loan.Refuse("loan must have at least one investor before disbursement"))
 		return
 	}
 
 	if loanObj.ROI <= 0 {
-		e.Cancel(errors.New("loan interest rate must be set before disbursement"))
+		e.Cancel(loan.Refuse("loan interest rate must be set before disbursement"))
 		return
 	}
 
 	if loanObj.Rate <= 0 {
-		e.Cancel(errors.New("loan rate must be set before disbursement"))
+		e.Cancel(loan.Refuse("loan rate must be set before disbursement"))
 		return
 	}
 }
@@ -72,22 +75,44 @@ func (p *CallbackProvider) AfterDisburse(ctx context.Context, e *fsm.Event) {
 	agreementDocFileName := e.Args[2].(string)
 
 	agreementDoc := document.NewDocument(loanObj.ID, agreementDocFileName)
-	docId, err := p.DocumentRepository.Create(context.Background(), agreementDoc)
+	docId, err := p.DocumentRepository.Create(ctx, agreementDoc)
 	if err != nil {
 		e.Cancel(errors.New("error saving loan agreement document"))
 		return
 	}
 
-	roiAmount, err := p.calculateAndSetInvestorROI(ctx, loanObj)
+	// The repayment schedule starts on disbursement
+	loanObj.DisbursementDate = &now
+	loanObj.UpdateTotals()
+
+	investments, err := p.setInvestorPayouts(ctx, loanObj)
 	if err != nil {
 		e.Cancel(err)
 		return
 	}
 
-	repaymentAmount := calculateBorrowerRepayment(loanObj)
+	roiAmount := 0.0
+	investors := make([]response.InvestorPayout, 0, len(investments))
+	for _, investment := range investments {
+		roiAmount = math.Round((roiAmount+investment.Payout)*100) / 100
+		investors = append(investors, response.InvestorPayout{
+			InvestmentID:   investment.ID,
+			LenderID:       investment.LenderID,
+			Amount:         investment.Amount,
+			ExpectedReturn: investment.ExpectedReturn,
+			Payout:         investment.Payout,
+		})
+	}
+
+	repaymentAmount := loanObj.TotalRepayment
+
+	// Reserved investments are now actually paid out to the borrower
+	if err := p.debitReservations(ctx, loanObj); err != nil {
+		e.Cancel(err)
+		return
+	}
 
 	loanObj.Status = loan.Status(e.Dst)
-	loanObj.DisbursementDate = &now
 	loanObj.DisbursedBy = &fieldOfficerId
 	loanObj.AgreementDocumentID = &docId
 	loanObj.UpdatedAt = now
@@ -100,9 +125,16 @@ func (p *CallbackProvider) AfterDisburse(ctx context.Context, e *fsm.Event) {
 		PerformedBy: fieldOfficerId,
 	})
 
-	loanObj.DisbursementDate = &now
+	loanObj.RecordEvent(loan.EventTypeLoanDisbursed, loan.LoanDisbursedPayload{
+		BorrowerID:          loanObj.BorrowerID,
+		DisbursedBy:         fieldOfficerId,
+		AgreementDocumentID: docId,
+		DisbursementDate:    now,
+		BorrowerRepayment:   repaymentAmount,
+		InvestorROI:         roiAmount,
+	})
 
-	err = p.LoanRepository.Save(context.Background(), loanObj)
+	err = p.LoanRepository.Save(ctx, loanObj)
 	if err != nil {
 		e.Cancel(errors.New("error updating loan status"))
 		return
@@ -116,30 +148,57 @@ func (p *CallbackProvider) AfterDisburse(ctx context.Context, e *fsm.Event) {
 			DisbursedBy:       fieldOfficerId,
 			BorrowerRepayment: repaymentAmount,
 			InvestorROI:       roiAmount,
+			Investors:         investors,
 		}
 	}
 }
 
-func (p *CallbackProvider) calculateAndSetInvestorROI(ctx context.Context, loan *loan.Loan) (float64, error) {
-	totalInvestment := 0.0
-
-	loanLenders, err := p.LoanLenderRepository.GetByLoanID(ctx, loan.ID)
+// setInvestorPayouts splits the return earned at the loan ROI, over the schedule starting on
+// disbursement, between the investments in the loan by their share and saves their payouts
+func (p *CallbackProvider) setInvestorPayouts(ctx context.Context, loanObj *loan.Loan) ([]*loanlender.LoanLender, error) {
+	investments, err := p.LoanLenderRepository.GetByLoanID(ctx, loanObj.ID)
 	if err != nil {
-		return 0, err
+		return nil, fmt.Errorf("error fetching investments: %w", err)
 	}
 
-	for _, loanLender := range loanLenders {
-		totalInvestment += loanLender.Amount
+	totalInvestment := 0.0
+	for _, investment := range investments {
+		totalInvestment += investment.Amount
 	}
 
-	roiAmount := totalInvestment * (loan.ROI / 100)
+	loanlender.AllocateReturn(investments, loanObj.InvestorReturn(totalInvestment))
+
+	for _, investment := range investments {
+		if err := p.LoanLenderRepository.Save(ctx, investment); err != nil {
+			return nil, fmt.Errorf("error saving investor payout: %w", err)
+		}
+	}
 
-	return totalInvestment + roiAmount, nil
+	return investments, nil
 }
 
-func calculateBorrowerRepayment(loan *loan.Loan) float64 {
-	interestAmount := loan.Amount * (loan.Rate / 100.0)
-	totalRepayment := loan.Amount + interestAmount
+// debitReservations converts every reservation made for the loan into a debit on the lender wallet
+func (p *CallbackProvider) debitReservations(ctx context.Context, loanObj *loan.Loan) error {
+	investments, err := p.LoanLenderRepository.GetByLoanID(ctx, loanObj.ID)
+	if err != nil {
+		return fmt.Errorf("error fetching investments: %w", err)
+	}
+
+	for _, investment := range investments {
+		lenderWallet, err := p.WalletRepository.GetByLenderID(ctx, investment.LenderID)
+		if err != nil {
+			return fmt.Errorf("error fetching lender wallet: %w", err)
+		}
+
+		transaction, err := lenderWallet.Debit(loanObj.ID, investment.Amount)
+		if err != nil {
+			return err
+		}
+
+		if err := p.WalletRepository.Apply(ctx, lenderWallet, transaction); err != nil {
+			return fmt.Errorf("error debiting lender wallet: %w", err)
+		}
+	}
 
-	return totalRepayment
+	return nil
 }
@@ -0,0 +1,58 @@
+package callbacks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/looplab/fsm"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+func (p *CallbackProvider) registerExpireCallbacks(callbacks fsm.Callbacks) {
+	callbacks["before_"+loan.EventExpire] = p.BeforeExpire
+	callbacks["after_"+loan.EventExpire] = p.AfterExpire
+}
+
+func (p *CallbackProvider) BeforeExpire(ctx context.Context, e *fsm.Event) {
+	loanObj := e.Args[0].(*loan.Loan)
+
+	// validate transition
+	err := p.Validator.Validate(loanObj, loan.Status(e.Src), loan.Status(e.Dst))
+	if err != nil {
+		e.Cancel(loan.Refuse(err.Error()))
+		return
+	}
+}
+
+func (p *CallbackProvider) AfterExpire(ctx context.Context, e *fsm.Event) {
+	loanObj := e.Args[0].(*loan.Loan)
+	now := time.Now()
+
+	// Partially funded loans keep the investors' money reserved until they expire
+	if err := p.releaseReservations(ctx, loanObj, "Loan funding expired"); err != nil {
+		e.Cancel(err)
+		return
+	}
+
+	loanObj.Status = loan.Status(e.Dst)
+	loanObj.UpdatedAt = now
+
+	loanObj.StatusTransitions = append(loanObj.StatusTransitions, loan.StatusTransition{
+		From:        loan.Status(e.Src),
+		To:          loan.Status(e.Dst),
+		Date:        now,
+		Description: "Loan funding expired",
+		PerformedBy: "system",
+	})
+
+	loanObj.RecordEvent(loan.EventTypeLoanExpired, loan.LoanExpiredPayload{
+		From: loan.Status(e.Src),
+	})
+
+	err := p.LoanRepository.Save(ctx, loanObj)
+	if err != nil {
+		e.Cancel(errors.New("error updating loan status"))
+		return
+	}
+}
@@ -3,6 +3,7 @@ package callbacks
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,6 +13,7 @@ import (
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
 	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
 )
 
 func (p *CallbackProvider) registerInvestCallbacks(callbacks fsm.Callbacks) {
@@ -25,13 +27,13 @@ func (p *CallbackProvider) BeforeInvest(ctx context.Context, e *fsm.Event) {
 	amount := e.Args[2].(float64)
 
 	if amount <= 0 {
-		e.Cancel(errors.New("investment amount must be positive"))
+		e.Cancel(loan.Refuse("investment amount must be positive"))
 		return
 	}
 
 	// Validate lender exists
 	if _, err := p.LenderRepository.Get(ctx, lender.ID); err != nil {
-		e.Cancel(errors.New("lender not found"))
+		e.Cancel(loan.Refuse("lender not found"))
 		return
 	}
 
@@ -39,7 +41,7 @@ func (p *CallbackProvider) BeforeInvest(ctx context.Context, e *fsm.Event) {
 	var currentInvestment float64
 	investments, err := p.LoanLenderRepository.GetByLoanID(ctx, loanObj.ID)
 	if err != nil {
-		e.Cancel(errors.New("error fetching investments: " + err.Error()))
+		e.Cancel(fmt.Errorf("error fetching investments: %w", err))
 		return
 	}
 
@@ -51,14 +53,30 @@ func (p *CallbackProvider) BeforeInvest(ctx context.Context, e *fsm.Event) {
 	remainingPrincipal := loanObj.Amount - currentInvestment
 
 	if amount > remainingPrincipal {
-		e.Cancel(errors.New("investment exceeds remaining principal amount"))
+		e.Cancel(loan.Refuse("investment exceeds remaining principal amount"))
+		return
+	}
+
+	// Lender must have enough available funds in the wallet, which lenders get on their first deposit
+	lenderWallet, err := p.WalletRepository.GetByLenderID(ctx, lender.ID)
+	if errors.Is(err, wallet.ErrWalletNotFound) {
+		e.Cancel(wallet.ErrInsufficientBalance)
+		return
+	}
+	if err != nil {
+		e.Cancel(fmt.Errorf("error fetching lender wallet: %w", err))
+		return
+	}
+
+	if amount > lenderWallet.Available() {
+		e.Cancel(wallet.ErrInsufficientBalance)
 		return
 	}
 
 	// If this will fully fund the loan, proceed with state change
 	validateErr := p.Validator.Validate(loanObj, loan.Status(e.Src), loan.Status(e.Dst))
 	if validateErr != nil {
-		e.Cancel(validateErr)
+		e.Cancel(loan.Refuse(validateErr.Error()))
 		return
 	}
 
@@ -73,7 +91,7 @@ func (p *CallbackProvider) AfterInvest(ctx context.Context, e *fsm.Event) {
 	var currentInvestment float64
 	investments, err := p.LoanLenderRepository.GetByLoanID(ctx, loanObj.ID)
 	if err != nil {
-		e.Cancel(errors.New("error fetching investments: " + err.Error()))
+		e.Cancel(fmt.Errorf("error fetching investments: %w", err))
 		return
 	}
 
@@ -81,19 +99,42 @@ func (p *CallbackProvider) AfterInvest(ctx context.Context, e *fsm.Event) {
 		currentInvestment += investment.Amount
 	}
 
+	// Reserve the funds before recording the investment
+	lenderWallet, err := p.WalletRepository.GetByLenderID(ctx, lender.ID)
+	if err != nil {
+		e.Cancel(fmt.Errorf("error fetching lender wallet: %w", err))
+		return
+	}
+
+	reservation, err := lenderWallet.Reserve(loanObj.ID, amount)
+	if err != nil {
+		e.Cancel(err)
+		return
+	}
+
+	if err := p.WalletRepository.Apply(ctx, lenderWallet, reservation); err != nil {
+		e.Cancel(fmt.Errorf("error reserving funds: %w", err))
+		return
+	}
+
 	investedTime := time.Now()
 
 	loanLender := loanlender.LoanLender{
-		ID:        uuid.New().String(),
-		LoanID:    loanObj.ID,
-		LenderID:  lender.ID,
-		Amount:    amount,
-		CreatedAt: investedTime,
+		ID:         uuid.New().String(),
+		LoanID:     loanObj.ID,
+		LenderID:   lender.ID,
+		Amount:     amount,
+		InvestedAt: investedTime,
+		CreatedAt:  investedTime,
+		UpdatedAt:  investedTime,
 	}
+	// Projected until the loan is disbursed
+	loanLender.SetExpectedReturn(loanObj.InvestorReturn(amount))
 
+	// The reservation is rolled back with the transaction of the event when this fails
 	createErr := p.LoanLenderRepository.Create(ctx, &loanLender)
 	if createErr != nil {
-		e.Cancel(errors.New("error creating investment record: " + createErr.Error()))
+		e.Cancel(fmt.Errorf("error creating investment record: %w", createErr))
 		return
 	}
 
@@ -101,11 +142,19 @@ func (p *CallbackProvider) AfterInvest(ctx context.Context, e *fsm.Event) {
 
 	var agreementDocLink *string
 
+	loanObj.UpdatedAt = investedTime
+	loanObj.RecordEvent(loan.EventTypeLoanInvestmentReceived, loan.LoanInvestmentReceivedPayload{
+		InvestmentID:    loanLender.ID,
+		LenderID:        lender.ID,
+		Amount:          amount,
+		TotalInvested:   currentInvestment + amount,
+		RemainingAmount: loanObj.Amount - (currentInvestment + amount),
+	})
+
 	// Update status when fully funded only
 	if willBeFullyFunded {
 		loanObj.Status = loan.Status(e.Dst)
 		loanObj.InvestmentDate = &investedTime
-		loanObj.UpdatedAt = investedTime
 
 		loanObj.StatusTransitions = append(loanObj.StatusTransitions, loan.StatusTransition{
 			From:        loan.Status(e.Src),
@@ -115,26 +164,35 @@ func (p *CallbackProvider) AfterInvest(ctx context.Context, e *fsm.Event) {
 			PerformedBy: lender.ID,
 		})
 
-		// Create agreement letter
+		// Create agreement letter, investors receive it with the LoanFullyFunded notification
 		document := &document.Document{
 			ID:       uuid.New().String(),
 			FileName: "agreement_" + loanObj.ID + ".pdf",
 		}
 		agreementDocID, err := p.DocumentRepository.Create(ctx, document)
 		if err != nil {
-			e.Cancel(errors.New("error creating agreement document: " + err.Error()))
+			e.Cancel(fmt.Errorf("error creating agreement document: %w", err))
 			return
 		}
 
 		loanObj.AgreementDocumentID = &agreementDocID
 		agreementDocLink = &document.FileName
 
-		// Update loan in DB
-		err = p.LoanRepository.Save(ctx, loanObj)
-		if err != nil {
-			e.Cancel(errors.New("error updating loan status: " + err.Error()))
-			return
-		}
+		loanObj.RecordEvent(loan.EventTypeLoanFullyFunded, loan.LoanFullyFundedPayload{
+			BorrowerID:          loanObj.BorrowerID,
+			FundedBy:            lender.ID,
+			TotalInvested:       currentInvestment + amount,
+			AgreementDocumentID: agreementDocID,
+			AgreementDocument:   document.FileName,
+			InvestmentDate:      investedTime,
+		})
+	}
+
+	// Partial investments are saved as well so their event reaches the outbox
+	err = p.LoanRepository.Save(ctx, loanObj)
+	if err != nil {
+		e.Cancel(fmt.Errorf("error updating loan status: %w", err))
+		return
 	}
 	if result, ok := ctx.Value(loan.InvestResultKey).(*response.LoanLenderResponse); ok {
 		// Copy values to the result pointer
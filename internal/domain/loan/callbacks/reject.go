@@ -0,0 +1,75 @@
+package callbacks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/looplab/fsm"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+func (p *CallbackProvider) registerRejectCallbacks(callbacks fsm.Callbacks) {
+	callbacks["before_"+loan.EventReject] = p.BeforeReject
+	callbacks["after_"+loan.EventReject] = p.AfterReject
+}
+
+func (p *CallbackProvider) BeforeReject(ctx context.Context, e *fsm.Event) {
+	loanObj := e.Args[0].(*loan.Loan)
+	rejectedBy := e.Args[1].(string)
+	reason := e.Args[2].(string)
+
+	if rejectedBy == "" {
+		e.Cancel(loan.Refuse("rejected by is required"))
+		return
+	}
+
+	if reason == "" {
+		e.Cancel(loan.Refuse("rejection reason is required"))
+		return
+	}
+
+	// validate transition
+	err := p.Validator.Validate(loanObj, loan.Status(e.Src), loan.Status(e.Dst))
+	if err != nil {
+		e.Cancel(loan.Refuse(err.Error()))
+		return
+	}
+
+	// check employee exists in DB
+	_, err = p.EmployeeRepository.Get(ctx, rejectedBy)
+	if err != nil {
+		e.Cancel(loan.Refuse("employee not found"))
+		return
+	}
+}
+
+func (p *CallbackProvider) AfterReject(ctx context.Context, e *fsm.Event) {
+	loanObj := e.Args[0].(*loan.Loan)
+	rejectedBy := e.Args[1].(string)
+	reason := e.Args[2].(string)
+	now := time.Now()
+
+	loanObj.Status = loan.Status(e.Dst)
+	loanObj.UpdatedAt = now
+
+	loanObj.StatusTransitions = append(loanObj.StatusTransitions, loan.StatusTransition{
+		From:        loan.Status(e.Src),
+		To:          loan.Status(e.Dst),
+		Date:        now,
+		Description: "Loan rejected: " + reason,
+		PerformedBy: rejectedBy,
+	})
+
+	loanObj.RecordEvent(loan.EventTypeLoanRejected, loan.LoanRejectedPayload{
+		BorrowerID: loanObj.BorrowerID,
+		RejectedBy: rejectedBy,
+		Reason:     reason,
+	})
+
+	err := p.LoanRepository.Save(ctx, loanObj)
+	if err != nil {
+		e.Cancel(errors.New("error updating loan status"))
+		return
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/document"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
 )
 
 type Status string
@@ -14,10 +15,26 @@ const (
 	StatusInvested  Status = "invested"
 	StatusDisbursed Status = "disbursed"
 	StatusRejected  Status = "rejected"
+	StatusCancelled Status = "cancelled"
+	StatusExpired   Status = "expired"
 )
 
-// To mark the history of the status transition
+// Statuses lists every loan status, in the order of the workflow
+var Statuses = []Status{
+	StatusProposed,
+	StatusApproved,
+	StatusInvested,
+	StatusDisbursed,
+	StatusRejected,
+	StatusCancelled,
+	StatusExpired,
+}
+
+// To mark the history of the status transition.
+// A transition without ID has not been persisted yet.
 type StatusTransition struct {
+	ID          string    `json:"id"`
+	LoanID      string    `json:"loan_id"`
 	From        Status    `json:"from"`
 	To          Status    `json:"to"`
 	Date        time.Time `json:"date"`
@@ -28,9 +45,15 @@ type StatusTransition struct {
 type Loan struct {
 	ID                  string             `json:"id"`
 	BorrowerID          string             `json:"borrower_id"`
+	ProductID           *string            `json:"product_id"` // Unset on the loans proposed before the product catalog
 	Amount              float64            `json:"amount"`
-	Rate                float64            `json:"rate"`
-	ROI                 float64            `json:"roi"`
+	Rate                float64            `json:"rate"` // Annual interest rate paid by the borrower, in percent
+	ROI                 float64            `json:"roi"`  // Annual return paid to the investors, in percent
+	TenorMonths         int                `json:"tenor_months"`
+	InterestMethod      interest.Method    `json:"interest_method"`
+	DayCount            interest.DayCount  `json:"day_count"`
+	TotalInterest       float64            `json:"total_interest"`  // Interest over the tenor, projected from the creation until disbursed
+	TotalRepayment      float64            `json:"total_repayment"` // Principal plus interest
 	Status              Status             `json:"status"`
 	SurveyDocumentID    *string            `json:"survey_document_id"`
 	SurveyDocument      *document.Document `json:"survey_document,omitempty"`
@@ -44,12 +67,14 @@ type Loan struct {
 	StatusTransitions   []StatusTransition `json:"status_transitions"`
 	CreatedAt           time.Time          `json:"created_at"`
 	UpdatedAt           time.Time          `json:"updated_at"`
+
+	events []DomainEvent // Recorded domain events not yet persisted
 }
 
-func NewLoan(id string, borrowerID string, amount float64, rate float64, roi float64) *Loan {
+func NewLoan(id string, borrowerID string, amount float64, rate float64, roi float64, terms interest.Terms) *Loan {
 	now := time.Now()
 
-	return &Loan{
+	loan := &Loan{
 		ID:         id,
 		BorrowerID: borrowerID,
 		Amount:     amount,
@@ -68,4 +93,48 @@ func NewLoan(id string, borrowerID string, amount float64, rate float64, roi flo
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
+	loan.SetTerms(terms)
+
+	return loan
+}
+
+// Terms returns the interest terms of the loan
+func (l *Loan) Terms() interest.Terms {
+	return interest.Terms{TenorMonths: l.TenorMonths, Method: l.InterestMethod, DayCount: l.DayCount}
+}
+
+// SetTerms changes the interest terms of the loan, the unset ones taking their default,
+// and updates its totals
+func (l *Loan) SetTerms(terms interest.Terms) {
+	terms = terms.WithDefaults()
+	l.TenorMonths = terms.TenorMonths
+	l.InterestMethod = terms.Method
+	l.DayCount = terms.DayCount
+	l.UpdateTotals()
+}
+
+// Schedule returns the repayment schedule of the loan at its rate, starting on the disbursement
+// date, or projected from the creation of the loan until it is disbursed
+func (l *Loan) Schedule() *interest.Schedule {
+	return interest.Calculate(l.Amount, l.Rate, l.Terms(), l.scheduleStart())
+}
+
+// InvestorReturn returns the interest earned at the loan ROI on an invested amount, over the
+// same schedule as the borrower repayment
+func (l *Loan) InvestorReturn(invested float64) float64 {
+	return interest.Calculate(invested, l.ROI, l.Terms(), l.scheduleStart()).TotalInterest
+}
+
+// UpdateTotals sets the total interest and repayment from the schedule, once its start changes
+func (l *Loan) UpdateTotals() {
+	schedule := l.Schedule()
+	l.TotalInterest = schedule.TotalInterest
+	l.TotalRepayment = schedule.TotalRepayment
+}
+
+func (l *Loan) scheduleStart() time.Time {
+	if l.DisbursementDate != nil {
+		return *l.DisbursementDate
+	}
+	return l.CreatedAt
 }
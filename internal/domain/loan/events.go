@@ -0,0 +1,177 @@
+package loan
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+)
+
+// EventType names a domain event emitted by the loan aggregate
+type EventType string
+
+const (
+	EventTypeLoanCreated            EventType = "LoanCreated"
+	EventTypeLoanApproved           EventType = "LoanApproved"
+	EventTypeLoanInvestmentReceived EventType = "LoanInvestmentReceived"
+	EventTypeLoanFullyFunded        EventType = "LoanFullyFunded"
+	EventTypeLoanDisbursed          EventType = "LoanDisbursed"
+	EventTypeLoanRejected           EventType = "LoanRejected"
+	EventTypeLoanCancelled          EventType = "LoanCancelled"
+	EventTypeLoanExpired            EventType = "LoanExpired"
+)
+
+// EventTypes lists every event type the loan aggregate emits
+var EventTypes = []EventType{
+	EventTypeLoanCreated,
+	EventTypeLoanApproved,
+	EventTypeLoanInvestmentReceived,
+	EventTypeLoanFullyFunded,
+	EventTypeLoanDisbursed,
+	EventTypeLoanRejected,
+	EventTypeLoanCancelled,
+	EventTypeLoanExpired,
+}
+
+func IsValidEventType(eventType string) bool {
+	for _, t := range EventTypes {
+		if string(t) == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// DomainEvent is something that happened to a loan. Events are recorded on the loan and
+// persisted by the repository together with the loan itself.
+type DomainEvent struct {
+	ID         string
+	Type       EventType
+	LoanID     string
+	Version    int // Position in the loan's event stream, set once the event is stored
+	Payload    any
+	OccurredAt time.Time
+}
+
+// LoanCreatedPayload is the payload of EventTypeLoanCreated
+type LoanCreatedPayload struct {
+	BorrowerID     string            `json:"borrower_id"`
+	ProductID      string            `json:"product_id,omitempty"`
+	Amount         float64           `json:"amount"`
+	Rate           float64           `json:"rate"`
+	ROI            float64           `json:"roi"`
+	TenorMonths    int               `json:"tenor_months,omitempty"` // The terms are unset in the events stored before they existed
+	InterestMethod interest.Method   `json:"interest_method,omitempty"`
+	DayCount       interest.DayCount `json:"day_count,omitempty"`
+}
+
+// LoanApprovedPayload is the payload of EventTypeLoanApproved
+type LoanApprovedPayload struct {
+	BorrowerID       string    `json:"borrower_id"`
+	ApprovedBy       string    `json:"approved_by"`
+	SurveyDocumentID string    `json:"survey_document_id"`
+	ApprovalDate     time.Time `json:"approval_date"`
+}
+
+// LoanInvestmentReceivedPayload is the payload of EventTypeLoanInvestmentReceived
+type LoanInvestmentReceivedPayload struct {
+	InvestmentID    string  `json:"investment_id"`
+	LenderID        string  `json:"lender_id"`
+	Amount          float64 `json:"amount"`
+	TotalInvested   float64 `json:"total_invested"`
+	RemainingAmount float64 `json:"remaining_amount"`
+}
+
+// LoanFullyFundedPayload is the payload of EventTypeLoanFullyFunded
+type LoanFullyFundedPayload struct {
+	BorrowerID          string    `json:"borrower_id"`
+	FundedBy            string    `json:"funded_by"` // Lender whose investment completed the funding
+	TotalInvested       float64   `json:"total_invested"`
+	AgreementDocumentID string    `json:"agreement_document_id"`
+	AgreementDocument   string    `json:"agreement_document"`
+	InvestmentDate      time.Time `json:"investment_date"`
+}
+
+// LoanDisbursedPayload is the payload of EventTypeLoanDisbursed
+type LoanDisbursedPayload struct {
+	BorrowerID          string    `json:"borrower_id"`
+	DisbursedBy         string    `json:"disbursed_by"`
+	AgreementDocumentID string    `json:"agreement_document_id"`
+	DisbursementDate    time.Time `json:"disbursement_date"`
+	BorrowerRepayment   float64   `json:"borrower_repayment"`
+	InvestorROI         float64   `json:"investor_roi"`
+}
+
+// LoanRejectedPayload is the payload of EventTypeLoanRejected
+type LoanRejectedPayload struct {
+	BorrowerID string `json:"borrower_id"`
+	RejectedBy string `json:"rejected_by"`
+	Reason     string `json:"reason"`
+}
+
+// LoanCancelledPayload is the payload of EventTypeLoanCancelled
+type LoanCancelledPayload struct {
+	From        Status `json:"from"`
+	CancelledBy string `json:"cancelled_by"`
+	Reason      string `json:"reason"`
+}
+
+// LoanExpiredPayload is the payload of EventTypeLoanExpired
+type LoanExpiredPayload struct {
+	From Status `json:"from"`
+}
+
+// DecodeEventPayload decodes a stored payload into the payload type of the event
+func DecodeEventPayload(eventType EventType, data []byte) (any, error) {
+	switch eventType {
+	case EventTypeLoanCreated:
+		return decodePayload[LoanCreatedPayload](data)
+	case EventTypeLoanApproved:
+		return decodePayload[LoanApprovedPayload](data)
+	case EventTypeLoanInvestmentReceived:
+		return decodePayload[LoanInvestmentReceivedPayload](data)
+	case EventTypeLoanFullyFunded:
+		return decodePayload[LoanFullyFundedPayload](data)
+	case EventTypeLoanDisbursed:
+		return decodePayload[LoanDisbursedPayload](data)
+	case EventTypeLoanRejected:
+		return decodePayload[LoanRejectedPayload](data)
+	case EventTypeLoanCancelled:
+		return decodePayload[LoanCancelledPayload](data)
+	case EventTypeLoanExpired:
+		return decodePayload[LoanExpiredPayload](data)
+	default:
+		return nil, fmt.Errorf("unknown loan event type %q", eventType)
+	}
+}
+
+func decodePayload[T any](data []byte) (any, error) {
+	var payload T
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// RecordEvent adds an event to be persisted with the next save of the loan
+func (l *Loan) RecordEvent(eventType EventType, payload any) {
+	l.events = append(l.events, DomainEvent{
+		ID:         uuid.New().String(),
+		Type:       eventType,
+		LoanID:     l.ID,
+		Payload:    payload,
+		OccurredAt: time.Now(),
+	})
+}
+
+// PendingEvents returns the events recorded since the loan was last saved
+func (l *Loan) PendingEvents() []DomainEvent {
+	return l.events
+}
+
+// ClearEvents forgets the recorded events once they have been persisted
+func (l *Loan) ClearEvents() {
+	l.events = nil
+}
@@ -0,0 +1,140 @@
+package loan
+
+import (
+	"context"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/document"
+
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
+	"go.uber.org/zap"
+)
+
+// DefaultSnapshotInterval is the number of events after which a new snapshot of a loan is taken
+const DefaultSnapshotInterval = 20
+
+// EventStore reads the event streams of loans. Events are appended by the loan repository,
+// in the same transaction as the loan.
+type EventStore interface {
+	// Load returns the events of the loan with a version above afterVersion, in order
+	Load(ctx context.Context, loanID string, afterVersion int) ([]DomainEvent, error)
+	// LatestSnapshot returns the most recent snapshot of the loan, or nil when there is none
+	LatestSnapshot(ctx context.Context, loanID string) (*Snapshot, error)
+	SaveSnapshot(ctx context.Context, snapshot *Snapshot) error
+	// StreamIDs returns the IDs of all loans with stored events
+	StreamIDs(ctx context.Context) ([]string, error)
+}
+
+// EventSourcedRepository is a loan repository that rebuilds loans from their event stream.
+// Loans created before the event store existed are read from the wrapped repository, which also
+// keeps the loans table up to date as a projection for listing, until a snapshot of them is seeded.
+// The events only refer to the documents, which are read from the document repository.
+type EventSourcedRepository struct {
+	Repository
+	documentRepository document.Repository
+	store              EventStore
+	snapshotInterval   int
+}
+
+func NewEventSourcedRepository(r Repository, d document.Repository, store EventStore, snapshotInterval int) *EventSourcedRepository {
+	if snapshotInterval <= 0 {
+		snapshotInterval = DefaultSnapshotInterval
+	}
+
+	return &EventSourcedRepository{
+		Repository:         r,
+		documentRepository: d,
+		store:              store,
+		snapshotInterval:   snapshotInterval,
+	}
+}
+
+func (r *EventSourcedRepository) Get(ctx context.Context, id string) (*Loan, error) {
+	snapshot, err := r.store.LatestSnapshot(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	afterVersion := 0
+	if snapshot != nil {
+		afterVersion = snapshot.Version
+	}
+
+	events, err := r.store.Load(ctx, id, afterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot == nil && (len(events) == 0 || events[0].Type != EventTypeLoanCreated) {
+		lastVersion := 0
+		if len(events) > 0 {
+			lastVersion = events[len(events)-1].Version
+		}
+		return r.getLegacy(ctx, id, lastVersion)
+	}
+
+	l, version, err := RebuildLoan(snapshot, events)
+	if err != nil {
+		return nil, err
+	}
+
+	// Take a new snapshot once enough events were replayed, a failure only makes the next load slower
+	if len(events) >= r.snapshotInterval {
+		if err := r.store.SaveSnapshot(ctx, &Snapshot{LoanID: id, Version: version, Loan: l}); err != nil {
+			logging.FromContext(ctx).Warn("Failed to save loan snapshot", zap.String("loan_id", id), zap.Int("version", version), zap.Error(err))
+		}
+	}
+
+	if err := r.loadDocuments(ctx, l); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// getLegacy reads a loan created before the event store from the projection, which is written in
+// the same transaction as its events, and seeds a snapshot of it at the version of its last event,
+// so that the events appended later are replayed on top of it
+func (r *EventSourcedRepository) getLegacy(ctx context.Context, id string, version int) (*Loan, error) {
+	l, err := r.Repository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The projection may already include an event stored meanwhile, which would be replayed twice
+	newer, err := r.store.Load(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+	if len(newer) > 0 {
+		return l, nil
+	}
+
+	// A failure only leaves the loan read from the projection until the next attempt
+	if err := r.store.SaveSnapshot(ctx, &Snapshot{LoanID: id, Version: version, Loan: l}); err != nil {
+		logging.FromContext(ctx).Warn("Failed to seed legacy loan snapshot", zap.String("loan_id", id), zap.Int("version", version), zap.Error(err))
+	}
+
+	return l, nil
+}
+
+// loadDocuments sets the survey and agreement documents of a rebuilt loan, as the loans table
+// returns them
+func (r *EventSourcedRepository) loadDocuments(ctx context.Context, l *Loan) error {
+	if l.SurveyDocumentID != nil {
+		doc, err := r.documentRepository.Get(ctx, *l.SurveyDocumentID)
+		if err != nil {
+			return err
+		}
+		l.SurveyDocument = doc
+	}
+
+	if l.AgreementDocumentID != nil {
+		doc, err := r.documentRepository.Get(ctx, *l.AgreementDocumentID)
+		if err != nil {
+			return err
+		}
+		l.AgreementDocument = doc
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package loan
+
+import (
+	"context"
+	"errors"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+)
+
+var ErrCreditLimitExceeded = errors.New("loan amount exceeds borrower credit limit")
+
+// StatusAmount is the number and total amount of a borrower's loans in a status
+type StatusAmount struct {
+	Status Status  `json:"status"`
+	Count  int64   `json:"count"`
+	Amount float64 `json:"amount"`
+}
+
+// BorrowerExposure summarizes how much a borrower already owes
+type BorrowerExposure struct {
+	BorrowerID  string         `json:"borrower_id"`
+	CreditLimit *float64       `json:"credit_limit"`
+	Outstanding float64        `json:"outstanding"`
+	Available   *float64       `json:"available"` // Remaining credit, nil when the borrower has no limit
+	ByStatus    []StatusAmount `json:"by_status"`
+}
+
+// IsTerminal reports whether a loan in this status can no longer change
+func (s Status) IsTerminal() bool {
+	switch s {
+	case StatusDisbursed, StatusRejected, StatusCancelled, StatusExpired:
+		return true
+	}
+	return false
+}
+
+// NewBorrowerExposure computes the exposure from the per-status totals of the borrower's loans.
+// Only loans in a non-terminal status count as outstanding: repayments are not tracked, so a
+// disbursed loan releases the credit it used.
+func NewBorrowerExposure(b *borrower.Borrower, byStatus []StatusAmount) *BorrowerExposure {
+	exposure := &BorrowerExposure{
+		BorrowerID:  b.ID,
+		CreditLimit: b.CreditLimit,
+		ByStatus:    byStatus,
+	}
+
+	for _, summary := range byStatus {
+		if !summary.Status.IsTerminal() {
+			exposure.Outstanding += summary.Amount
+		}
+	}
+
+	if b.CreditLimit != nil {
+		available := *b.CreditLimit - exposure.Outstanding
+		exposure.Available = &available
+	}
+
+	return exposure
+}
+
+// CheckCreditLimit returns ErrCreditLimitExceeded when adding amount to the outstanding loans
+// would go over the borrower's credit limit
+func (e *BorrowerExposure) CheckCreditLimit(amount float64) error {
+	if e.CreditLimit == nil {
+		return nil
+	}
+
+	if e.Outstanding+amount > *e.CreditLimit {
+		return ErrCreditLimitExceeded
+	}
+
+	return nil
+}
+
+func (s *LoanService) GetBorrowerExposure(ctx context.Context, borrowerID string) (*BorrowerExposure, error) {
+	b, err := s.borrowerRepository.Get(ctx, borrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	byStatus, err := s.repository.SumByStatus(ctx, borrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBorrowerExposure(b, byStatus), nil
+}
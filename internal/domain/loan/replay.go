@@ -0,0 +1,81 @@
+package loan
+
+import (
+	"context"
+	"fmt"
+)
+
+// Projection stores the current state of loans, such as the loans table
+type Projection interface {
+	ReplaceProjection(ctx context.Context, loan *Loan) error
+}
+
+// Replayer rebuilds projections from the complete event streams, ignoring snapshots,
+// to audit or correct the stored state of loans
+type Replayer struct {
+	store      EventStore
+	projection Projection
+}
+
+func NewReplayer(store EventStore, projection Projection) *Replayer {
+	return &Replayer{
+		store:      store,
+		projection: projection,
+	}
+}
+
+// Replay rebuilds the loan from all its events and writes it to the projection,
+// unless dryRun is set. It returns the rebuilt loan.
+func (r *Replayer) Replay(ctx context.Context, loanID string, dryRun bool) (*Loan, error) {
+	events, err := r.store.Load(ctx, loanID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// A loan created before the event store has no creation event, its earlier state is only kept by its snapshots
+	var snapshot *Snapshot
+	if len(events) > 0 && events[0].Type != EventTypeLoanCreated {
+		snapshot, err = r.store.LatestSnapshot(ctx, loanID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	l, _, err := RebuildLoan(snapshot, events)
+	if err != nil {
+		return nil, err
+	}
+	if l == nil {
+		return nil, fmt.Errorf("loan %s has no events", loanID)
+	}
+
+	if dryRun {
+		return l, nil
+	}
+
+	if err := r.projection.ReplaceProjection(ctx, l); err != nil {
+		return nil, fmt.Errorf("error writing loan %s: %w", loanID, err)
+	}
+
+	return l, nil
+}
+
+// ReplayAll replays every loan with stored events, calling onReplayed after each of them
+func (r *Replayer) ReplayAll(ctx context.Context, dryRun bool, onReplayed func(*Loan)) (int, error) {
+	loanIDs, err := r.store.StreamIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, loanID := range loanIDs {
+		l, err := r.Replay(ctx, loanID, dryRun)
+		if err != nil {
+			return i, err
+		}
+		if onReplayed != nil {
+			onReplayed(l)
+		}
+	}
+
+	return len(loanIDs), nil
+}
@@ -2,8 +2,13 @@ package loan
 
 import (
 	"context"
+	"errors"
+	"time"
 )
 
+// ErrLoanNotFound is returned when no loan has the requested ID
+var ErrLoanNotFound = errors.New("loan not found")
+
 // Repository defines the data access interface for loans
 type Repository interface {
 	Get(ctx context.Context, id string) (*Loan, error)
@@ -13,14 +18,33 @@ type Repository interface {
 	List(ctx context.Context, filter LoanFilter) ([]*Loan, error)
 	// Delete(ctx context.Context, id string) error
 	Count(ctx context.Context, filter LoanFilter) (int64, error)
+	// SumByStatus returns the number and total amount of a borrower's loans grouped by status,
+	// or of all the loans when borrowerID is empty
+	SumByStatus(ctx context.Context, borrowerID string) ([]StatusAmount, error)
+	ListTransitions(ctx context.Context, filter TransitionFilter) ([]StatusTransition, error)
+	CountTransitions(ctx context.Context, filter TransitionFilter) (int64, error)
+}
+
+// Transactor runs fn in one transaction, joined by the repositories it calls with ctx, so that the
+// changes made by the state machine callbacks are kept or rolled back together
+type Transactor interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
+// LoanFilter selects loans. List returns a page of them, newest first, only when Page or PageSize
+// is set, and every matching loan otherwise.
 type LoanFilter struct {
-	Status    *Status
-	MinAmount *float64
-	MaxAmount *float64
-	Page      int
-	PageSize  int
+	BorrowerID *string
+	Status     *Status
+	MinAmount  *float64
+	MaxAmount  *float64
+	Page       int
+	PageSize   int
+}
+
+// Paginated reports whether a page of loans was requested
+func (f *LoanFilter) Paginated() bool {
+	return f.Page > 0 || f.PageSize > 0
 }
 
 func (f *LoanFilter) WithDefaults() *LoanFilter {
@@ -32,3 +56,23 @@ func (f *LoanFilter) WithDefaults() *LoanFilter {
 	}
 	return f
 }
+
+type TransitionFilter struct {
+	LoanID      *string
+	To          *Status
+	PerformedBy *string
+	Since       *time.Time
+	Until       *time.Time
+	Page        int
+	PageSize    int
+}
+
+func (f *TransitionFilter) WithDefaults() *TransitionFilter {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	if f.PageSize <= 0 {
+		f.PageSize = 10
+	}
+	return f
+}
@@ -5,9 +5,12 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
 	borrower "github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/document"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
 )
 
 // Service provides loan business operations
@@ -23,30 +26,82 @@ type LoanService struct {
 	borrowerRepository borrower.Repository
 	employeeRepository employee.Repository
 	documentRepository document.Repository
+	productRepository  product.Repository
 	validator          DefaultStatusValidator
+	auditRepository    audit.Repository
+	transactor         Transactor
 	callbackRegistrar  CallbackRegistrar
 }
 
-func NewLoanService(r Repository, b borrower.Repository, d document.Repository, e employee.Repository, c CallbackRegistrar) *LoanService {
+func NewLoanService(r Repository, b borrower.Repository, d document.Repository, e employee.Repository, p product.Repository, a audit.Repository, t Transactor, c CallbackRegistrar) *LoanService {
 	return &LoanService{
 		repository:         r,
 		borrowerRepository: b,
 		documentRepository: d,
 		employeeRepository: e,
+		productRepository:  p,
+		auditRepository:    a,
+		transactor:         t,
 		validator:          *NewDefaultStatusValidator(),
 		callbackRegistrar:  c,
 	}
 }
 
-func (s *LoanService) CreateLoan(ctx context.Context, borrowerID string, amount float64, rate float64, roi float64) (*Loan, error) {
+// CreateLoan proposes a loan from a product at the annual rate and ROI, repaid with terms. The amount,
+// rates and terms must be offered by the product, the unset terms taking the product's or their default.
+func (s *LoanService) CreateLoan(ctx context.Context, borrowerID string, productID string, amount float64, rate float64, roi float64, terms interest.Terms) (*Loan, error) {
 	id := uuid.New().String()
 
+	loan, err := s.createLoan(ctx, id, borrowerID, productID, amount, rate, roi, terms)
+	if err != nil {
+		s.recordAudit(ctx, id, audit.ActionCreate, borrowerID, "", "", err)
+		return nil, err
+	}
+
+	s.recordAudit(ctx, id, audit.ActionCreate, borrowerID, "", loan.Status, nil)
+	return loan, nil
+}
+
+func (s *LoanService) createLoan(ctx context.Context, id string, borrowerID string, productID string, amount float64, rate float64, roi float64, terms interest.Terms) (*Loan, error) {
+	p, err := s.productRepository.Get(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	terms, err = p.LoanTerms(amount, rate, roi, terms)
+	if err != nil {
+		return nil, err
+	}
+	if err := terms.Validate(); err != nil {
+		return nil, err
+	}
+
 	// validate borrower ID
-	if _, err := s.borrowerRepository.Get(ctx, borrowerID); err != nil {
+	b, err := s.borrowerRepository.Get(ctx, borrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the new loan must fit in the borrower's remaining credit
+	byStatus, err := s.repository.SumByStatus(ctx, borrowerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := NewBorrowerExposure(b, byStatus).CheckCreditLimit(amount); err != nil {
 		return nil, err
 	}
 
-	loan := NewLoan(id, borrowerID, amount, rate, roi)
+	loan := NewLoan(id, borrowerID, amount, rate, roi, terms)
+	loan.ProductID = &p.ID
+	loan.RecordEvent(EventTypeLoanCreated, LoanCreatedPayload{
+		BorrowerID:     borrowerID,
+		ProductID:      p.ID,
+		Amount:         amount,
+		Rate:           rate,
+		ROI:            roi,
+		TenorMonths:    terms.TenorMonths,
+		InterestMethod: terms.Method,
+		DayCount:       terms.DayCount,
+	})
 
 	if err := s.repository.Create(ctx, loan); err != nil {
 		return nil, err
@@ -59,6 +114,7 @@ func (s *LoanService) GetByID(ctx context.Context, id string) (*Loan, error) {
 	return s.repository.Get(ctx, id)
 }
 
+// ListLoans lists a page of loans, newest first, the first 10 unless another page is requested
 func (s *LoanService) ListLoans(ctx context.Context, filter LoanFilter) (*domain.PaginatedResponse, error) {
 	filter.WithDefaults()
 	loans, err := s.repository.List(ctx, filter)
@@ -89,6 +145,38 @@ func (s *LoanService) ListLoans(ctx context.Context, filter LoanFilter) (*domain
 	}, nil
 }
 
+// ListTransitions lists the status transitions of all loans, newest first,
+// e.g. the loans approved by an employee over a period
+func (s *LoanService) ListTransitions(ctx context.Context, filter TransitionFilter) (*domain.PaginatedResponse, error) {
+	filter.WithDefaults()
+	transitions, err := s.repository.ListTransitions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the total count
+	totalItems, err := s.repository.CountTransitions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate total pages
+	totalPages := 0
+	if filter.PageSize > 0 {
+		totalPages = int((totalItems + int64(filter.PageSize) - 1) / int64(filter.PageSize))
+	}
+
+	return &domain.PaginatedResponse{
+		Data: transitions,
+		Pagination: domain.PaginationInfo{
+			CurrentPage: filter.Page,
+			PageSize:    filter.PageSize,
+			TotalItems:  totalItems,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
+
 func (s *LoanService) Save(ctx context.Context, loan *Loan) error {
 	return s.repository.Save(ctx, loan)
 }
@@ -6,14 +6,25 @@ import (
 
 	"github.com/looplab/fsm"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/api/dto/response"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/requestid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
 )
 
+var tracer = otel.Tracer("github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan")
+
 const (
 	EventApprove  = "approve"
 	EventInvest   = "invest"
 	EventDisburse = "disburse"
 	EventReject   = "reject"
+	EventCancel   = "cancel"
+	EventExpire   = "expire"
 )
 
 type CallbackRegistrar interface {
@@ -26,6 +37,8 @@ func IsValidStatus(status string) bool {
 		string(EventDisburse),
 		string(EventInvest),
 		string(EventReject),
+		string(EventCancel),
+		string(EventExpire),
 	}
 
 	for _, s := range validStatuses {
@@ -45,22 +58,120 @@ func (s *LoanService) createFSM(loan *Loan) *fsm.FSM {
 			{Name: EventApprove, Src: []string{string(StatusProposed)}, Dst: string(StatusApproved)},
 			{Name: EventInvest, Src: []string{string(StatusApproved)}, Dst: string(StatusInvested)},
 			{Name: EventDisburse, Src: []string{string(StatusInvested)}, Dst: string(StatusDisbursed)},
-			{Name: EventReject, Src: []string{string(StatusProposed)}},
+			{Name: EventReject, Src: []string{string(StatusProposed)}, Dst: string(StatusRejected)},
+			{Name: EventCancel, Src: []string{string(StatusApproved), string(StatusInvested)}, Dst: string(StatusCancelled)},
+			{Name: EventExpire, Src: []string{string(StatusApproved)}, Dst: string(StatusExpired)},
 		},
 		s.callbackRegistrar.GetCallbacks(),
 	)
 }
 
-func (s *LoanService) ApproveLoan(loan *Loan, approvedBy string, fileName string) error {
+// TransitionError is returned when the event is not allowed in the current status of the loan
+type TransitionError struct {
+	Event string
+}
+
+func (e *TransitionError) Error() string {
+	return "cannot " + e.Event + " loan in current state"
+}
+
+// RefusalError is how the state machine callbacks refuse a transition for a business reason,
+// e.g. a missing field or an unknown employee. Other errors cancelling a transition are failures
+// to read or store the data.
+type RefusalError struct {
+	Reason string
+}
+
+func (e *RefusalError) Error() string {
+	return e.Reason
+}
+
+// Refuse returns the error refusing a transition for reason
+func Refuse(reason string) error {
+	return &RefusalError{Reason: reason}
+}
+
+// fireEvent runs the event on the loan state machine and records the attempt in the audit log
+// and the logs, whether the transition happened or was refused by a callback. The callbacks
+// get ctx, within the span of the event, and their changes are rolled back when one of them
+// refuses the transition.
+func (s *LoanService) fireEvent(ctx context.Context, loan *Loan, event string, actor string, args ...interface{}) error {
+	ctx, span := tracer.Start(ctx, "loan."+event)
+	defer span.End()
+
+	from := loan.Status
 	loanFSM := s.createFSM(loan)
-	err := loanFSM.Event(context.Background(), EventApprove, loan, approvedBy, fileName)
+
+	err := s.transactor.Transaction(ctx, func(ctx context.Context) error {
+		return loanFSM.Event(ctx, event, append([]interface{}{loan}, args...)...)
+	})
+	if err != nil && errors.Is(err, fsm.NoTransitionError{}) {
+		err = &TransitionError{Event: event}
+	}
+
+	to := Status(loanFSM.Current())
 	if err != nil {
-		if errors.Is(err, fsm.NoTransitionError{}) {
-			return errors.New("cannot approve loan in current state")
-		}
-		return err
+		to = from
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.SetAttributes(
+		attribute.String("loan.id", loan.ID),
+		attribute.String("loan.actor", actor),
+		attribute.String("loan.status.from", string(from)),
+		attribute.String("loan.status.to", string(to)),
+	)
+	s.recordAudit(ctx, loan.ID, event, actor, from, to, err)
+	logTransition(ctx, loan.ID, event, actor, from, to, err)
+
+	return err
+}
+
+// logTransition logs the outcome of an event, refused when it is not allowed in the status of
+// the loan or cancelled by a callback
+func logTransition(ctx context.Context, loanID string, event string, actor string, from Status, to Status, err error) {
+	fields := []zap.Field{
+		zap.String("loan_id", loanID),
+		zap.String("event", event),
+		zap.String("actor", actor),
+		zap.String("from", string(from)),
+		zap.String("to", string(to)),
+	}
+
+	var transitionErr *TransitionError
+	var invalidEventErr fsm.InvalidEventError
+	switch {
+	case err == nil:
+		logging.FromContext(ctx).Info("Loan transition succeeded", append(fields, zap.String("outcome", "succeeded"))...)
+	case errors.As(err, &transitionErr) || errors.As(err, &invalidEventErr):
+		logging.FromContext(ctx).Warn("Loan transition not allowed", append(fields, zap.String("outcome", "not_allowed"))...)
+	default:
+		logging.FromContext(ctx).Warn("Loan transition cancelled", append(fields, zap.String("outcome", "cancelled"), zap.Error(err))...)
 	}
-	return nil
+}
+
+// recordAudit appends an entry to the audit log. A failure to write it is logged
+// and does not change the outcome of the action.
+func (s *LoanService) recordAudit(ctx context.Context, loanID string, action string, actor string, from Status, to Status, actionErr error) {
+	entry := audit.NewEntry(loanID, action, actor, string(from), string(to), requestid.FromContext(ctx), actionErr)
+	if err := s.auditRepository.Append(ctx, entry); err != nil {
+		logging.FromContext(ctx).Error("Failed to record audit entry",
+			zap.String("loan_id", loanID), zap.String("action", action), zap.Error(err))
+	}
+}
+
+// RecordRefusedAction records in the audit log an action refused before reaching the state
+// machine, such as an unknown event, an invalid request or a missing loan or lender. An action
+// that is not a state machine event is recorded as unknown. status is the current status of the
+// loan, empty when it was not found.
+func (s *LoanService) RecordRefusedAction(ctx context.Context, loanID string, action string, actor string, status Status, err error) {
+	if !IsValidStatus(action) {
+		action = audit.ActionUnknown
+	}
+	s.recordAudit(ctx, loanID, action, actor, status, status, err)
+}
+
+func (s *LoanService) ApproveLoan(ctx context.Context, loan *Loan, approvedBy string, fileName string) error {
+	return s.fireEvent(ctx, loan, EventApprove, approvedBy, approvedBy, fileName)
 }
 
 // Constants for context keys
@@ -68,34 +179,36 @@ type contextKey string
 
 const InvestResultKey contextKey = "investResult"
 
-func (s *LoanService) InvestLoan(loan *Loan, lender *lender.Lender, amount float64) (*response.LoanLenderResponse, error) {
-	loanFSM := s.createFSM(loan)
-
+func (s *LoanService) InvestLoan(ctx context.Context, loan *Loan, lender *lender.Lender, amount float64) (*response.LoanLenderResponse, error) {
 	result := &response.LoanLenderResponse{}
-	ctx := context.WithValue(context.Background(), InvestResultKey, result)
+	ctx = context.WithValue(ctx, InvestResultKey, result)
 
-	err := loanFSM.Event(ctx, EventInvest, loan, lender, amount)
-	if err != nil {
-		if errors.Is(err, fsm.NoTransitionError{}) {
-			return nil, errors.New("cannot invest loan in current state")
-		}
+	if err := s.fireEvent(ctx, loan, EventInvest, lender.ID, lender, amount); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-func (s *LoanService) DisburseLoan(loan *Loan, fieldOfficeID string, agreementFileName string) (*response.DisbursementResponse, error) {
-	loanFSM := s.createFSM(loan)
-
+func (s *LoanService) DisburseLoan(ctx context.Context, loan *Loan, fieldOfficeID string, agreementFileName string) (*response.DisbursementResponse, error) {
 	result := &response.DisbursementResponse{}
-	ctx := context.WithValue(context.Background(), InvestResultKey, result)
+	ctx = context.WithValue(ctx, InvestResultKey, result)
 
-	err := loanFSM.Event(ctx, EventDisburse, loan, fieldOfficeID, agreementFileName)
-	if err != nil {
-		if errors.Is(err, fsm.NoTransitionError{}) {
-			return nil, errors.New("cannot disburse loan in current state")
-		}
+	if err := s.fireEvent(ctx, loan, EventDisburse, fieldOfficeID, fieldOfficeID, agreementFileName); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
+
+func (s *LoanService) CancelLoan(ctx context.Context, loan *Loan, cancelledBy string, reason string) error {
+	return s.fireEvent(ctx, loan, EventCancel, cancelledBy, cancelledBy, reason)
+}
+
+// RejectLoan declines a proposed loan
+func (s *LoanService) RejectLoan(ctx context.Context, loan *Loan, rejectedBy string, reason string) error {
+	return s.fireEvent(ctx, loan, EventReject, rejectedBy, rejectedBy, reason)
+}
+
+// ExpireLoan closes an approved loan whose funding window has lapsed
+func (s *LoanService) ExpireLoan(ctx context.Context, loan *Loan) error {
+	return s.fireEvent(ctx, loan, EventExpire, audit.ActorSystem)
+}
@@ -0,0 +1,87 @@
+package loan
+
+import (
+	"context"
+	"time"
+)
+
+// StatementLine describes a single loan on a borrower statement
+type StatementLine struct {
+	LoanID           string     `json:"loan_id"`
+	Status           Status     `json:"status"`
+	Amount           float64    `json:"amount"`
+	Rate             float64    `json:"rate"`
+	TotalRepayment   float64    `json:"total_repayment"` // Principal plus interest the borrower repays once disbursed
+	ApprovalDate     *time.Time `json:"approval_date"`
+	DisbursementDate *time.Time `json:"disbursement_date"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// Statement lists every loan of a borrower together with the amounts owed
+type Statement struct {
+	BorrowerID        string          `json:"borrower_id"`
+	TotalLoans        int             `json:"total_loans"`
+	TotalDisbursed    float64         `json:"total_disbursed"`
+	TotalRepaymentDue float64         `json:"total_repayment_due"` // Repayment of the disbursed loans
+	Loans             []StatementLine `json:"loans"`
+}
+
+// CalculateBorrowerRepayment returns the principal plus the interest at the loan rate over its schedule
+func CalculateBorrowerRepayment(loan *Loan) float64 {
+	return loan.Schedule().TotalRepayment
+}
+
+// NewStatement builds the statement of a borrower from their loans
+func NewStatement(borrowerID string, loans []*Loan) *Statement {
+	statement := &Statement{
+		BorrowerID: borrowerID,
+		TotalLoans: len(loans),
+		Loans:      make([]StatementLine, 0, len(loans)),
+	}
+
+	for _, l := range loans {
+		line := StatementLine{
+			LoanID:           l.ID,
+			Status:           l.Status,
+			Amount:           l.Amount,
+			Rate:             l.Rate,
+			TotalRepayment:   CalculateBorrowerRepayment(l),
+			ApprovalDate:     l.ApprovalDate,
+			DisbursementDate: l.DisbursementDate,
+			CreatedAt:        l.CreatedAt,
+		}
+		statement.Loans = append(statement.Loans, line)
+
+		if l.Status == StatusDisbursed {
+			statement.TotalDisbursed += line.Amount
+			statement.TotalRepaymentDue += line.TotalRepayment
+		}
+	}
+
+	return statement
+}
+
+// GetBorrowerStatement returns the statement of all loans of a borrower, newest first
+func (s *LoanService) GetBorrowerStatement(ctx context.Context, borrowerID string) (*Statement, error) {
+	if _, err := s.borrowerRepository.Get(ctx, borrowerID); err != nil {
+		return nil, err
+	}
+
+	filter := LoanFilter{BorrowerID: &borrowerID}
+
+	count, err := s.repository.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var loans []*Loan
+	if count > 0 {
+		filter.PageSize = int(count)
+		loans, err = s.repository.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewStatement(borrowerID, loans), nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/looplab/fsm"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan/callbacks"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/test/mocks"
@@ -34,17 +35,23 @@ func TestBeforeApprove(t *testing.T) {
 	t.Run("should pass when all validations succeed", func(t *testing.T) {
 		// Setup
 		mockEmployeeRepo := mocks.NewMockEmployeeRepository()
+		mockBorrowerRepo := mocks.NewMockBorrowerRepository()
+		mockLoanRepo := mocks.NewMockLoanRepository()
 
 		provider := &callbacks.CallbackProvider{
 			Validator:          loan.DefaultStatusValidator{},
 			EmployeeRepository: mockEmployeeRepo,
+			BorrowerRepository: mockBorrowerRepo,
+			LoanRepository:     mockLoanRepo,
 		}
 
-		loanObj := &loan.Loan{ID: "loan-123"}
+		loanObj := &loan.Loan{ID: "loan-123", BorrowerID: "borrower-123"}
 		approvedBy := "employee-123"
 		fileName := "document.pdf"
 
 		mockEmployeeRepo.On("Get", mock.Anything, approvedBy).Return(struct{}{}, nil)
+		mockBorrowerRepo.On("Get", mock.Anything, "borrower-123").Return(&borrower.Borrower{ID: "borrower-123"}, nil)
+		mockLoanRepo.On("SumByStatus", mock.Anything, "borrower-123").Return([]loan.StatusAmount{}, nil)
 
 		// Create mock event
 		mockEvent := &fsm.Event{
@@ -59,6 +66,8 @@ func TestBeforeApprove(t *testing.T) {
 
 		// Assert
 		mockEmployeeRepo.AssertExpectations(t)
+		mockBorrowerRepo.AssertExpectations(t)
+		mockLoanRepo.AssertExpectations(t)
 	})
 
 	t.Run("should cancel when document is missing", func(t *testing.T) {
@@ -179,4 +188,49 @@ func TestBeforeApprove(t *testing.T) {
 		assert.Equal(t, "employee not found", mockEvent.Err.Error())
 		mockEmployeeRepo.AssertExpectations(t)
 	})
+	t.Run("should cancel when borrower credit limit is exceeded", func(t *testing.T) {
+		// Setup
+		mockEmployeeRepo := mocks.NewMockEmployeeRepository()
+		mockBorrowerRepo := mocks.NewMockBorrowerRepository()
+		mockLoanRepo := mocks.NewMockLoanRepository()
+
+		provider := &callbacks.CallbackProvider{
+			Validator:          *loan.NewDefaultStatusValidator(),
+			EmployeeRepository: mockEmployeeRepo,
+			BorrowerRepository: mockBorrowerRepo,
+			LoanRepository:     mockLoanRepo,
+		}
+
+		loanObj := &loan.Loan{ID: "loan-123", BorrowerID: "borrower-123", Amount: 6000}
+		approvedBy := "employee-123"
+		fileName := "document.pdf"
+		creditLimit := 10000.0
+
+		// Configure mocks: the loan being approved (6000) plus another approved loan (5000) exceeds the limit,
+		// disbursed, rejected and cancelled loans are not outstanding anymore
+		mockEmployeeRepo.On("Get", mock.Anything, approvedBy).Return(struct{}{}, nil)
+		mockBorrowerRepo.On("Get", mock.Anything, "borrower-123").Return(&borrower.Borrower{ID: "borrower-123", CreditLimit: &creditLimit}, nil)
+		mockLoanRepo.On("SumByStatus", mock.Anything, "borrower-123").Return([]loan.StatusAmount{
+			{Status: loan.StatusProposed, Count: 1, Amount: 6000},
+			{Status: loan.StatusApproved, Count: 1, Amount: 5000},
+			{Status: loan.StatusDisbursed, Count: 2, Amount: 20000},
+			{Status: loan.StatusRejected, Count: 1, Amount: 8000},
+			{Status: loan.StatusCancelled, Count: 1, Amount: 3000},
+		}, nil)
+
+		// Create event
+		mockEvent := &fsm.Event{
+			Src:  "proposed",
+			Dst:  "approved",
+			Args: []interface{}{loanObj, approvedBy, fileName},
+		}
+
+		setCancelFunc(mockEvent, func() {})
+
+		// Execute
+		provider.BeforeApproval(context.Background(), mockEvent)
+
+		// Assert
+		assert.ErrorIs(t, mockEvent.Err, loan.ErrCreditLimitExceeded)
+	})
 }
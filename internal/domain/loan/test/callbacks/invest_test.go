@@ -0,0 +1,198 @@
+package callbacks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/looplab/fsm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan/callbacks"
+	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/test/mocks"
+)
+
+func TestBeforeInvest(t *testing.T) {
+	setup := func(balance, reserved float64) (*callbacks.CallbackProvider, *mocks.MockWalletRepository) {
+		mockLenderRepo := mocks.NewMockLenderRepository()
+		mockLoanLenderRepo := mocks.NewMockLoanLenderRepository()
+		mockWalletRepo := mocks.NewMockWalletRepository()
+
+		lenderWallet := wallet.NewWallet("lender-123")
+		lenderWallet.Balance = balance
+		lenderWallet.Reserved = reserved
+
+		mockLenderRepo.On("Get", mock.Anything, "lender-123").Return(&lender.Lender{ID: "lender-123"}, nil)
+		mockLoanLenderRepo.On("GetByLoanID", mock.Anything, "loan-123").Return([]*loanlender.LoanLender{
+			{LoanID: "loan-123", LenderID: "lender-456", Amount: 4000},
+		}, nil)
+		mockWalletRepo.On("GetByLenderID", mock.Anything, "lender-123").Return(lenderWallet, nil)
+
+		provider := &callbacks.CallbackProvider{
+			Validator:            *loan.NewDefaultStatusValidator(),
+			LenderRepository:     mockLenderRepo,
+			LoanLenderRepository: mockLoanLenderRepo,
+			WalletRepository:     mockWalletRepo,
+		}
+
+		return provider, mockWalletRepo
+	}
+
+	t.Run("should pass when wallet has enough available funds", func(t *testing.T) {
+		provider, mockWalletRepo := setup(5000, 1000)
+
+		loanObj := &loan.Loan{ID: "loan-123", Amount: 10000}
+
+		mockEvent := &fsm.Event{
+			Src:  "approved",
+			Dst:  "invested",
+			Args: []interface{}{loanObj, &lender.Lender{ID: "lender-123"}, 4000.0},
+		}
+
+		setCancelFunc(mockEvent, func() {})
+
+		// Execute
+		provider.BeforeInvest(context.Background(), mockEvent)
+
+		// Assert
+		assert.NoError(t, mockEvent.Err)
+		mockWalletRepo.AssertExpectations(t)
+	})
+
+	t.Run("should cancel when investment exceeds available balance", func(t *testing.T) {
+		// 5000 balance with 2000 already reserved leaves 3000 available
+		provider, _ := setup(5000, 2000)
+
+		loanObj := &loan.Loan{ID: "loan-123", Amount: 10000}
+
+		mockEvent := &fsm.Event{
+			Src:  "approved",
+			Dst:  "invested",
+			Args: []interface{}{loanObj, &lender.Lender{ID: "lender-123"}, 4000.0},
+		}
+
+		setCancelFunc(mockEvent, func() {})
+
+		// Execute
+		provider.BeforeInvest(context.Background(), mockEvent)
+
+		// Assert
+		assert.ErrorIs(t, mockEvent.Err, wallet.ErrInsufficientBalance)
+	})
+
+	t.Run("should cancel when investment exceeds remaining principal", func(t *testing.T) {
+		provider, mockWalletRepo := setup(50000, 0)
+
+		loanObj := &loan.Loan{ID: "loan-123", Amount: 10000}
+
+		mockEvent := &fsm.Event{
+			Src:  "approved",
+			Dst:  "invested",
+			Args: []interface{}{loanObj, &lender.Lender{ID: "lender-123"}, 7000.0},
+		}
+
+		setCancelFunc(mockEvent, func() {})
+
+		// Execute
+		provider.BeforeInvest(context.Background(), mockEvent)
+
+		// Assert
+		assert.Equal(t, "investment exceeds remaining principal amount", mockEvent.Err.Error())
+		mockWalletRepo.AssertNotCalled(t, "GetByLenderID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should cancel when the lender has never deposited funds", func(t *testing.T) {
+		mockLenderRepo := mocks.NewMockLenderRepository()
+		mockLoanLenderRepo := mocks.NewMockLoanLenderRepository()
+		mockWalletRepo := mocks.NewMockWalletRepository()
+		mockLenderRepo.On("Get", mock.Anything, "lender-789").Return(&lender.Lender{ID: "lender-789"}, nil)
+		mockLoanLenderRepo.On("GetByLoanID", mock.Anything, "loan-123").Return([]*loanlender.LoanLender{}, nil)
+		mockWalletRepo.On("GetByLenderID", mock.Anything, "lender-789").Return(nil, wallet.ErrWalletNotFound)
+
+		provider := &callbacks.CallbackProvider{
+			Validator:            *loan.NewDefaultStatusValidator(),
+			LenderRepository:     mockLenderRepo,
+			LoanLenderRepository: mockLoanLenderRepo,
+			WalletRepository:     mockWalletRepo,
+		}
+
+		loanObj := &loan.Loan{ID: "loan-123", Amount: 10000}
+
+		mockEvent := &fsm.Event{
+			Src:  "approved",
+			Dst:  "invested",
+			Args: []interface{}{loanObj, &lender.Lender{ID: "lender-789"}, 1000.0},
+		}
+
+		setCancelFunc(mockEvent, func() {})
+
+		// Execute
+		provider.BeforeInvest(context.Background(), mockEvent)
+
+		// Assert
+		assert.ErrorIs(t, mockEvent.Err, wallet.ErrInsufficientBalance)
+		mockWalletRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAfterInvest(t *testing.T) {
+	setup := func() (*callbacks.CallbackProvider, *mocks.MockLoanRepository) {
+		mockLoanRepo := mocks.NewMockLoanRepository()
+		mockLoanLenderRepo := mocks.NewMockLoanLenderRepository()
+		mockWalletRepo := mocks.NewMockWalletRepository()
+
+		lenderWallet := wallet.NewWallet("lender-123")
+		lenderWallet.Balance = 10000
+
+		mockLoanLenderRepo.On("GetByLoanID", mock.Anything, "loan-123").Return([]*loanlender.LoanLender{
+			{LoanID: "loan-123", LenderID: "lender-456", Amount: 4000},
+		}, nil)
+		mockLoanLenderRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+		mockWalletRepo.On("GetByLenderID", mock.Anything, "lender-123").Return(lenderWallet, nil)
+		mockWalletRepo.On("Apply", mock.Anything, lenderWallet, mock.Anything).Return(nil)
+		mockLoanRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+		provider := &callbacks.CallbackProvider{
+			Validator:            *loan.NewDefaultStatusValidator(),
+			LoanRepository:       mockLoanRepo,
+			LoanLenderRepository: mockLoanLenderRepo,
+			WalletRepository:     mockWalletRepo,
+		}
+
+		return provider, mockLoanRepo
+	}
+
+	t.Run("should save partial investment with an investment received event", func(t *testing.T) {
+		provider, mockLoanRepo := setup()
+
+		loanObj := &loan.Loan{ID: "loan-123", Amount: 10000, Status: loan.StatusApproved}
+
+		mockEvent := &fsm.Event{
+			Src:  "approved",
+			Dst:  "invested",
+			Args: []interface{}{loanObj, &lender.Lender{ID: "lender-123"}, 3000.0},
+		}
+
+		setCancelFunc(mockEvent, func() {})
+
+		// Execute
+		provider.AfterInvest(context.Background(), mockEvent)
+
+		// Assert
+		assert.NoError(t, mockEvent.Err)
+		assert.Equal(t, loan.StatusApproved, loanObj.Status)
+		mockLoanRepo.AssertCalled(t, "Save", mock.Anything, loanObj)
+
+		events := loanObj.PendingEvents()
+		if assert.Len(t, events, 1) {
+			assert.Equal(t, loan.EventTypeLoanInvestmentReceived, events[0].Type)
+			payload := events[0].Payload.(loan.LoanInvestmentReceivedPayload)
+			assert.Equal(t, "lender-123", payload.LenderID)
+			assert.Equal(t, 7000.0, payload.TotalInvested)
+			assert.Equal(t, 3000.0, payload.RemainingAmount)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package callbacks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/looplab/fsm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan/callbacks"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/test/mocks"
+)
+
+func TestReject(t *testing.T) {
+	t.Run("should cancel when reason is missing", func(t *testing.T) {
+		provider := &callbacks.CallbackProvider{}
+
+		loanObj := &loan.Loan{ID: "loan-123", Status: loan.StatusProposed}
+
+		mockEvent := &fsm.Event{
+			Src:  "proposed",
+			Dst:  "rejected",
+			Args: []interface{}{loanObj, "employee-123", ""},
+		}
+
+		setCancelFunc(mockEvent, func() {})
+
+		provider.BeforeReject(context.Background(), mockEvent)
+
+		assert.Equal(t, "rejection reason is required", mockEvent.Err.Error())
+	})
+
+	t.Run("should record the rejection", func(t *testing.T) {
+		mockLoanRepo := mocks.NewMockLoanRepository()
+
+		provider := &callbacks.CallbackProvider{
+			LoanRepository: mockLoanRepo,
+		}
+
+		loanObj := &loan.Loan{ID: "loan-123", BorrowerID: "borrower-123", Status: loan.StatusProposed}
+
+		mockLoanRepo.On("Save", mock.Anything, loanObj).Return(nil)
+
+		mockEvent := &fsm.Event{
+			Src:  "proposed",
+			Dst:  "rejected",
+			Args: []interface{}{loanObj, "employee-123", "incomplete survey"},
+		}
+
+		provider.AfterReject(context.Background(), mockEvent)
+
+		assert.NoError(t, mockEvent.Err)
+		assert.Equal(t, loan.StatusRejected, loanObj.Status)
+		assert.Len(t, loanObj.StatusTransitions, 1)
+		assert.Equal(t, "employee-123", loanObj.StatusTransitions[0].PerformedBy)
+
+		events := loanObj.PendingEvents()
+		assert.Len(t, events, 1)
+		assert.Equal(t, loan.EventTypeLoanRejected, events[0].Type)
+		assert.Equal(t, "incomplete survey", events[0].Payload.(loan.LoanRejectedPayload).Reason)
+		mockLoanRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,215 @@
+package eventsourcing
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/document"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/test/mocks"
+)
+
+var start = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func event(version int, eventType loan.EventType, payload any) loan.DomainEvent {
+	return loan.DomainEvent{
+		ID:         "event-" + strconv.Itoa(version),
+		Type:       eventType,
+		LoanID:     "loan-123",
+		Version:    version,
+		Payload:    payload,
+		OccurredAt: start.Add(time.Duration(version) * time.Hour),
+	}
+}
+
+func disbursedStream() []loan.DomainEvent {
+	return []loan.DomainEvent{
+		event(1, loan.EventTypeLoanCreated, loan.LoanCreatedPayload{BorrowerID: "borrower-123", Amount: 1000, Rate: 10, ROI: 8}),
+		event(2, loan.EventTypeLoanApproved, loan.LoanApprovedPayload{BorrowerID: "borrower-123", ApprovedBy: "employee-123", SurveyDocumentID: "survey-123", ApprovalDate: start.Add(2 * time.Hour)}),
+		event(3, loan.EventTypeLoanInvestmentReceived, loan.LoanInvestmentReceivedPayload{LenderID: "lender-1", Amount: 400, TotalInvested: 400, RemainingAmount: 600}),
+		event(4, loan.EventTypeLoanInvestmentReceived, loan.LoanInvestmentReceivedPayload{LenderID: "lender-2", Amount: 600, TotalInvested: 1000}),
+		event(5, loan.EventTypeLoanFullyFunded, loan.LoanFullyFundedPayload{BorrowerID: "borrower-123", FundedBy: "lender-2", TotalInvested: 1000, AgreementDocumentID: "agreement-123", InvestmentDate: start.Add(4 * time.Hour)}),
+		event(6, loan.EventTypeLoanDisbursed, loan.LoanDisbursedPayload{BorrowerID: "borrower-123", DisbursedBy: "officer-123", AgreementDocumentID: "signed-123", DisbursementDate: start.Add(6 * time.Hour)}),
+	}
+}
+
+func TestRebuildLoan(t *testing.T) {
+	t.Run("should rebuild a loan from its events", func(t *testing.T) {
+		l, version, err := loan.RebuildLoan(nil, disbursedStream())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 6, version)
+		assert.Equal(t, "loan-123", l.ID)
+		assert.Equal(t, "borrower-123", l.BorrowerID)
+		assert.Equal(t, 1000.0, l.Amount)
+		assert.Equal(t, loan.StatusDisbursed, l.Status)
+		assert.Equal(t, "employee-123", *l.ApprovedBy)
+		assert.Equal(t, "survey-123", *l.SurveyDocumentID)
+		assert.Equal(t, start.Add(4*time.Hour), *l.InvestmentDate)
+		assert.Equal(t, "officer-123", *l.DisbursedBy)
+		assert.Equal(t, "signed-123", *l.AgreementDocumentID)
+		assert.Equal(t, start.Add(time.Hour), l.CreatedAt)
+		assert.Equal(t, start.Add(6*time.Hour), l.UpdatedAt)
+
+		statuses := []loan.Status{}
+		for _, transition := range l.StatusTransitions {
+			statuses = append(statuses, transition.To)
+		}
+		assert.Equal(t, []loan.Status{loan.StatusProposed, loan.StatusApproved, loan.StatusInvested, loan.StatusDisbursed}, statuses)
+		assert.Equal(t, loan.StatusApproved, l.StatusTransitions[2].From)
+		assert.Equal(t, "lender-2", l.StatusTransitions[2].PerformedBy)
+		assert.Equal(t, "event-5", l.StatusTransitions[2].ID)
+	})
+
+	t.Run("should give the same loan from a snapshot", func(t *testing.T) {
+		events := disbursedStream()
+		fromScratch, _, err := loan.RebuildLoan(nil, events)
+		assert.NoError(t, err)
+
+		approved, _, err := loan.RebuildLoan(nil, events[:2])
+		assert.NoError(t, err)
+
+		l, version, err := loan.RebuildLoan(&loan.Snapshot{LoanID: "loan-123", Version: 2, Loan: approved}, events)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 6, version)
+		assert.Equal(t, fromScratch, l)
+		assert.Equal(t, loan.StatusApproved, approved.Status, "the snapshot must not be modified")
+		assert.Len(t, approved.StatusTransitions, 2)
+	})
+
+	t.Run("should give the default interest terms to loans created before they existed", func(t *testing.T) {
+		l, _, err := loan.RebuildLoan(nil, disbursedStream())
+
+		assert.NoError(t, err)
+		assert.Equal(t, interest.Terms{}.WithDefaults(), l.Terms())
+		assert.Equal(t, 100.0, l.TotalInterest)
+		assert.Equal(t, 1100.0, l.TotalRepayment)
+	})
+
+	t.Run("should rebuild the interest terms of a loan", func(t *testing.T) {
+		terms := interest.Terms{TenorMonths: 12, Method: interest.MethodDecliningBalance, DayCount: interest.DayCount30360}
+		created := event(1, loan.EventTypeLoanCreated, loan.LoanCreatedPayload{
+			BorrowerID: "borrower-123", Amount: 12000, Rate: 12, ROI: 8,
+			TenorMonths: terms.TenorMonths, InterestMethod: terms.Method, DayCount: terms.DayCount,
+		})
+
+		l, _, err := loan.RebuildLoan(nil, []loan.DomainEvent{created})
+
+		assert.NoError(t, err)
+		assert.Equal(t, terms, l.Terms())
+		assert.Equal(t, 780.0, l.TotalInterest)
+	})
+
+	t.Run("should rebuild a cancelled loan", func(t *testing.T) {
+		events := append(disbursedStream()[:2], event(3, loan.EventTypeLoanCancelled, loan.LoanCancelledPayload{From: loan.StatusApproved, CancelledBy: "employee-456", Reason: "duplicate"}))
+
+		l, _, err := loan.RebuildLoan(nil, events)
+
+		assert.NoError(t, err)
+		assert.Equal(t, loan.StatusCancelled, l.Status)
+		assert.Equal(t, "Loan cancelled: duplicate", l.StatusTransitions[2].Description)
+		assert.Equal(t, "employee-456", l.StatusTransitions[2].PerformedBy)
+	})
+
+	t.Run("should fail when the stream does not start with the loan creation", func(t *testing.T) {
+		_, _, err := loan.RebuildLoan(nil, disbursedStream()[1:])
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return nil without events", func(t *testing.T) {
+		l, version, err := loan.RebuildLoan(nil, nil)
+
+		assert.NoError(t, err)
+		assert.Nil(t, l)
+		assert.Equal(t, 0, version)
+	})
+}
+
+func TestEventSourcedRepository(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should read loans without events from the projection and seed their snapshot", func(t *testing.T) {
+		mockLoanRepo := mocks.NewMockLoanRepository()
+		mockStore := mocks.NewMockEventStore()
+		projected := &loan.Loan{ID: "legacy-123", Status: loan.StatusApproved}
+
+		mockStore.On("LatestSnapshot", ctx, "legacy-123").Return(nil, nil)
+		mockStore.On("Load", ctx, "legacy-123", 0).Return([]loan.DomainEvent{}, nil)
+		mockStore.On("SaveSnapshot", ctx, &loan.Snapshot{LoanID: "legacy-123", Version: 0, Loan: projected}).Return(nil)
+		mockLoanRepo.On("Get", ctx, "legacy-123").Return(projected, nil)
+
+		l, err := loan.NewEventSourcedRepository(mockLoanRepo, nil, mockStore, 0).Get(ctx, "legacy-123")
+
+		assert.NoError(t, err)
+		assert.Same(t, projected, l)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("should seed the snapshot of a loan transitioned before the event store at its last event", func(t *testing.T) {
+		mockLoanRepo := mocks.NewMockLoanRepository()
+		mockStore := mocks.NewMockEventStore()
+		projected := &loan.Loan{ID: "loan-123", Status: loan.StatusApproved}
+		events := disbursedStream()[1:2]
+
+		mockStore.On("LatestSnapshot", ctx, "loan-123").Return(nil, nil)
+		mockStore.On("Load", ctx, "loan-123", 0).Return(events, nil)
+		mockStore.On("Load", ctx, "loan-123", 2).Return([]loan.DomainEvent{}, nil)
+		mockStore.On("SaveSnapshot", ctx, &loan.Snapshot{LoanID: "loan-123", Version: 2, Loan: projected}).Return(nil)
+		mockLoanRepo.On("Get", ctx, "loan-123").Return(projected, nil)
+
+		l, err := loan.NewEventSourcedRepository(mockLoanRepo, nil, mockStore, 0).Get(ctx, "loan-123")
+
+		assert.NoError(t, err)
+		assert.Same(t, projected, l)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("should take a snapshot once enough events are replayed", func(t *testing.T) {
+		mockLoanRepo := mocks.NewMockLoanRepository()
+		mockDocumentRepo := mocks.NewMockDocumentRepository()
+		mockStore := mocks.NewMockEventStore()
+
+		mockStore.On("LatestSnapshot", ctx, "loan-123").Return(nil, nil)
+		mockStore.On("Load", ctx, "loan-123", 0).Return(disbursedStream(), nil)
+		mockStore.On("SaveSnapshot", ctx, mock.MatchedBy(func(s *loan.Snapshot) bool {
+			return s.LoanID == "loan-123" && s.Version == 6 && s.Loan.Status == loan.StatusDisbursed
+		})).Return(nil)
+		mockDocumentRepo.On("Get", ctx, "survey-123").Return(&document.Document{ID: "survey-123", FileName: "survey.jpg"}, nil)
+		mockDocumentRepo.On("Get", ctx, "signed-123").Return(&document.Document{ID: "signed-123", FileName: "signed.pdf"}, nil)
+
+		l, err := loan.NewEventSourcedRepository(mockLoanRepo, mockDocumentRepo, mockStore, 5).Get(ctx, "loan-123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, loan.StatusDisbursed, l.Status)
+		// The documents are read like the loans table returns them
+		assert.Equal(t, "survey.jpg", l.SurveyDocument.FileName)
+		assert.Equal(t, "signed.pdf", l.AgreementDocument.FileName)
+		mockStore.AssertExpectations(t)
+		mockLoanRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should replay only the events after the latest snapshot", func(t *testing.T) {
+		mockLoanRepo := mocks.NewMockLoanRepository()
+		mockDocumentRepo := mocks.NewMockDocumentRepository()
+		mockStore := mocks.NewMockEventStore()
+		events := disbursedStream()
+		funded, _, _ := loan.RebuildLoan(nil, events[:5])
+
+		mockStore.On("LatestSnapshot", ctx, "loan-123").Return(&loan.Snapshot{LoanID: "loan-123", Version: 5, Loan: funded}, nil)
+		mockStore.On("Load", ctx, "loan-123", 5).Return(events[5:], nil)
+		mockDocumentRepo.On("Get", ctx, mock.Anything).Return(&document.Document{}, nil)
+
+		l, err := loan.NewEventSourcedRepository(mockLoanRepo, mockDocumentRepo, mockStore, 5).Get(ctx, "loan-123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, loan.StatusDisbursed, l.Status)
+		mockStore.AssertNotCalled(t, "SaveSnapshot", mock.Anything, mock.Anything)
+	})
+}
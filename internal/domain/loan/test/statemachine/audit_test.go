@@ -0,0 +1,127 @@
+package statemachine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/looplab/fsm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/memory"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/test/mocks"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/requestid"
+)
+
+// stubRegistrar provides fixed callbacks to the loan state machine
+type stubRegistrar struct {
+	callbacks fsm.Callbacks
+}
+
+func (r stubRegistrar) GetCallbacks() fsm.Callbacks {
+	return r.callbacks
+}
+
+func TestTransitionAudit(t *testing.T) {
+	ctx := requestid.WithContext(context.Background(), "req-123")
+
+	t.Run("should record a refused transition", func(t *testing.T) {
+		mockAuditRepo := mocks.NewMockAuditRepository()
+		registrar := stubRegistrar{callbacks: fsm.Callbacks{
+			"before_" + loan.EventCancel: func(_ context.Context, e *fsm.Event) {
+				e.Cancel(errors.New("employee not found"))
+			},
+		}}
+		service := loan.NewLoanService(nil, nil, nil, nil, nil, mockAuditRepo, memory.NewTransactor(), registrar)
+
+		var entry *audit.Entry
+		mockAuditRepo.On("Append", mock.Anything, mock.AnythingOfType("*audit.Entry")).
+			Run(func(args mock.Arguments) { entry = args.Get(1).(*audit.Entry) }).
+			Return(nil)
+
+		loanObj := &loan.Loan{ID: "loan-123", Status: loan.StatusApproved}
+		err := service.CancelLoan(ctx, loanObj, "employee-123", "duplicate application")
+
+		assert.ErrorContains(t, err, "employee not found")
+		mockAuditRepo.AssertExpectations(t)
+		assert.Equal(t, "loan-123", entry.LoanID)
+		assert.Equal(t, loan.EventCancel, entry.Action)
+		assert.Equal(t, "employee-123", entry.Actor)
+		assert.Equal(t, string(loan.StatusApproved), entry.FromStatus)
+		assert.Equal(t, string(loan.StatusApproved), entry.ToStatus)
+		assert.Equal(t, audit.OutcomeFailure, entry.Outcome)
+		assert.Equal(t, err.Error(), *entry.Error)
+		assert.Equal(t, "req-123", entry.RequestID)
+	})
+
+	t.Run("should record a successful transition", func(t *testing.T) {
+		mockAuditRepo := mocks.NewMockAuditRepository()
+		service := loan.NewLoanService(nil, nil, nil, nil, nil, mockAuditRepo, memory.NewTransactor(), stubRegistrar{callbacks: fsm.Callbacks{}})
+
+		var entry *audit.Entry
+		mockAuditRepo.On("Append", mock.Anything, mock.AnythingOfType("*audit.Entry")).
+			Run(func(args mock.Arguments) { entry = args.Get(1).(*audit.Entry) }).
+			Return(nil)
+
+		loanObj := &loan.Loan{ID: "loan-123", Status: loan.StatusApproved}
+		err := service.ExpireLoan(ctx, loanObj)
+
+		assert.NoError(t, err)
+		assert.Equal(t, audit.ActorSystem, entry.Actor)
+		assert.Equal(t, string(loan.StatusExpired), entry.ToStatus)
+		assert.Equal(t, audit.OutcomeSuccess, entry.Outcome)
+		assert.Nil(t, entry.Error)
+	})
+
+	t.Run("should record an action refused before the state machine", func(t *testing.T) {
+		mockAuditRepo := mocks.NewMockAuditRepository()
+		service := loan.NewLoanService(nil, nil, nil, nil, nil, mockAuditRepo, memory.NewTransactor(), stubRegistrar{callbacks: fsm.Callbacks{}})
+
+		var entry *audit.Entry
+		mockAuditRepo.On("Append", mock.Anything, mock.AnythingOfType("*audit.Entry")).
+			Run(func(args mock.Arguments) { entry = args.Get(1).(*audit.Entry) }).
+			Return(nil)
+
+		service.RecordRefusedAction(ctx, "loan-123", loan.EventInvest, "lender-123", loan.StatusApproved, errors.New("lender not found"))
+
+		mockAuditRepo.AssertExpectations(t)
+		assert.Equal(t, loan.EventInvest, entry.Action)
+		assert.Equal(t, "lender-123", entry.Actor)
+		assert.Equal(t, string(loan.StatusApproved), entry.FromStatus)
+		assert.Equal(t, string(loan.StatusApproved), entry.ToStatus)
+		assert.Equal(t, audit.OutcomeFailure, entry.Outcome)
+		assert.Equal(t, "lender not found", *entry.Error)
+		assert.Equal(t, "req-123", entry.RequestID)
+	})
+
+	t.Run("should record an action that is not a state machine event as unknown", func(t *testing.T) {
+		mockAuditRepo := mocks.NewMockAuditRepository()
+		service := loan.NewLoanService(nil, nil, nil, nil, nil, mockAuditRepo, memory.NewTransactor(), stubRegistrar{callbacks: fsm.Callbacks{}})
+
+		var entry *audit.Entry
+		mockAuditRepo.On("Append", mock.Anything, mock.AnythingOfType("*audit.Entry")).
+			Run(func(args mock.Arguments) { entry = args.Get(1).(*audit.Entry) }).
+			Return(nil)
+
+		service.RecordRefusedAction(ctx, "loan-123", "approve-every-loan-ever-proposed", "", "", errors.New("invalid status"))
+
+		mockAuditRepo.AssertExpectations(t)
+		assert.Equal(t, audit.ActionUnknown, entry.Action)
+		assert.Equal(t, "invalid status", *entry.Error)
+	})
+
+	t.Run("should not fail the action when the audit log cannot be written", func(t *testing.T) {
+		mockAuditRepo := mocks.NewMockAuditRepository()
+		service := loan.NewLoanService(nil, nil, nil, nil, nil, mockAuditRepo, memory.NewTransactor(), stubRegistrar{callbacks: fsm.Callbacks{}})
+
+		mockAuditRepo.On("Append", mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+
+		loanObj := &loan.Loan{ID: "loan-123", Status: loan.StatusApproved}
+		err := service.ExpireLoan(ctx, loanObj)
+
+		assert.NoError(t, err)
+		mockAuditRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,78 @@
+package statemachine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/looplab/fsm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/memory"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/test/mocks"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/requestid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestTransitionLog(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	t.Cleanup(zap.ReplaceGlobals(zap.New(core)))
+
+	ctx := requestid.WithContext(context.Background(), "req-123")
+	mockAuditRepo := mocks.NewMockAuditRepository()
+	mockAuditRepo.On("Append", mock.Anything, mock.Anything).Return(nil)
+	registrar := stubRegistrar{callbacks: fsm.Callbacks{
+		"before_" + loan.EventCancel: func(_ context.Context, e *fsm.Event) {
+			e.Cancel(errors.New("employee not found"))
+		},
+	}}
+	service := loan.NewLoanService(nil, nil, nil, nil, nil, mockAuditRepo, memory.NewTransactor(), registrar)
+
+	tests := []struct {
+		name    string
+		fire    func(*loan.Loan) error
+		outcome string
+		to      loan.Status
+	}{
+		{
+			name:    "succeeded",
+			fire:    func(l *loan.Loan) error { return service.ExpireLoan(ctx, l) },
+			outcome: "succeeded",
+			to:      loan.StatusExpired,
+		},
+		{
+			name:    "cancelled by a callback",
+			fire:    func(l *loan.Loan) error { return service.CancelLoan(ctx, l, "employee-123", "duplicate") },
+			outcome: "cancelled",
+			to:      loan.StatusApproved,
+		},
+		{
+			name:    "not allowed in the status",
+			fire:    func(l *loan.Loan) error { return service.RejectLoan(ctx, l, "employee-123", "duplicate") },
+			outcome: "not_allowed",
+			to:      loan.StatusApproved,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs.TakeAll()
+			tt.fire(&loan.Loan{ID: "loan-123", Status: loan.StatusApproved})
+
+			entries := logs.TakeAll()
+			require.Len(t, entries, 1)
+			fields := entries[0].ContextMap()
+			assert.Equal(t, "loan-123", fields["loan_id"])
+			assert.Equal(t, "req-123", fields["request_id"])
+			assert.Equal(t, tt.outcome, fields["outcome"])
+			assert.Equal(t, string(loan.StatusApproved), fields["from"])
+			assert.Equal(t, string(tt.to), fields["to"])
+			assert.NotEmpty(t, fields["event"])
+			assert.NotEmpty(t, fields["actor"])
+		})
+	}
+}
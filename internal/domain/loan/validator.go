@@ -20,12 +20,14 @@ func NewDefaultStatusValidator() *DefaultStatusValidator {
 func (v *DefaultStatusValidator) isValidTransition(from, to Status) bool {
 	validTransitions := map[Status][]Status{
 		StatusProposed: {StatusApproved, StatusRejected},
-		StatusApproved: {StatusInvested},
-		StatusInvested: {StatusDisbursed},
+		StatusApproved: {StatusInvested, StatusCancelled, StatusExpired},
+		StatusInvested: {StatusDisbursed, StatusCancelled},
 
 		// Terminated
 		StatusDisbursed: {},
 		StatusRejected:  {},
+		StatusCancelled: {},
+		StatusExpired:   {},
 	}
 
 	allowedNext, exists := validTransitions[from]
@@ -1,6 +1,7 @@
 package loanlender
 
 import (
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,13 +9,15 @@ import (
 
 // LoanLender represents a domain entity for the relationship between a loan and a lender
 type LoanLender struct {
-	ID         string
-	LoanID     string
-	LenderID   string
-	Amount     float64
-	InvestedAt time.Time
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	ID             string
+	LoanID         string
+	LenderID       string
+	Amount         float64
+	ExpectedReturn float64 // At the loan ROI, projected from the investment until the loan is disbursed
+	Payout         float64 // Amount plus the expected return, paid back to the lender over the tenor
+	InvestedAt     time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 func NewLoanLender(loanID, lenderID string, amount float64) *LoanLender {
@@ -24,8 +27,43 @@ func NewLoanLender(loanID, lenderID string, amount float64) *LoanLender {
 		LoanID:     loanID,
 		LenderID:   lenderID,
 		Amount:     amount,
+		Payout:     amount,
 		InvestedAt: now,
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	}
 }
+
+// SetExpectedReturn records the return earned on the investment and the resulting payout
+func (ll *LoanLender) SetExpectedReturn(expectedReturn float64) {
+	ll.ExpectedReturn = expectedReturn
+	ll.Payout = round(ll.Amount + expectedReturn)
+	ll.UpdatedAt = time.Now()
+}
+
+// AllocateReturn splits the return earned on all the investments in a loan between them by their
+// share of the invested total. Returns are rounded to the cent, the last investment taking the
+// difference so that they add up to totalReturn.
+func AllocateReturn(investments []*LoanLender, totalReturn float64) {
+	var invested float64
+	for _, investment := range investments {
+		invested += investment.Amount
+	}
+	if invested <= 0 {
+		return
+	}
+
+	allocated := 0.0
+	for i, investment := range investments {
+		expectedReturn := round(totalReturn * investment.Amount / invested)
+		if i == len(investments)-1 {
+			expectedReturn = round(totalReturn - allocated)
+		}
+		allocated = round(allocated + expectedReturn)
+		investment.SetExpectedReturn(expectedReturn)
+	}
+}
+
+func round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,69 @@
+package loanlender
+
+import (
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
+)
+
+// ReleasedLoanStatuses are the statuses of loans that ended before disbursement. The funds invested
+// in them went back to the lenders, without any return.
+var ReleasedLoanStatuses = []string{"cancelled", "expired"}
+
+// IsReleased reports whether the investments in a loan of this status went back to the lenders
+func IsReleased(loanStatus string) bool {
+	for _, status := range ReleasedLoanStatuses {
+		if status == loanStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// PortfolioStatus aggregates a lender's investments in loans of one status
+type PortfolioStatus struct {
+	Status         string  `json:"status"`
+	Loans          int64   `json:"loans"`
+	Invested       float64 `json:"invested"`
+	ExpectedReturn float64 `json:"expected_return"`
+}
+
+// Position is a lender's aggregated investment in a single loan
+type Position struct {
+	LoanID          string    `json:"loan_id"`
+	LoanStatus      string    `json:"loan_status"`
+	LoanAmount      float64   `json:"loan_amount"`
+	ROI             float64   `json:"roi"`
+	Invested        float64   `json:"invested"`
+	SharePercentage float64   `json:"share_percentage"` // Share of the loan principal funded by the lender
+	ExpectedReturn  float64   `json:"expected_return"`
+	FirstInvestedAt time.Time `json:"first_invested_at"`
+	LastInvestedAt  time.Time `json:"last_invested_at"`
+}
+
+// Portfolio summarizes all investments of a lender
+type Portfolio struct {
+	LenderID       string                    `json:"lender_id"`
+	TotalInvested  float64                   `json:"total_invested"`
+	ExpectedReturn float64                   `json:"expected_return"`
+	Released       float64                   `json:"released"` // Invested in cancelled or expired loans, not part of the totals
+	ByStatus       []PortfolioStatus         `json:"by_status"`
+	Positions      *domain.PaginatedResponse `json:"positions"`
+}
+
+// PositionFilter paginates the positions of a lender
+type PositionFilter struct {
+	LenderID string
+	Page     int
+	PageSize int
+}
+
+func (f *PositionFilter) WithDefaults() *PositionFilter {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	if f.PageSize <= 0 {
+		f.PageSize = 10
+	}
+	return f
+}
@@ -14,6 +14,9 @@ type Repository interface {
 	Create(ctx context.Context, loanLender *LoanLender) error
 	List(ctx context.Context, filter LoanLenderFilter) ([]*LoanLender, error)
 	Count(ctx context.Context, filter LoanLenderFilter) (int64, error)
+	SummarizeByLenderID(ctx context.Context, lenderID string) ([]PortfolioStatus, error)
+	ListPositions(ctx context.Context, filter PositionFilter) ([]*Position, error)
+	CountPositions(ctx context.Context, filter PositionFilter) (int64, error)
 }
 
 type LoanLenderFilter struct {
@@ -3,6 +3,8 @@ package loanlender
 import (
 	"context"
 	"errors"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
 )
 
 var (
@@ -16,6 +18,7 @@ type Service interface {
 	Create(ctx context.Context, loanID, lenderID string, amount float64) (*LoanLender, error)
 	List(ctx context.Context, filter LoanLenderFilter) ([]*LoanLender, error)
 	TotalInvestmentForLoan(ctx context.Context, loanID string) (float64, error)
+	GetPortfolio(ctx context.Context, filter PositionFilter) (*Portfolio, error)
 }
 
 type LoanLenderService struct {
@@ -71,3 +74,56 @@ func (s *LoanLenderService) TotalInvestmentForLoan(ctx context.Context, loanID s
 	}
 	return total, nil
 }
+
+// GetPortfolio summarizes the investments of a lender. The totals are aggregated by the repository,
+// only the requested page of positions is loaded. Investments in cancelled or expired loans are
+// reported as released instead of invested.
+func (s *LoanLenderService) GetPortfolio(ctx context.Context, filter PositionFilter) (*Portfolio, error) {
+	filter.WithDefaults()
+
+	byStatus, err := s.repository.SummarizeByLenderID(ctx, filter.LenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio := &Portfolio{
+		LenderID: filter.LenderID,
+		ByStatus: byStatus,
+	}
+	for _, summary := range byStatus {
+		if IsReleased(summary.Status) {
+			portfolio.Released += summary.Invested
+			continue
+		}
+		portfolio.TotalInvested += summary.Invested
+		portfolio.ExpectedReturn += summary.ExpectedReturn
+	}
+
+	positions, err := s.repository.ListPositions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	totalItems, err := s.repository.CountPositions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate total pages
+	totalPages := 0
+	if filter.PageSize > 0 {
+		totalPages = int((totalItems + int64(filter.PageSize) - 1) / int64(filter.PageSize))
+	}
+
+	portfolio.Positions = &domain.PaginatedResponse{
+		Data: positions,
+		Pagination: domain.PaginationInfo{
+			CurrentPage: filter.Page,
+			PageSize:    filter.PageSize,
+			TotalItems:  totalItems,
+			TotalPages:  totalPages,
+		},
+	}
+
+	return portfolio, nil
+}
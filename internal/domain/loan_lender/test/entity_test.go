@@ -0,0 +1,48 @@
+package loanlender
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
+)
+
+func TestAllocateReturn(t *testing.T) {
+	t.Run("should split the return by share of the investments", func(t *testing.T) {
+		investments := []*loanlender.LoanLender{
+			loanlender.NewLoanLender("loan", "lender-1", 400),
+			loanlender.NewLoanLender("loan", "lender-2", 600),
+		}
+
+		loanlender.AllocateReturn(investments, 80)
+
+		assert.Equal(t, 32.0, investments[0].ExpectedReturn)
+		assert.Equal(t, 432.0, investments[0].Payout)
+		assert.Equal(t, 48.0, investments[1].ExpectedReturn)
+		assert.Equal(t, 648.0, investments[1].Payout)
+	})
+
+	t.Run("should give the rounding difference to the last investment", func(t *testing.T) {
+		investments := []*loanlender.LoanLender{
+			loanlender.NewLoanLender("loan", "lender-1", 100),
+			loanlender.NewLoanLender("loan", "lender-2", 100),
+			loanlender.NewLoanLender("loan", "lender-3", 100),
+		}
+
+		loanlender.AllocateReturn(investments, 10)
+
+		assert.Equal(t, 3.33, investments[0].ExpectedReturn)
+		assert.Equal(t, 3.33, investments[1].ExpectedReturn)
+		assert.Equal(t, 3.34, investments[2].ExpectedReturn)
+		assert.Equal(t, 103.34, investments[2].Payout)
+	})
+
+	t.Run("should leave investments without an amount unchanged", func(t *testing.T) {
+		investment := loanlender.NewLoanLender("loan", "lender-1", 0)
+
+		loanlender.AllocateReturn([]*loanlender.LoanLender{investment}, 10)
+
+		assert.Equal(t, 0.0, investment.ExpectedReturn)
+		assert.Equal(t, 0.0, investment.Payout)
+	})
+}
@@ -0,0 +1,77 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecipientType string
+
+const (
+	RecipientBorrower RecipientType = "borrower"
+	RecipientLender   RecipientType = "lender"
+)
+
+type Status string
+
+const (
+	StatusSent   Status = "sent"
+	StatusFailed Status = "failed"
+)
+
+// Message is what a Notifier sends to a single recipient
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Notification records a message sent, or attempted, to a borrower or lender
+type Notification struct {
+	ID            string        `json:"id"`
+	MessageID     string        `json:"message_id"` // Outbox message that triggered the notification
+	LoanID        string        `json:"loan_id"`
+	EventType     string        `json:"event_type"`
+	RecipientType RecipientType `json:"recipient_type"`
+	RecipientID   string        `json:"recipient_id"`
+	Email         string        `json:"email"`
+	Channel       string        `json:"channel"`
+	Subject       string        `json:"subject"`
+	Body          string        `json:"body"`
+	Status        Status        `json:"status"`
+	Error         *string       `json:"error"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+func NewNotification(messageID, loanID, eventType string, recipient Recipient, channel string, message Message) *Notification {
+	return &Notification{
+		ID:            uuid.New().String(),
+		MessageID:     messageID,
+		LoanID:        loanID,
+		EventType:     eventType,
+		RecipientType: recipient.Type,
+		RecipientID:   recipient.ID,
+		Email:         recipient.Email,
+		Channel:       channel,
+		Subject:       message.Subject,
+		Body:          message.Body,
+		Status:        StatusSent,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// MarkFailed records why the notification could not be sent
+func (n *Notification) MarkFailed(err error) {
+	errMsg := err.Error()
+	n.Status = StatusFailed
+	n.Error = &errMsg
+}
+
+// Recipient is a borrower or lender being notified
+type Recipient struct {
+	Type  RecipientType
+	ID    string
+	Name  string
+	Email string
+}
@@ -0,0 +1,100 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
+	"go.uber.org/zap"
+)
+
+// Notifier delivers a message to a recipient
+type Notifier interface {
+	// Channel names the delivery channel recorded with each notification
+	Channel() string
+	Send(ctx context.Context, message Message) error
+}
+
+// SMTPNotifier sends notifications as plain text emails
+type SMTPNotifier struct {
+	addr string
+	host string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTPNotifier(host, port, username, password, from string) *SMTPNotifier {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTPNotifier{
+		addr: net.JoinHostPort(host, port),
+		host: host,
+		auth: auth,
+		from: from,
+	}
+}
+
+func (n *SMTPNotifier) Channel() string {
+	return "smtp"
+}
+
+func (n *SMTPNotifier) Send(ctx context.Context, message Message) error {
+	var b strings.Builder
+	b.WriteString("From: " + n.from + "\r\n")
+	b.WriteString("To: " + message.To + "\r\n")
+	b.WriteString("Subject: " + message.Subject + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+
+	return smtp.SendMail(n.addr, n.auth, n.from, []string{message.To}, []byte(b.String()))
+}
+
+// LogNotifier is a stand-in for environments without a mail server. It appends every
+// message to a file, or writes it to the standard logger when no file is configured.
+type LogNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewLogNotifier(path string) *LogNotifier {
+	return &LogNotifier{
+		path: path,
+	}
+}
+
+func (n *LogNotifier) Channel() string {
+	return "log"
+}
+
+func (n *LogNotifier) Send(ctx context.Context, message Message) error {
+	entry := fmt.Sprintf("[%s] To: %s\nSubject: %s\n\n%s\n\n", time.Now().Format(time.RFC3339), message.To, message.Subject, message.Body)
+
+	if n.path == "" {
+		logging.FromContext(ctx).Info("Notification", zap.String("to", message.To), zap.String("subject", message.Subject), zap.String("body", message.Body))
+		return nil
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.WriteString(entry)
+	return err
+}
@@ -0,0 +1,195 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/outbox"
+)
+
+// Settings configures the content of the notifications
+type Settings struct {
+	AgreementBaseURL string // Agreement letters are linked as <AgreementBaseURL>/<file name>
+}
+
+// OutboxHandler notifies the borrower and the investors of a loan about the events they care about
+type OutboxHandler struct {
+	repository           Repository
+	notifier             Notifier
+	loanRepository       loan.Repository
+	borrowerRepository   borrower.Repository
+	lenderRepository     lender.Repository
+	loanLenderRepository loanlender.Repository
+	settings             Settings
+}
+
+var _ outbox.Handler = (*OutboxHandler)(nil)
+
+func NewOutboxHandler(
+	r Repository,
+	n Notifier,
+	loanRepo loan.Repository,
+	borrowerRepo borrower.Repository,
+	lenderRepo lender.Repository,
+	loanLenderRepo loanlender.Repository,
+	settings Settings,
+) *OutboxHandler {
+	return &OutboxHandler{
+		repository:           r,
+		notifier:             n,
+		loanRepository:       loanRepo,
+		borrowerRepository:   borrowerRepo,
+		lenderRepository:     lenderRepo,
+		loanLenderRepository: loanLenderRepo,
+		settings:             settings,
+	}
+}
+
+func (h *OutboxHandler) Name() string {
+	return "notifications"
+}
+
+func (h *OutboxHandler) Handle(ctx context.Context, message *outbox.Message) error {
+	eventType := loan.EventType(message.EventType)
+	notifyBorrower := hasTemplate(eventType, RecipientBorrower)
+	notifyLenders := hasTemplate(eventType, RecipientLender)
+	if !notifyBorrower && !notifyLenders {
+		return nil
+	}
+
+	loanObj, err := h.loanRepository.Get(ctx, message.AggregateID)
+	if err != nil {
+		return err
+	}
+
+	data, err := h.templateData(message, loanObj)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	if notifyBorrower {
+		borrowerObj, err := h.borrowerRepository.Get(ctx, loanObj.BorrowerID)
+		if err != nil {
+			return err
+		}
+
+		recipient := Recipient{Type: RecipientBorrower, ID: borrowerObj.ID, Name: borrowerObj.FullName, Email: borrowerObj.Email}
+		if err := h.notify(ctx, message, recipient, data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if notifyLenders {
+		investments, err := h.loanLenderRepository.GetByLoanID(ctx, loanObj.ID)
+		if err != nil {
+			return err
+		}
+
+		// A lender may have invested several times in the same loan
+		invested := map[string]float64{}
+		payout := map[string]float64{}
+		var lenderIDs []string
+		for _, investment := range investments {
+			if _, ok := invested[investment.LenderID]; !ok {
+				lenderIDs = append(lenderIDs, investment.LenderID)
+			}
+			invested[investment.LenderID] += investment.Amount
+			payout[investment.LenderID] += investment.Payout
+		}
+
+		for _, lenderID := range lenderIDs {
+			lenderObj, err := h.lenderRepository.Get(ctx, lenderID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			lenderData := data
+			lenderData.InvestedAmount = invested[lenderID]
+			lenderData.Payout = payout[lenderID]
+
+			recipient := Recipient{Type: RecipientLender, ID: lenderObj.ID, Name: lenderObj.FullName, Email: lenderObj.Email}
+			if err := h.notify(ctx, message, recipient, lenderData); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	// Failed recipients are retried with the outbox message, the others are skipped then
+	return errors.Join(errs...)
+}
+
+func (h *OutboxHandler) templateData(message *outbox.Message, loanObj *loan.Loan) (TemplateData, error) {
+	data := TemplateData{
+		LoanID: loanObj.ID,
+		Amount: loanObj.Amount,
+		Rate:   loanObj.Rate,
+		ROI:    loanObj.ROI,
+	}
+
+	switch loan.EventType(message.EventType) {
+	case loan.EventTypeLoanApproved:
+		var payload loan.LoanApprovedPayload
+		if err := message.DecodePayload(&payload); err != nil {
+			return data, err
+		}
+		data.ApprovalDate = payload.ApprovalDate
+
+	case loan.EventTypeLoanFullyFunded:
+		var payload loan.LoanFullyFundedPayload
+		if err := message.DecodePayload(&payload); err != nil {
+			return data, err
+		}
+		data.AgreementLink = h.agreementLink(payload.AgreementDocument)
+
+	case loan.EventTypeLoanDisbursed:
+		var payload loan.LoanDisbursedPayload
+		if err := message.DecodePayload(&payload); err != nil {
+			return data, err
+		}
+		data.DisbursementDate = payload.DisbursementDate
+		data.BorrowerRepayment = payload.BorrowerRepayment
+	}
+
+	return data, nil
+}
+
+func (h *OutboxHandler) agreementLink(fileName string) string {
+	if h.settings.AgreementBaseURL == "" {
+		return fileName
+	}
+	return strings.TrimSuffix(h.settings.AgreementBaseURL, "/") + "/" + fileName
+}
+
+// notify sends the message of the event to a recipient once and records the outcome
+func (h *OutboxHandler) notify(ctx context.Context, message *outbox.Message, recipient Recipient, data TemplateData) error {
+	sent, err := h.repository.HasBeenSent(ctx, message.ID, recipient.ID)
+	if err != nil || sent {
+		return err
+	}
+
+	rendered, ok, err := Render(loan.EventType(message.EventType), recipient, data)
+	if err != nil || !ok {
+		return err
+	}
+
+	notification := NewNotification(message.ID, data.LoanID, message.EventType, recipient, h.notifier.Channel(), rendered)
+
+	sendErr := h.notifier.Send(ctx, rendered)
+	if sendErr != nil {
+		notification.MarkFailed(sendErr)
+	}
+
+	if err := h.repository.Create(ctx, notification); err != nil {
+		return err
+	}
+
+	return sendErr
+}
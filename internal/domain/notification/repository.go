@@ -0,0 +1,34 @@
+package notification
+
+import (
+	"context"
+)
+
+// Repository defines the data access interface for sent notifications
+type Repository interface {
+	Create(ctx context.Context, notification *Notification) error
+	// HasBeenSent reports whether the recipient already received a notification for the outbox message
+	HasBeenSent(ctx context.Context, messageID, recipientID string) (bool, error)
+	List(ctx context.Context, filter NotificationFilter) ([]*Notification, error)
+	Count(ctx context.Context, filter NotificationFilter) (int64, error)
+}
+
+type NotificationFilter struct {
+	RecipientType *RecipientType
+	RecipientID   *string
+	LoanID        *string
+	EventType     *string
+	Status        *Status
+	Page          int
+	PageSize      int
+}
+
+func (f *NotificationFilter) WithDefaults() *NotificationFilter {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	if f.PageSize <= 0 {
+		f.PageSize = 10
+	}
+	return f
+}
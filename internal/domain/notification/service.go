@@ -0,0 +1,47 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
+)
+
+type NotificationService struct {
+	repository Repository
+}
+
+func NewNotificationService(r Repository) *NotificationService {
+	return &NotificationService{
+		repository: r,
+	}
+}
+
+func (s *NotificationService) ListNotifications(ctx context.Context, filter NotificationFilter) (*domain.PaginatedResponse, error) {
+	filter.WithDefaults()
+	notifications, err := s.repository.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the total count
+	totalItems, err := s.repository.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate total pages
+	totalPages := 0
+	if filter.PageSize > 0 {
+		totalPages = int((totalItems + int64(filter.PageSize) - 1) / int64(filter.PageSize))
+	}
+
+	return &domain.PaginatedResponse{
+		Data: notifications,
+		Pagination: domain.PaginationInfo{
+			CurrentPage: filter.Page,
+			PageSize:    filter.PageSize,
+			TotalItems:  totalItems,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
@@ -0,0 +1,109 @@
+package notification
+
+import (
+	"bytes"
+	"text/template"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+// TemplateData holds the values available to the notification templates
+type TemplateData struct {
+	RecipientName     string
+	LoanID            string
+	Amount            float64
+	Rate              float64
+	ROI               float64
+	InvestedAmount    float64 // Lender's investment in the loan
+	Payout            float64 // Lender's principal plus ROI
+	AgreementLink     string
+	ApprovalDate      time.Time
+	DisbursementDate  time.Time
+	BorrowerRepayment float64
+}
+
+type messageTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+type templateKey struct {
+	eventType     loan.EventType
+	recipientType RecipientType
+}
+
+func mustTemplate(subject, body string) messageTemplate {
+	return messageTemplate{
+		subject: template.Must(template.New("subject").Parse(subject)),
+		body:    template.Must(template.New("body").Parse(body)),
+	}
+}
+
+var templates = map[templateKey]messageTemplate{
+	{loan.EventTypeLoanApproved, RecipientBorrower}: mustTemplate(
+		"Your loan has been approved",
+		`Dear {{.RecipientName}},
+
+Your loan {{.LoanID}} of {{printf "%.2f" .Amount}} at a rate of {{printf "%.2f" .Rate}}% was approved on {{.ApprovalDate.Format "02 Jan 2006"}}.
+It is now open for investment and you will be notified once it is fully funded.
+`),
+	{loan.EventTypeLoanFullyFunded, RecipientBorrower}: mustTemplate(
+		"Your loan is fully funded",
+		`Dear {{.RecipientName}},
+
+Your loan {{.LoanID}} of {{printf "%.2f" .Amount}} is fully funded and will be disbursed soon.
+Your agreement letter is available at {{.AgreementLink}}
+`),
+	{loan.EventTypeLoanFullyFunded, RecipientLender}: mustTemplate(
+		"Agreement letter for loan {{.LoanID}}",
+		`Dear {{.RecipientName}},
+
+Loan {{.LoanID}} you invested {{printf "%.2f" .InvestedAmount}} in is now fully funded.
+Your agreement letter is available at {{.AgreementLink}}
+`),
+	{loan.EventTypeLoanDisbursed, RecipientBorrower}: mustTemplate(
+		"Your loan has been disbursed",
+		`Dear {{.RecipientName}},
+
+Your loan {{.LoanID}} of {{printf "%.2f" .Amount}} was disbursed on {{.DisbursementDate.Format "02 Jan 2006"}}.
+The total repayment due is {{printf "%.2f" .BorrowerRepayment}}.
+`),
+	{loan.EventTypeLoanDisbursed, RecipientLender}: mustTemplate(
+		"Loan {{.LoanID}} has been disbursed",
+		`Dear {{.RecipientName}},
+
+Loan {{.LoanID}} you invested {{printf "%.2f" .InvestedAmount}} in was disbursed on {{.DisbursementDate.Format "02 Jan 2006"}}.
+At an ROI of {{printf "%.2f" .ROI}}% your payout, principal included, is {{printf "%.2f" .Payout}}.
+`),
+}
+
+// hasTemplate reports whether a recipient type is notified of an event type
+func hasTemplate(eventType loan.EventType, recipientType RecipientType) bool {
+	_, ok := templates[templateKey{eventType, recipientType}]
+	return ok
+}
+
+// Render builds the message of an event for a recipient
+func Render(eventType loan.EventType, recipient Recipient, data TemplateData) (Message, bool, error) {
+	tmpl, ok := templates[templateKey{eventType, recipient.Type}]
+	if !ok {
+		return Message{}, false, nil
+	}
+
+	data.RecipientName = recipient.Name
+
+	var subject, body bytes.Buffer
+	if err := tmpl.subject.Execute(&subject, data); err != nil {
+		return Message{}, false, err
+	}
+	if err := tmpl.body.Execute(&body, data); err != nil {
+		return Message{}, false, err
+	}
+
+	return Message{
+		To:      recipient.Email,
+		Subject: subject.String(),
+		Body:    body.String(),
+	}, true, nil
+}
@@ -0,0 +1,159 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/notification"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/outbox"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/test/mocks"
+)
+
+// recordingNotifier keeps the messages instead of sending them
+type recordingNotifier struct {
+	failFor string
+	sent    []notification.Message
+}
+
+func (n *recordingNotifier) Channel() string {
+	return "test"
+}
+
+func (n *recordingNotifier) Send(ctx context.Context, message notification.Message) error {
+	if message.To == n.failFor {
+		return errors.New("mailbox unavailable")
+	}
+	n.sent = append(n.sent, message)
+	return nil
+}
+
+func TestOutboxHandler(t *testing.T) {
+	setup := func(notifier *recordingNotifier) (*notification.OutboxHandler, *mocks.MockNotificationRepository) {
+		mockRepo := mocks.NewMockNotificationRepository()
+		mockLoanRepo := mocks.NewMockLoanRepository()
+		mockBorrowerRepo := mocks.NewMockBorrowerRepository()
+		mockLenderRepo := mocks.NewMockLenderRepository()
+		mockLoanLenderRepo := mocks.NewMockLoanLenderRepository()
+
+		mockLoanRepo.On("Get", mock.Anything, "loan-123").Return(&loan.Loan{
+			ID: "loan-123", BorrowerID: "borrower-123", Amount: 10000, Rate: 12, ROI: 10,
+		}, nil)
+		mockBorrowerRepo.On("Get", mock.Anything, "borrower-123").Return(&borrower.Borrower{
+			ID: "borrower-123", FullName: "Budi", Email: "budi@example.com",
+		}, nil)
+		mockLoanLenderRepo.On("GetByLoanID", mock.Anything, "loan-123").Return([]*loanlender.LoanLender{
+			{LoanID: "loan-123", LenderID: "lender-1", Amount: 4000, Payout: 4400},
+			{LoanID: "loan-123", LenderID: "lender-2", Amount: 5000, Payout: 5500},
+			{LoanID: "loan-123", LenderID: "lender-1", Amount: 1000, Payout: 1100},
+		}, nil)
+		mockLenderRepo.On("Get", mock.Anything, "lender-1").Return(&lender.Lender{ID: "lender-1", FullName: "Ani", Email: "ani@example.com"}, nil)
+		mockLenderRepo.On("Get", mock.Anything, "lender-2").Return(&lender.Lender{ID: "lender-2", FullName: "Citra", Email: "citra@example.com"}, nil)
+		mockRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+		handler := notification.NewOutboxHandler(mockRepo, notifier, mockLoanRepo, mockBorrowerRepo, mockLenderRepo, mockLoanLenderRepo,
+			notification.Settings{AgreementBaseURL: "https://loans.example.com/documents/"})
+
+		return handler, mockRepo
+	}
+
+	fullyFunded := func() *outbox.Message {
+		payload, _ := json.Marshal(loan.LoanFullyFundedPayload{
+			BorrowerID:        "borrower-123",
+			TotalInvested:     10000,
+			AgreementDocument: "agreement_loan-123.pdf",
+			InvestmentDate:    time.Now(),
+		})
+		return &outbox.Message{ID: "msg-1", AggregateID: "loan-123", EventType: string(loan.EventTypeLoanFullyFunded), Payload: payload}
+	}
+
+	t.Run("should send the agreement link to the borrower and every investor", func(t *testing.T) {
+		notifier := &recordingNotifier{}
+		handler, mockRepo := setup(notifier)
+		mockRepo.On("HasBeenSent", mock.Anything, "msg-1", mock.Anything).Return(false, nil)
+
+		// Execute
+		err := handler.Handle(context.Background(), fullyFunded())
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, notifier.sent, 3) {
+			assert.Equal(t, "budi@example.com", notifier.sent[0].To)
+			assert.Equal(t, "ani@example.com", notifier.sent[1].To)
+			assert.Equal(t, "citra@example.com", notifier.sent[2].To)
+			for _, message := range notifier.sent {
+				assert.Contains(t, message.Body, "https://loans.example.com/documents/agreement_loan-123.pdf")
+			}
+			assert.Contains(t, notifier.sent[1].Body, "invested 5000.00")
+		}
+		mockRepo.AssertNumberOfCalls(t, "Create", 3)
+	})
+
+	t.Run("should skip recipients already notified and report failed sends", func(t *testing.T) {
+		notifier := &recordingNotifier{failFor: "citra@example.com"}
+		handler, mockRepo := setup(notifier)
+		mockRepo.On("HasBeenSent", mock.Anything, "msg-1", "borrower-123").Return(true, nil)
+		mockRepo.On("HasBeenSent", mock.Anything, "msg-1", mock.Anything).Return(false, nil)
+
+		// Execute
+		err := handler.Handle(context.Background(), fullyFunded())
+
+		// Assert
+		assert.ErrorContains(t, err, "mailbox unavailable")
+		if assert.Len(t, notifier.sent, 1) {
+			assert.Equal(t, "ani@example.com", notifier.sent[0].To)
+		}
+
+		// The failed attempt is recorded too
+		failed := mockRepo.Calls[len(mockRepo.Calls)-1].Arguments.Get(1).(*notification.Notification)
+		assert.Equal(t, notification.StatusFailed, failed.Status)
+		assert.Equal(t, "lender-2", failed.RecipientID)
+	})
+
+	t.Run("should send every investor their payout on disbursement", func(t *testing.T) {
+		notifier := &recordingNotifier{}
+		handler, mockRepo := setup(notifier)
+		mockRepo.On("HasBeenSent", mock.Anything, "msg-3", mock.Anything).Return(false, nil)
+
+		payload, _ := json.Marshal(loan.LoanDisbursedPayload{
+			BorrowerID:        "borrower-123",
+			DisbursementDate:  time.Now(),
+			BorrowerRepayment: 11200,
+			InvestorROI:       1000,
+		})
+		message := &outbox.Message{ID: "msg-3", AggregateID: "loan-123", EventType: string(loan.EventTypeLoanDisbursed), Payload: payload}
+
+		// Execute
+		err := handler.Handle(context.Background(), message)
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, notifier.sent, 3) {
+			assert.Contains(t, notifier.sent[1].Body, "invested 5000.00")
+			assert.Contains(t, notifier.sent[1].Body, "your payout, principal included, is 5500.00")
+			assert.Contains(t, notifier.sent[2].Body, "your payout, principal included, is 5500.00")
+		}
+	})
+
+	t.Run("should ignore events without notifications", func(t *testing.T) {
+		notifier := &recordingNotifier{}
+		handler, _ := setup(notifier)
+
+		message := &outbox.Message{ID: "msg-2", AggregateID: "loan-123", EventType: string(loan.EventTypeLoanInvestmentReceived), Payload: []byte(`{}`)}
+
+		// Execute
+		err := handler.Handle(context.Background(), message)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, notifier.sent)
+	})
+}
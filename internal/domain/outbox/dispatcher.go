@@ -0,0 +1,134 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPollInterval = 2 * time.Second
+	defaultBatchSize    = 50
+)
+
+// Handler receives the messages of the outbox.
+// Delivery is at-least-once: a message is redelivered to every handler until all of them
+// succeed, so handlers must be idempotent.
+type Handler interface {
+	// Name identifies the handler in logs
+	Name() string
+	Handle(ctx context.Context, message *Message) error
+}
+
+// Dispatcher polls the outbox and delivers pending messages to the registered handlers
+type Dispatcher struct {
+	repository   Repository
+	handlers     []Handler
+	pollInterval time.Duration
+	batchSize    int
+
+	stop    chan struct{}
+	wg      sync.WaitGroup
+	running atomic.Bool
+}
+
+func NewDispatcher(r Repository, handlers []Handler) *Dispatcher {
+	return &Dispatcher{
+		repository:   r,
+		handlers:     handlers,
+		pollInterval: defaultPollInterval,
+		batchSize:    defaultBatchSize,
+	}
+}
+
+// Start begins polling the outbox in the background
+func (d *Dispatcher) Start() {
+	d.stop = make(chan struct{})
+	d.wg.Add(1)
+	d.running.Store(true)
+
+	go func() {
+		defer d.wg.Done()
+		defer d.running.Store(false)
+
+		ticker := time.NewTicker(d.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-d.stop:
+				return
+			case <-ticker.C:
+				if _, err := d.DispatchPending(context.Background()); err != nil {
+					zap.L().Error("Outbox dispatch failed", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Running tells whether the background loop is started and not stopped
+func (d *Dispatcher) Running() bool {
+	return d.running.Load()
+}
+
+// Stop waits for the current batch to finish, or for ctx to be done
+func (d *Dispatcher) Stop(ctx context.Context) error {
+	if d.stop == nil {
+		return nil
+	}
+	close(d.stop)
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// DispatchPending delivers one batch of pending messages and returns how many were sent
+func (d *Dispatcher) DispatchPending(ctx context.Context) (int, error) {
+	messages, err := d.repository.FetchPending(ctx, d.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, message := range messages {
+		if err := d.deliver(ctx, message); err != nil {
+			message.MarkFailed(time.Now(), err)
+			logging.FromContext(ctx).Warn("Outbox message delivery failed",
+				zap.String("message_id", message.ID), zap.String("event_type", message.EventType), zap.Int("attempt", message.Attempts), zap.Error(err))
+		} else {
+			message.MarkSent(time.Now())
+			sent++
+		}
+
+		if err := d.repository.Save(ctx, message); err != nil {
+			return sent, err
+		}
+	}
+
+	return sent, nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, message *Message) error {
+	for _, handler := range d.handlers {
+		if err := handler.Handle(ctx, message); err != nil {
+			return fmt.Errorf("%s: %w", handler.Name(), err)
+		}
+	}
+	return nil
+}
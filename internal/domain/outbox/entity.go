@@ -0,0 +1,78 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusSent    Status = "sent"
+	StatusFailed  Status = "failed" // Gave up after MaxAttempts
+)
+
+const (
+	// MaxAttempts is the number of deliveries tried before a message is marked failed
+	MaxAttempts = 10
+
+	baseBackoff = time.Second
+	maxBackoff  = 5 * time.Minute
+)
+
+// Message is a domain event waiting in the outbox to be delivered to the handlers
+type Message struct {
+	ID            string          `json:"id"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+	Status        Status          `json:"status"`
+	Attempts      int             `json:"attempts"`
+	LastError     *string         `json:"last_error"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	SentAt        *time.Time      `json:"sent_at"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// DecodePayload unmarshals the event payload into v
+func (m *Message) DecodePayload(v any) error {
+	return json.Unmarshal(m.Payload, v)
+}
+
+// MarkSent records a successful delivery
+func (m *Message) MarkSent(now time.Time) {
+	m.Status = StatusSent
+	m.Attempts++
+	m.LastError = nil
+	m.SentAt = &now
+}
+
+// MarkFailed records a failed delivery and schedules the next attempt with exponential backoff.
+// The message is given up once it reaches MaxAttempts.
+func (m *Message) MarkFailed(now time.Time, err error) {
+	m.Attempts++
+	errMsg := err.Error()
+	m.LastError = &errMsg
+
+	if m.Attempts >= MaxAttempts {
+		m.Status = StatusFailed
+		return
+	}
+
+	m.NextAttemptAt = now.Add(Backoff(m.Attempts))
+}
+
+// Backoff returns the delay before retrying after the given number of attempts
+func Backoff(attempts int) time.Duration {
+	delay := baseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return delay
+}
@@ -0,0 +1,13 @@
+package outbox
+
+import (
+	"context"
+)
+
+// Repository defines the data access interface for outbox messages.
+// Messages are inserted by the aggregate repositories in the same transaction as the aggregate.
+type Repository interface {
+	// FetchPending returns up to limit pending messages due for delivery, oldest first
+	FetchPending(ctx context.Context, limit int) ([]*Message, error)
+	Save(ctx context.Context, message *Message) error
+}
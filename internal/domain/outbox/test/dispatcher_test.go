@@ -0,0 +1,119 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/outbox"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/test/mocks"
+)
+
+type recordingHandler struct {
+	err      error
+	received []string
+}
+
+func (h *recordingHandler) Name() string {
+	return "recording"
+}
+
+func (h *recordingHandler) Handle(ctx context.Context, message *outbox.Message) error {
+	h.received = append(h.received, message.ID)
+	return h.err
+}
+
+func TestDispatchPending(t *testing.T) {
+	newMessage := func(id string) *outbox.Message {
+		return &outbox.Message{
+			ID:        id,
+			EventType: "LoanApproved",
+			Payload:   []byte(`{}`),
+			Status:    outbox.StatusPending,
+		}
+	}
+
+	t.Run("should mark messages sent once every handler succeeds", func(t *testing.T) {
+		mockRepo := mocks.NewMockOutboxRepository()
+		first, second := &recordingHandler{}, &recordingHandler{}
+
+		messages := []*outbox.Message{newMessage("msg-1"), newMessage("msg-2")}
+		mockRepo.On("FetchPending", mock.Anything, mock.Anything).Return(messages, nil)
+		mockRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+		dispatcher := outbox.NewDispatcher(mockRepo, []outbox.Handler{first, second})
+
+		// Execute
+		sent, err := dispatcher.DispatchPending(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, sent)
+		assert.Equal(t, []string{"msg-1", "msg-2"}, first.received)
+		assert.Equal(t, []string{"msg-1", "msg-2"}, second.received)
+		for _, message := range messages {
+			assert.Equal(t, outbox.StatusSent, message.Status)
+			assert.NotNil(t, message.SentAt)
+		}
+		mockRepo.AssertNumberOfCalls(t, "Save", 2)
+	})
+
+	t.Run("should keep failed messages pending with backoff", func(t *testing.T) {
+		mockRepo := mocks.NewMockOutboxRepository()
+		handler := &recordingHandler{err: errors.New("receiver unavailable")}
+
+		message := newMessage("msg-1")
+		mockRepo.On("FetchPending", mock.Anything, mock.Anything).Return([]*outbox.Message{message}, nil)
+		mockRepo.On("Save", mock.Anything, message).Return(nil)
+
+		dispatcher := outbox.NewDispatcher(mockRepo, []outbox.Handler{handler})
+
+		// Execute
+		before := time.Now()
+		sent, err := dispatcher.DispatchPending(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sent)
+		assert.Equal(t, outbox.StatusPending, message.Status)
+		assert.Equal(t, 1, message.Attempts)
+		assert.Equal(t, "recording: receiver unavailable", *message.LastError)
+		assert.True(t, message.NextAttemptAt.After(before))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should give up after the maximum number of attempts", func(t *testing.T) {
+		mockRepo := mocks.NewMockOutboxRepository()
+		handler := &recordingHandler{err: errors.New("receiver unavailable")}
+
+		message := newMessage("msg-1")
+		message.Attempts = outbox.MaxAttempts - 1
+		mockRepo.On("FetchPending", mock.Anything, mock.Anything).Return([]*outbox.Message{message}, nil)
+		mockRepo.On("Save", mock.Anything, message).Return(nil)
+
+		dispatcher := outbox.NewDispatcher(mockRepo, []outbox.Handler{handler})
+
+		// Execute
+		_, err := dispatcher.DispatchPending(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, outbox.StatusFailed, message.Status)
+	})
+}
+
+func TestDispatcherLifecycle(t *testing.T) {
+	t.Run("should be running between start and stop", func(t *testing.T) {
+		dispatcher := outbox.NewDispatcher(mocks.NewMockOutboxRepository(), nil)
+		assert.False(t, dispatcher.Running())
+
+		dispatcher.Start()
+		assert.True(t, dispatcher.Running())
+
+		assert.NoError(t, dispatcher.Stop(context.Background()))
+		assert.False(t, dispatcher.Running())
+	})
+}
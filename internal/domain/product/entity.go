@@ -0,0 +1,112 @@
+package product
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+)
+
+// Details are what a product offers, set when it is created and replaced when it is updated
+type Details struct {
+	Name              string          `json:"name"`
+	MinAmount         float64         `json:"min_amount"`
+	MaxAmount         float64         `json:"max_amount"`
+	MinRate           float64         `json:"min_rate"` // Annual interest rate paid by the borrower, in percent
+	MaxRate           float64         `json:"max_rate"`
+	MinROI            float64         `json:"min_roi"` // Annual return paid to the investors, in percent
+	MaxROI            float64         `json:"max_roi"`
+	TenorMonths       []int           `json:"tenor_months"` // Tenors offered, the first one being the default
+	InterestMethod    interest.Method `json:"interest_method"`
+	RequiredDocuments []string        `json:"required_documents"` // e.g. id_card or payslip, for the field officers to collect; informational, not checked at approval
+}
+
+// LoanProduct is an entry of the catalog a loan is proposed from. Loans keep their own amount,
+// rates and terms, so changing or deleting a product does not change the loans made from it.
+type LoanProduct struct {
+	ID string `json:"id"`
+	Details
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewLoanProduct(details Details) *LoanProduct {
+	now := time.Now()
+	return &LoanProduct{
+		ID:        uuid.New().String(),
+		Details:   details,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Validate returns an error when an amount is not positive, a rate or ROI is not between 0 and 100
+// percent, a range is reversed, no ROI is below a rate or a tenor or the interest method cannot be used
+func (d Details) Validate() error {
+	switch {
+	case d.MinAmount <= 0 || d.MaxAmount <= 0:
+		return fmt.Errorf("%w: amounts must be above 0", ErrInvalidProduct)
+	case d.MinRate <= 0 || d.MaxRate >= 100:
+		return fmt.Errorf("%w: rates must be between 0 and 100 percent", ErrInvalidProduct)
+	case d.MinROI <= 0 || d.MaxROI >= 100:
+		return fmt.Errorf("%w: ROI must be between 0 and 100 percent", ErrInvalidProduct)
+	case d.MinAmount > d.MaxAmount:
+		return fmt.Errorf("%w: min amount is above max amount", ErrInvalidProduct)
+	case d.MinRate > d.MaxRate:
+		return fmt.Errorf("%w: min rate is above max rate", ErrInvalidProduct)
+	case d.MinROI > d.MaxROI:
+		return fmt.Errorf("%w: min ROI is above max ROI", ErrInvalidProduct)
+	case d.MinROI >= d.MaxRate:
+		return fmt.Errorf("%w: min ROI must be below max rate", ErrInvalidProduct)
+	case len(d.TenorMonths) == 0:
+		return fmt.Errorf("%w: at least one tenor must be offered", ErrInvalidProduct)
+	}
+
+	for _, tenor := range d.TenorMonths {
+		terms := interest.Terms{TenorMonths: tenor, Method: d.InterestMethod, DayCount: interest.DayCount30360}
+		if err := terms.Validate(); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidProduct, err)
+		}
+	}
+
+	return nil
+}
+
+// LoanTerms checks that the product offers a loan of amount at the annual rate and ROI, the investors
+// earning less than the borrower pays, and returns its interest terms. The tenor defaults to the first
+// one offered and the method to the product's.
+func (p *LoanProduct) LoanTerms(amount float64, rate float64, roi float64, requested interest.Terms) (interest.Terms, error) {
+	if amount < p.MinAmount || amount > p.MaxAmount {
+		return interest.Terms{}, fmt.Errorf("%w: amount must be between %s and %s", ErrNotOffered, format(p.MinAmount), format(p.MaxAmount))
+	}
+	if rate < p.MinRate || rate > p.MaxRate {
+		return interest.Terms{}, fmt.Errorf("%w: rate must be between %s and %s", ErrNotOffered, format(p.MinRate), format(p.MaxRate))
+	}
+	if roi < p.MinROI || roi > p.MaxROI {
+		return interest.Terms{}, fmt.Errorf("%w: ROI must be between %s and %s", ErrNotOffered, format(p.MinROI), format(p.MaxROI))
+	}
+	if roi >= rate {
+		return interest.Terms{}, fmt.Errorf("%w: ROI must be below the rate", ErrNotOffered)
+	}
+
+	terms := requested
+	if terms.TenorMonths == 0 {
+		terms.TenorMonths = p.TenorMonths[0]
+	} else if !slices.Contains(p.TenorMonths, terms.TenorMonths) {
+		return interest.Terms{}, fmt.Errorf("%w: tenor must be one of %v months", ErrNotOffered, p.TenorMonths)
+	}
+	if terms.Method == "" {
+		terms.Method = p.InterestMethod
+	} else if terms.Method != p.InterestMethod {
+		return interest.Terms{}, fmt.Errorf("%w: interest method must be %s", ErrNotOffered, p.InterestMethod)
+	}
+
+	return terms.WithDefaults(), nil
+}
+
+func format(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
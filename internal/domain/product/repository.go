@@ -0,0 +1,40 @@
+package product
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrProductNotFound is returned when no product has the requested ID
+	ErrProductNotFound = errors.New("loan product not found")
+	ErrInvalidProduct  = errors.New("invalid loan product")
+	// ErrNotOffered is returned when a loan does not fit the amount, rates or terms of its product
+	ErrNotOffered = errors.New("loan not offered by the product")
+)
+
+// Repository defines the data access interface for loan products
+type Repository interface {
+	Get(ctx context.Context, id string) (*LoanProduct, error)
+	Create(ctx context.Context, product *LoanProduct) error
+	Save(ctx context.Context, product *LoanProduct) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, filter ProductFilter) ([]*LoanProduct, error)
+	Count(ctx context.Context, filter ProductFilter) (int64, error)
+}
+
+type ProductFilter struct {
+	Name     *string // Matches the name partially
+	Page     int
+	PageSize int
+}
+
+func (f *ProductFilter) WithDefaults() *ProductFilter {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	if f.PageSize <= 0 {
+		f.PageSize = 10
+	}
+	return f
+}
@@ -0,0 +1,92 @@
+package product
+
+import (
+	"context"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
+)
+
+type ProductService struct {
+	repository Repository
+}
+
+func NewProductService(r Repository) *ProductService {
+	return &ProductService{
+		repository: r,
+	}
+}
+
+func (s *ProductService) CreateProduct(ctx context.Context, details Details) (*LoanProduct, error) {
+	if err := details.Validate(); err != nil {
+		return nil, err
+	}
+
+	product := NewLoanProduct(details)
+
+	if err := s.repository.Create(ctx, product); err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+func (s *ProductService) GetByID(ctx context.Context, id string) (*LoanProduct, error) {
+	return s.repository.Get(ctx, id)
+}
+
+// UpdateProduct replaces the details of a product, the loans already proposed from it are unchanged
+func (s *ProductService) UpdateProduct(ctx context.Context, id string, details Details) (*LoanProduct, error) {
+	if err := details.Validate(); err != nil {
+		return nil, err
+	}
+
+	product, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	product.Details = details
+	product.UpdatedAt = time.Now()
+
+	if err := s.repository.Save(ctx, product); err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// DeleteProduct removes a product from the catalog, the loans proposed from it keep its ID
+func (s *ProductService) DeleteProduct(ctx context.Context, id string) error {
+	return s.repository.Delete(ctx, id)
+}
+
+func (s *ProductService) ListProducts(ctx context.Context, filter ProductFilter) (*domain.PaginatedResponse, error) {
+	filter.WithDefaults()
+	products, err := s.repository.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the total count
+	totalItems, err := s.repository.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate total pages
+	totalPages := 0
+	if filter.PageSize > 0 {
+		totalPages = int((totalItems + int64(filter.PageSize) - 1) / int64(filter.PageSize))
+	}
+
+	return &domain.PaginatedResponse{
+		Data: products,
+		Pagination: domain.PaginationInfo{
+			CurrentPage: filter.Page,
+			PageSize:    filter.PageSize,
+			TotalItems:  totalItems,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
@@ -0,0 +1,150 @@
+package product
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/memory"
+)
+
+func microLoan() product.Details {
+	return product.Details{
+		Name:              "Micro loan",
+		MinAmount:         500,
+		MaxAmount:         5000,
+		MinRate:           8,
+		MaxRate:           12,
+		MinROI:            6,
+		MaxROI:            10,
+		TenorMonths:       []int{6, 12},
+		InterestMethod:    interest.MethodAnnuity,
+		RequiredDocuments: []string{"id_card", "payslip"},
+	}
+}
+
+func TestLoanTerms(t *testing.T) {
+	p := product.NewLoanProduct(microLoan())
+
+	t.Run("should default to the first tenor and the method of the product", func(t *testing.T) {
+		terms, err := p.LoanTerms(1000, 10, 8, interest.Terms{})
+
+		require.NoError(t, err)
+		assert.Equal(t, interest.Terms{TenorMonths: 6, Method: interest.MethodAnnuity, DayCount: interest.DayCount30360}, terms)
+	})
+
+	t.Run("should keep an offered tenor and the day count", func(t *testing.T) {
+		terms, err := p.LoanTerms(5000, 12, 10, interest.Terms{TenorMonths: 12, DayCount: interest.DayCountActual365})
+
+		require.NoError(t, err)
+		assert.Equal(t, interest.Terms{TenorMonths: 12, Method: interest.MethodAnnuity, DayCount: interest.DayCountActual365}, terms)
+	})
+
+	tests := []struct {
+		name    string
+		amount  float64
+		rate    float64
+		roi     float64
+		terms   interest.Terms
+		message string
+	}{
+		{"amount below the range", 499.99, 10, 8, interest.Terms{}, "amount must be between 500 and 5000"},
+		{"amount above the range", 5000.01, 10, 8, interest.Terms{}, "amount must be between 500 and 5000"},
+		{"rate outside the range", 1000, 12.5, 8, interest.Terms{}, "rate must be between 8 and 12"},
+		{"ROI outside the range", 1000, 10, 5, interest.Terms{}, "ROI must be between 6 and 10"},
+		{"ROI not below the rate", 1000, 9, 9, interest.Terms{}, "ROI must be below the rate"},
+		{"tenor not offered", 1000, 10, 8, interest.Terms{TenorMonths: 24}, "tenor must be one of [6 12] months"},
+		{"another interest method", 1000, 10, 8, interest.Terms{Method: interest.MethodFlat}, "interest method must be annuity"},
+	}
+	for _, tt := range tests {
+		t.Run("should refuse the "+tt.name, func(t *testing.T) {
+			_, err := p.LoanTerms(tt.amount, tt.rate, tt.roi, tt.terms)
+
+			assert.ErrorIs(t, err, product.ErrNotOffered)
+			assert.ErrorContains(t, err, tt.message)
+		})
+	}
+}
+
+func TestDetailsValidate(t *testing.T) {
+	assert.NoError(t, microLoan().Validate())
+
+	tests := map[string]func(d *product.Details){
+		"zero amount":           func(d *product.Details) { d.MinAmount = 0 },
+		"negative amount":       func(d *product.Details) { d.MinAmount, d.MaxAmount = -5000, -500 },
+		"zero rate":             func(d *product.Details) { d.MinRate = 0 },
+		"rate of 100 percent":   func(d *product.Details) { d.MaxRate = 100 },
+		"negative ROI":          func(d *product.Details) { d.MinROI = -1 },
+		"ROI of 100 percent":    func(d *product.Details) { d.MaxROI = 100 },
+		"reversed amount range": func(d *product.Details) { d.MinAmount = 10000 },
+		"reversed rate range":   func(d *product.Details) { d.MaxRate = 7 },
+		"reversed ROI range":    func(d *product.Details) { d.MinROI = 11 },
+		"ROI range above rates": func(d *product.Details) { d.MinROI, d.MaxROI = 12, 14 },
+		"no tenor":              func(d *product.Details) { d.TenorMonths = nil },
+		"tenor too long":        func(d *product.Details) { d.TenorMonths = []int{12, 361} },
+		"no interest method":    func(d *product.Details) { d.InterestMethod = "" },
+	}
+	for name, change := range tests {
+		t.Run("should refuse a "+name, func(t *testing.T) {
+			details := microLoan()
+			change(&details)
+
+			assert.ErrorIs(t, details.Validate(), product.ErrInvalidProduct)
+		})
+	}
+}
+
+func TestProductService(t *testing.T) {
+	ctx := context.Background()
+	service := product.NewProductService(memory.NewProductRepository(memory.NewStore()))
+
+	created, err := service.CreateProduct(ctx, microLoan())
+	require.NoError(t, err)
+
+	t.Run("should replace the details of a product", func(t *testing.T) {
+		details := microLoan()
+		details.MaxAmount = 10000
+
+		updated, err := service.UpdateProduct(ctx, created.ID, details)
+		require.NoError(t, err)
+		assert.Equal(t, created.CreatedAt, updated.CreatedAt)
+
+		stored, err := service.GetByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 10000.0, stored.MaxAmount)
+	})
+
+	t.Run("should refuse invalid details", func(t *testing.T) {
+		details := microLoan()
+		details.TenorMonths = nil
+
+		_, err := service.CreateProduct(ctx, details)
+		assert.ErrorIs(t, err, product.ErrInvalidProduct)
+		_, err = service.UpdateProduct(ctx, created.ID, details)
+		assert.ErrorIs(t, err, product.ErrInvalidProduct)
+	})
+
+	t.Run("should filter the products by name", func(t *testing.T) {
+		name := "MICRO"
+		result, err := service.ListProducts(ctx, product.ProductFilter{Name: &name})
+		require.NoError(t, err)
+		assert.Len(t, result.Data, 1)
+		assert.Equal(t, int64(1), result.Pagination.TotalItems)
+
+		name = "mortgage"
+		result, err = service.ListProducts(ctx, product.ProductFilter{Name: &name})
+		require.NoError(t, err)
+		assert.Empty(t, result.Data)
+	})
+
+	t.Run("should delete a product", func(t *testing.T) {
+		require.NoError(t, service.DeleteProduct(ctx, created.ID))
+
+		_, err := service.GetByID(ctx, created.ID)
+		assert.ErrorIs(t, err, product.ErrProductNotFound)
+		assert.ErrorIs(t, service.DeleteProduct(ctx, created.ID), product.ErrProductNotFound)
+	})
+}
@@ -0,0 +1,180 @@
+package wallet
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAmount       = errors.New("amount must be positive")
+	ErrInsufficientBalance = errors.New("insufficient wallet balance")
+	ErrInsufficientReserve = errors.New("insufficient reserved funds")
+)
+
+type TransactionType string
+
+const (
+	TransactionDeposit  TransactionType = "deposit"
+	TransactionWithdraw TransactionType = "withdraw"
+	TransactionReserve  TransactionType = "reserve"
+	TransactionRelease  TransactionType = "release"
+	TransactionDebit    TransactionType = "debit"
+)
+
+// Wallet holds the funds of a lender. Balance is the total amount owned by the
+// lender, Reserved is the part of it committed to loans that are not yet disbursed.
+type Wallet struct {
+	ID        string    `json:"id"`
+	LenderID  string    `json:"lender_id"`
+	Balance   float64   `json:"balance"`
+	Reserved  float64   `json:"reserved"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Transaction is a single movement recorded in the wallet history
+type Transaction struct {
+	ID            string          `json:"id"`
+	WalletID      string          `json:"wallet_id"`
+	LenderID      string          `json:"lender_id"`
+	LoanID        *string         `json:"loan_id"`
+	Type          TransactionType `json:"type"`
+	Amount        float64         `json:"amount"`
+	BalanceAfter  float64         `json:"balance_after"`
+	ReservedAfter float64         `json:"reserved_after"`
+	Description   string          `json:"description"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+func NewWallet(lenderID string) *Wallet {
+	now := time.Now()
+	return &Wallet{
+		ID:        uuid.New().String(),
+		LenderID:  lenderID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Available returns the amount that can still be withdrawn or invested
+func (w *Wallet) Available() float64 {
+	return w.Balance - w.Reserved
+}
+
+func (w *Wallet) Deposit(amount float64, description string) (*Transaction, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	w.Balance += amount
+	return w.record(TransactionDeposit, nil, amount, description), nil
+}
+
+func (w *Wallet) Withdraw(amount float64, description string) (*Transaction, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	if amount > w.Available() {
+		return nil, ErrInsufficientBalance
+	}
+
+	w.Balance -= amount
+	return w.record(TransactionWithdraw, nil, amount, description), nil
+}
+
+// Reserve locks funds for an investment in a loan
+func (w *Wallet) Reserve(loanID string, amount float64) (*Transaction, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	if amount > w.Available() {
+		return nil, ErrInsufficientBalance
+	}
+
+	w.Reserved += amount
+	return w.record(TransactionReserve, &loanID, amount, "Funds reserved for loan investment"), nil
+}
+
+// Release gives reserved funds back to the available balance, e.g. when the loan is cancelled
+func (w *Wallet) Release(loanID string, amount float64, description string) (*Transaction, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	if amount > w.Reserved {
+		return nil, ErrInsufficientReserve
+	}
+
+	w.Reserved -= amount
+	return w.record(TransactionRelease, &loanID, amount, description), nil
+}
+
+// Debit converts reserved funds into an actual debit when the loan is disbursed
+func (w *Wallet) Debit(loanID string, amount float64) (*Transaction, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	if amount > w.Reserved {
+		return nil, ErrInsufficientReserve
+	}
+
+	w.Reserved -= amount
+	w.Balance -= amount
+	return w.record(TransactionDebit, &loanID, amount, "Funds disbursed to borrower"), nil
+}
+
+// Changes returns how the transaction moves the balance and the reserved funds of its wallet
+func (t *Transaction) Changes() (balance float64, reserved float64) {
+	switch t.Type {
+	case TransactionDeposit:
+		return t.Amount, 0
+	case TransactionWithdraw:
+		return -t.Amount, 0
+	case TransactionReserve:
+		return 0, t.Amount
+	case TransactionRelease:
+		return 0, -t.Amount
+	case TransactionDebit:
+		return -t.Amount, -t.Amount
+	}
+	return 0, 0
+}
+
+// ApplyTo moves the funds of w by the transaction and records the wallet after it. It refuses to
+// leave w with negative available or reserved funds, as w may have changed since the transaction
+// was made from an earlier read of it.
+func (t *Transaction) ApplyTo(w *Wallet) error {
+	balance, reserved := t.Changes()
+	if w.Reserved+reserved < 0 {
+		return ErrInsufficientReserve
+	}
+	if (w.Balance+balance)-(w.Reserved+reserved) < 0 {
+		return ErrInsufficientBalance
+	}
+
+	w.Balance += balance
+	w.Reserved += reserved
+	w.UpdatedAt = t.CreatedAt
+	t.BalanceAfter = w.Balance
+	t.ReservedAfter = w.Reserved
+	return nil
+}
+
+func (w *Wallet) record(txType TransactionType, loanID *string, amount float64, description string) *Transaction {
+	now := time.Now()
+	w.UpdatedAt = now
+
+	return &Transaction{
+		ID:            uuid.New().String(),
+		WalletID:      w.ID,
+		LenderID:      w.LenderID,
+		LoanID:        loanID,
+		Type:          txType,
+		Amount:        amount,
+		BalanceAfter:  w.Balance,
+		ReservedAfter: w.Reserved,
+		Description:   description,
+		CreatedAt:     now,
+	}
+}
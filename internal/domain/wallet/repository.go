@@ -0,0 +1,44 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrWalletNotFound is returned for a lender who has never deposited funds
+var ErrWalletNotFound = errors.New("wallet not found")
+
+// Repository defines the data access interface for lender wallets
+type Repository interface {
+	// GetByLenderID returns the wallet of a lender, or ErrWalletNotFound before its first deposit
+	GetByLenderID(ctx context.Context, lenderID string) (*Wallet, error)
+	// Create stores an empty wallet, unless the lender already has one
+	Create(ctx context.Context, wallet *Wallet) error
+	// Apply applies the transaction to the stored wallet, as it is when the transaction is stored,
+	// and records it. The wallet is updated to the stored one. It returns ErrInsufficientBalance or
+	// ErrInsufficientReserve when the stored wallet no longer has the funds.
+	Apply(ctx context.Context, wallet *Wallet, transaction *Transaction) error
+	ListTransactions(ctx context.Context, filter TransactionFilter) ([]*Transaction, error)
+	CountTransactions(ctx context.Context, filter TransactionFilter) (int64, error)
+}
+
+type TransactionFilter struct {
+	LenderID *string
+	LoanID   *string
+	Type     *TransactionType
+	From     *time.Time
+	To       *time.Time
+	Page     int
+	PageSize int
+}
+
+func (f *TransactionFilter) WithDefaults() *TransactionFilter {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	if f.PageSize <= 0 {
+		f.PageSize = 10
+	}
+	return f
+}
@@ -0,0 +1,109 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+)
+
+type WalletService struct {
+	repository       Repository
+	lenderRepository lender.Repository
+}
+
+func NewWalletService(r Repository, l lender.Repository) *WalletService {
+	return &WalletService{
+		repository:       r,
+		lenderRepository: l,
+	}
+}
+
+func (s *WalletService) GetByLenderID(ctx context.Context, lenderID string) (*Wallet, error) {
+	return s.repository.GetByLenderID(ctx, lenderID)
+}
+
+// Deposit adds funds to the wallet of a lender, which is created on the first deposit
+func (s *WalletService) Deposit(ctx context.Context, lenderID string, amount float64, description string) (*Transaction, error) {
+	wallet, err := s.repository.GetByLenderID(ctx, lenderID)
+	if errors.Is(err, ErrWalletNotFound) {
+		wallet, err = s.createWallet(ctx, lenderID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := wallet.Deposit(amount, description)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.Apply(ctx, wallet, transaction); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+func (s *WalletService) Withdraw(ctx context.Context, lenderID string, amount float64, description string) (*Transaction, error) {
+	wallet, err := s.repository.GetByLenderID(ctx, lenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := wallet.Withdraw(amount, description)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.Apply(ctx, wallet, transaction); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// createWallet stores an empty wallet for an existing lender and returns the lender's wallet, which
+// a concurrent deposit may have created first
+func (s *WalletService) createWallet(ctx context.Context, lenderID string) (*Wallet, error) {
+	if _, err := s.lenderRepository.Get(ctx, lenderID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.Create(ctx, NewWallet(lenderID)); err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetByLenderID(ctx, lenderID)
+}
+
+func (s *WalletService) ListTransactions(ctx context.Context, filter TransactionFilter) (*domain.PaginatedResponse, error) {
+	filter.WithDefaults()
+	transactions, err := s.repository.ListTransactions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the total count
+	totalItems, err := s.repository.CountTransactions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate total pages
+	totalPages := 0
+	if filter.PageSize > 0 {
+		totalPages = int((totalItems + int64(filter.PageSize) - 1) / int64(filter.PageSize))
+	}
+
+	return &domain.PaginatedResponse{
+		Data: transactions,
+		Pagination: domain.PaginationInfo{
+			CurrentPage: filter.Page,
+			PageSize:    filter.PageSize,
+			TotalItems:  totalItems,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
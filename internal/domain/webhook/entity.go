@@ -0,0 +1,173 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusSucceeded DeliveryStatus = "succeeded"
+	DeliveryStatusFailed    DeliveryStatus = "failed"    // Gave up after MaxAttempts
+	DeliveryStatusAbandoned DeliveryStatus = "abandoned" // Not sent, the subscription was deactivated
+)
+
+const (
+	// MaxAttempts is the number of deliveries tried before a delivery is marked failed
+	MaxAttempts = 8
+
+	baseBackoff = 5 * time.Second
+	maxBackoff  = time.Hour
+)
+
+// Subscription registers a partner URL to receive loan events
+type Subscription struct {
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"` // Empty subscribes to every event
+	Secret     string    `json:"-"`           // Only revealed when the subscription is created
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Delivery is one event sent to one subscription, with the outcome of the last attempt
+type Delivery struct {
+	ID             string          `json:"id"`
+	SubscriptionID string          `json:"subscription_id"`
+	MessageID      string          `json:"message_id"` // Outbox message the delivery was created from
+	RedeliveryOf   *string         `json:"redelivery_of"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	Status         DeliveryStatus  `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseCode   *int            `json:"response_code"`
+	LastError      *string         `json:"last_error"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+func NewSubscription(url string, eventTypes []string, secret string) (*Subscription, error) {
+	if secret == "" {
+		generated, err := generateSecret()
+		if err != nil {
+			return nil, err
+		}
+		secret = generated
+	}
+
+	now := time.Now()
+	return &Subscription{
+		ID:         uuid.New().String(),
+		URL:        url,
+		EventTypes: eventTypes,
+		Secret:     secret,
+		Active:     true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// Matches reports whether the subscription wants to receive the event type
+func (s *Subscription) Matches(eventType string) bool {
+	if !s.Active {
+		return false
+	}
+	if len(s.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func NewDelivery(subscriptionID, messageID, eventType string, payload json.RawMessage) *Delivery {
+	now := time.Now()
+	return &Delivery{
+		ID:             uuid.New().String(),
+		SubscriptionID: subscriptionID,
+		MessageID:      messageID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         DeliveryStatusPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// Redeliver creates a fresh delivery of the same payload, keeping the original in the log
+func (d *Delivery) Redeliver() *Delivery {
+	delivery := NewDelivery(d.SubscriptionID, d.MessageID, d.EventType, d.Payload)
+	delivery.RedeliveryOf = &d.ID
+	return delivery
+}
+
+// MarkSucceeded records an attempt answered with a 2xx status code
+func (d *Delivery) MarkSucceeded(now time.Time, responseCode int) {
+	d.Attempts++
+	d.Status = DeliveryStatusSucceeded
+	d.ResponseCode = &responseCode
+	d.LastError = nil
+	d.DeliveredAt = &now
+	d.UpdatedAt = now
+}
+
+// MarkFailed records a failed attempt and schedules a retry with exponential backoff.
+// responseCode is 0 when the receiver could not be reached.
+func (d *Delivery) MarkFailed(now time.Time, responseCode int, err error) {
+	d.Attempts++
+	d.ResponseCode = nil
+	if responseCode != 0 {
+		d.ResponseCode = &responseCode
+	}
+	errMsg := err.Error()
+	d.LastError = &errMsg
+	d.UpdatedAt = now
+
+	if d.Attempts >= MaxAttempts {
+		d.Status = DeliveryStatusFailed
+		return
+	}
+
+	d.NextAttemptAt = now.Add(Backoff(d.Attempts))
+}
+
+// MarkAbandoned gives up on the delivery without attempting it, its attempts are left as they are
+func (d *Delivery) MarkAbandoned(now time.Time, reason error) {
+	d.Status = DeliveryStatusAbandoned
+	reasonMsg := reason.Error()
+	d.LastError = &reasonMsg
+	d.UpdatedAt = now
+}
+
+// Backoff returns the delay before retrying after the given number of attempts
+func Backoff(attempts int) time.Duration {
+	delay := baseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return delay
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
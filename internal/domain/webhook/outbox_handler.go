@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/outbox"
+)
+
+// Envelope is the JSON body posted to the subscribers
+type Envelope struct {
+	ID          string          `json:"id"` // Stable across redeliveries, receivers can use it to deduplicate
+	Type        string          `json:"type"`
+	AggregateID string          `json:"aggregate_id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Data        json.RawMessage `json:"data"`
+}
+
+// OutboxHandler queues a delivery of every outbox message for each matching subscription.
+// Sending happens in the Worker so a slow partner does not hold up the outbox.
+type OutboxHandler struct {
+	repository Repository
+}
+
+var _ outbox.Handler = (*OutboxHandler)(nil)
+
+func NewOutboxHandler(r Repository) *OutboxHandler {
+	return &OutboxHandler{
+		repository: r,
+	}
+}
+
+func (h *OutboxHandler) Name() string {
+	return "webhooks"
+}
+
+func (h *OutboxHandler) Handle(ctx context.Context, message *outbox.Message) error {
+	active := true
+	filter := SubscriptionFilter{Active: &active}
+
+	count, err := h.repository.CountSubscriptions(ctx, filter)
+	if err != nil || count == 0 {
+		return err
+	}
+
+	filter.PageSize = int(count)
+	subscriptions, err := h.repository.ListSubscriptions(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(Envelope{
+		ID:          message.ID,
+		Type:        message.EventType,
+		AggregateID: message.AggregateID,
+		OccurredAt:  message.OccurredAt,
+		Data:        message.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(message.EventType) {
+			continue
+		}
+
+		// Redelivered outbox messages are ignored by the repository
+		delivery := NewDelivery(subscription.ID, message.ID, message.EventType, payload)
+		if err := h.repository.CreateDelivery(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
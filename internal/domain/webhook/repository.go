@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"context"
+)
+
+// Repository defines the data access interface for webhook subscriptions and deliveries
+type Repository interface {
+	GetSubscription(ctx context.Context, id string) (*Subscription, error)
+	CreateSubscription(ctx context.Context, subscription *Subscription) error
+	SaveSubscription(ctx context.Context, subscription *Subscription) error
+	ListSubscriptions(ctx context.Context, filter SubscriptionFilter) ([]*Subscription, error)
+	CountSubscriptions(ctx context.Context, filter SubscriptionFilter) (int64, error)
+
+	GetDelivery(ctx context.Context, id string) (*Delivery, error)
+	// CreateDelivery ignores a delivery of a message the subscription has already received
+	CreateDelivery(ctx context.Context, delivery *Delivery) error
+	SaveDelivery(ctx context.Context, delivery *Delivery) error
+	ListDeliveries(ctx context.Context, filter DeliveryFilter) ([]*Delivery, error)
+	CountDeliveries(ctx context.Context, filter DeliveryFilter) (int64, error)
+	// FetchDueDeliveries returns up to limit pending deliveries whose next attempt is due
+	FetchDueDeliveries(ctx context.Context, limit int) ([]*Delivery, error)
+}
+
+type SubscriptionFilter struct {
+	Active   *bool
+	Page     int
+	PageSize int
+}
+
+func (f *SubscriptionFilter) WithDefaults() *SubscriptionFilter {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	if f.PageSize <= 0 {
+		f.PageSize = 10
+	}
+	return f
+}
+
+type DeliveryFilter struct {
+	SubscriptionID *string
+	Status         *DeliveryStatus
+	EventType      *string
+	Page           int
+	PageSize       int
+}
+
+func (f *DeliveryFilter) WithDefaults() *DeliveryFilter {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	if f.PageSize <= 0 {
+		f.PageSize = 10
+	}
+	return f
+}
@@ -0,0 +1,142 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+var (
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrDeliveryNotFound     = errors.New("webhook delivery not found")
+	ErrInvalidURL           = errors.New("webhook URL must be an absolute http or https URL")
+	ErrInvalidEventType     = errors.New("unknown event type")
+)
+
+type WebhookService struct {
+	repository Repository
+	worker     *Worker
+}
+
+func NewWebhookService(r Repository, w *Worker) *WebhookService {
+	return &WebhookService{
+		repository: r,
+		worker:     w,
+	}
+}
+
+// CreateSubscription registers a URL for the given event types. A secret is generated when none is given.
+func (s *WebhookService) CreateSubscription(ctx context.Context, rawURL string, eventTypes []string, secret string) (*Subscription, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, ErrInvalidURL
+	}
+
+	for _, eventType := range eventTypes {
+		if !loan.IsValidEventType(eventType) {
+			return nil, errors.Join(ErrInvalidEventType, errors.New(eventType))
+		}
+	}
+
+	subscription, err := NewSubscription(rawURL, eventTypes, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.CreateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+// DeactivateSubscription stops deliveries to a subscription, its delivery log is kept
+func (s *WebhookService) DeactivateSubscription(ctx context.Context, id string) (*Subscription, error) {
+	subscription, err := s.repository.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.Active = false
+	subscription.UpdatedAt = time.Now()
+
+	if err := s.repository.SaveSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (s *WebhookService) ListSubscriptions(ctx context.Context, filter SubscriptionFilter) (*domain.PaginatedResponse, error) {
+	filter.WithDefaults()
+	subscriptions, err := s.repository.ListSubscriptions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the total count
+	totalItems, err := s.repository.CountSubscriptions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return paginate(subscriptions, filter.Page, filter.PageSize, totalItems), nil
+}
+
+func (s *WebhookService) ListDeliveries(ctx context.Context, filter DeliveryFilter) (*domain.PaginatedResponse, error) {
+	filter.WithDefaults()
+	deliveries, err := s.repository.ListDeliveries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the total count
+	totalItems, err := s.repository.CountDeliveries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return paginate(deliveries, filter.Page, filter.PageSize, totalItems), nil
+}
+
+// Redeliver sends the payload of a past delivery again right away as a new delivery.
+// The returned delivery holds the outcome, a failed attempt is retried by the worker.
+func (s *WebhookService) Redeliver(ctx context.Context, deliveryID string) (*Delivery, error) {
+	original, err := s.repository.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := original.Redeliver()
+	if err := s.repository.SaveDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	if err := s.worker.Attempt(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func paginate(data any, page, pageSize int, totalItems int64) *domain.PaginatedResponse {
+	// Calculate total pages
+	totalPages := 0
+	if pageSize > 0 {
+		totalPages = int((totalItems + int64(pageSize) - 1) / int64(pageSize))
+	}
+
+	return &domain.PaginatedResponse{
+		Data: data,
+		Pagination: domain.PaginationInfo{
+			CurrentPage: page,
+			PageSize:    pageSize,
+			TotalItems:  totalItems,
+			TotalPages:  totalPages,
+		},
+	}
+}
@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/outbox"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/webhook"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/test/mocks"
+)
+
+// receiver is a local partner endpoint verifying the signature of every request
+type receiver struct {
+	secret     string
+	statusCode int
+	verified   []bool
+	events     []string
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	timestamp, _ := strconv.ParseInt(req.Header.Get(webhook.HeaderTimestamp), 10, 64)
+
+	r.verified = append(r.verified, webhook.Verify(r.secret, req.Header.Get(webhook.HeaderSignature), timestamp, body))
+	r.events = append(r.events, req.Header.Get(webhook.HeaderEvent))
+	w.WriteHeader(r.statusCode)
+}
+
+func TestWorkerAttempt(t *testing.T) {
+	setup := func(statusCode int) (*receiver, *webhook.Subscription, *mocks.MockWebhookRepository, *webhook.Worker) {
+		rcv := &receiver{secret: "whsec_test", statusCode: statusCode}
+		server := httptest.NewServer(rcv)
+		t.Cleanup(server.Close)
+
+		subscription, _ := webhook.NewSubscription(server.URL, nil, rcv.secret)
+
+		mockRepo := mocks.NewMockWebhookRepository()
+		mockRepo.On("GetSubscription", mock.Anything, subscription.ID).Return(subscription, nil)
+		mockRepo.On("SaveDelivery", mock.Anything, mock.Anything).Return(nil)
+
+		worker := webhook.NewWorker(mockRepo, webhook.NewSenderWithClient(server.Client()))
+
+		return rcv, subscription, mockRepo, worker
+	}
+
+	t.Run("should deliver a signed payload", func(t *testing.T) {
+		rcv, subscription, mockRepo, worker := setup(http.StatusOK)
+
+		delivery := webhook.NewDelivery(subscription.ID, "msg-1", "LoanApproved", json.RawMessage(`{"id":"msg-1"}`))
+
+		// Execute
+		err := worker.Attempt(context.Background(), delivery)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{true}, rcv.verified)
+		assert.Equal(t, []string{"LoanApproved"}, rcv.events)
+		assert.Equal(t, webhook.DeliveryStatusSucceeded, delivery.Status)
+		assert.Equal(t, http.StatusOK, *delivery.ResponseCode)
+		assert.Equal(t, 1, delivery.Attempts)
+		mockRepo.AssertCalled(t, "SaveDelivery", mock.Anything, delivery)
+	})
+
+	t.Run("should record the response code and retry later on failure", func(t *testing.T) {
+		_, subscription, _, worker := setup(http.StatusInternalServerError)
+
+		delivery := webhook.NewDelivery(subscription.ID, "msg-1", "LoanApproved", json.RawMessage(`{"id":"msg-1"}`))
+
+		// Execute
+		before := time.Now()
+		err := worker.Attempt(context.Background(), delivery)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, webhook.DeliveryStatusPending, delivery.Status)
+		assert.Equal(t, http.StatusInternalServerError, *delivery.ResponseCode)
+		assert.NotNil(t, delivery.LastError)
+		assert.True(t, delivery.NextAttemptAt.After(before))
+	})
+
+	t.Run("should abandon the deliveries of a deactivated subscription", func(t *testing.T) {
+		rcv, subscription, _, worker := setup(http.StatusOK)
+		subscription.Active = false
+
+		delivery := webhook.NewDelivery(subscription.ID, "msg-1", "LoanApproved", json.RawMessage(`{"id":"msg-1"}`))
+		delivery.Attempts = 2
+
+		// Execute
+		err := worker.Attempt(context.Background(), delivery)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, rcv.events)
+		assert.Equal(t, webhook.DeliveryStatusAbandoned, delivery.Status)
+		assert.Equal(t, 2, delivery.Attempts)
+		assert.Equal(t, "subscription is no longer active", *delivery.LastError)
+	})
+
+	t.Run("should reject a payload signed with another secret", func(t *testing.T) {
+		rcv, subscription, _, worker := setup(http.StatusOK)
+		subscription.Secret = "whsec_other"
+
+		delivery := webhook.NewDelivery(subscription.ID, "msg-1", "LoanApproved", json.RawMessage(`{"id":"msg-1"}`))
+
+		// Execute
+		_ = worker.Attempt(context.Background(), delivery)
+
+		// Assert
+		assert.Equal(t, []bool{false}, rcv.verified)
+	})
+}
+
+func TestOutboxHandler(t *testing.T) {
+	t.Run("should queue deliveries for matching subscriptions only", func(t *testing.T) {
+		mockRepo := mocks.NewMockWebhookRepository()
+
+		all, _ := webhook.NewSubscription("https://partner-a.example.com/hook", nil, "secret-a")
+		approvals, _ := webhook.NewSubscription("https://partner-b.example.com/hook", []string{"LoanApproved"}, "secret-b")
+		disbursements, _ := webhook.NewSubscription("https://partner-c.example.com/hook", []string{"LoanDisbursed"}, "secret-c")
+
+		mockRepo.On("CountSubscriptions", mock.Anything, mock.Anything).Return(int64(3), nil)
+		mockRepo.On("ListSubscriptions", mock.Anything, mock.Anything).Return([]*webhook.Subscription{all, approvals, disbursements}, nil)
+		mockRepo.On("CreateDelivery", mock.Anything, mock.Anything).Return(nil)
+
+		handler := webhook.NewOutboxHandler(mockRepo)
+
+		message := &outbox.Message{
+			ID:          "msg-1",
+			AggregateID: "loan-123",
+			EventType:   "LoanApproved",
+			Payload:     json.RawMessage(`{"approved_by":"employee-123"}`),
+		}
+
+		// Execute
+		err := handler.Handle(context.Background(), message)
+
+		// Assert
+		assert.NoError(t, err)
+		mockRepo.AssertNumberOfCalls(t, "CreateDelivery", 2)
+
+		delivery := mockRepo.Calls[2].Arguments.Get(1).(*webhook.Delivery)
+		var envelope webhook.Envelope
+		assert.NoError(t, json.Unmarshal(delivery.Payload, &envelope))
+		assert.Equal(t, "msg-1", envelope.ID)
+		assert.Equal(t, "loan-123", envelope.AggregateID)
+		assert.JSONEq(t, `{"approved_by":"employee-123"}`, string(envelope.Data))
+	})
+}
@@ -0,0 +1,137 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultBatchSize    = 20
+)
+
+var errSubscriptionInactive = errors.New("subscription is no longer active")
+
+// Worker delivers pending webhook deliveries and retries the failed ones with backoff
+type Worker struct {
+	repository   Repository
+	sender       *Sender
+	pollInterval time.Duration
+	batchSize    int
+
+	stop    chan struct{}
+	wg      sync.WaitGroup
+	running atomic.Bool
+}
+
+func NewWorker(r Repository, sender *Sender) *Worker {
+	return &Worker{
+		repository:   r,
+		sender:       sender,
+		pollInterval: defaultPollInterval,
+		batchSize:    defaultBatchSize,
+	}
+}
+
+// Start begins delivering in the background
+func (w *Worker) Start() {
+	w.stop = make(chan struct{})
+	w.wg.Add(1)
+	w.running.Store(true)
+
+	go func() {
+		defer w.wg.Done()
+		defer w.running.Store(false)
+
+		ticker := time.NewTicker(w.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+				if _, err := w.DeliverDue(context.Background()); err != nil {
+					zap.L().Error("Webhook delivery failed", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Running tells whether the background loop is started and not stopped
+func (w *Worker) Running() bool {
+	return w.running.Load()
+}
+
+// Stop waits for the current batch to finish, or for ctx to be done
+func (w *Worker) Stop(ctx context.Context) error {
+	if w.stop == nil {
+		return nil
+	}
+	close(w.stop)
+
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// DeliverDue attempts one batch of due deliveries and returns how many succeeded
+func (w *Worker) DeliverDue(ctx context.Context) (int, error) {
+	deliveries, err := w.repository.FetchDueDeliveries(ctx, w.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	succeeded := 0
+	for _, delivery := range deliveries {
+		if err := w.Attempt(ctx, delivery); err != nil {
+			return succeeded, err
+		}
+		if delivery.Status == DeliveryStatusSucceeded {
+			succeeded++
+		}
+	}
+
+	return succeeded, nil
+}
+
+// Attempt sends the delivery once and records the outcome.
+// The returned error is about recording the outcome, a failed send is recorded on the delivery.
+func (w *Worker) Attempt(ctx context.Context, delivery *Delivery) error {
+	subscription, err := w.repository.GetSubscription(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return err
+	}
+
+	if !subscription.Active {
+		delivery.MarkAbandoned(time.Now(), errSubscriptionInactive)
+		return w.repository.SaveDelivery(ctx, delivery)
+	}
+
+	responseCode, sendErr := w.sender.Send(ctx, subscription, delivery)
+	if sendErr != nil {
+		delivery.MarkFailed(time.Now(), responseCode, sendErr)
+		logging.FromContext(ctx).Warn("Webhook delivery attempt failed",
+			zap.String("delivery_id", delivery.ID), zap.String("url", subscription.URL), zap.Int("attempt", delivery.Attempts), zap.Error(sendErr))
+	} else {
+		delivery.MarkSucceeded(time.Now(), responseCode)
+	}
+
+	return w.repository.SaveDelivery(ctx, delivery)
+}
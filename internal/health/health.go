@@ -0,0 +1,87 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	StatusOK       = "ok"
+	StatusReady    = "ready"
+	StatusNotReady = "not ready"
+)
+
+// Check reports why a dependency of the service is not usable, or nil when it is
+type Check func(ctx context.Context) error
+
+// Checker tells whether the service can take traffic: it is not shutting down and all the
+// registered checks pass
+type Checker struct {
+	shuttingDown atomic.Bool
+
+	mu     sync.Mutex
+	names  []string
+	checks map[string]Check
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		checks: map[string]Check{},
+	}
+}
+
+// Add registers a check, replacing the check with the same name
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.checks[name]; !ok {
+		c.names = append(c.names, name)
+	}
+	c.checks[name] = check
+}
+
+// SetShuttingDown marks the service not ready for good, so load balancers stop sending
+// requests while the in-flight ones are drained
+func (c *Checker) SetShuttingDown() {
+	c.shuttingDown.Store(true)
+}
+
+// Report is the result of the readiness checks, by check name
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+func (r Report) Ready() bool {
+	return r.Status == StatusReady
+}
+
+// Ready runs every check, in the order they were added
+func (c *Checker) Ready(ctx context.Context) Report {
+	c.mu.Lock()
+	names := append([]string(nil), c.names...)
+	checks := make(map[string]Check, len(c.checks))
+	for name, check := range c.checks {
+		checks[name] = check
+	}
+	c.mu.Unlock()
+
+	report := Report{Status: StatusReady, Checks: make(map[string]string, len(names)+1)}
+	if c.shuttingDown.Load() {
+		report.Status = StatusNotReady
+		report.Checks["shutdown"] = "shutting down"
+	}
+
+	for _, name := range names {
+		if err := checks[name](ctx); err != nil {
+			report.Status = StatusNotReady
+			report.Checks[name] = err.Error()
+			continue
+		}
+		report.Checks[name] = StatusOK
+	}
+
+	return report
+}
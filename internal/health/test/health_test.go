@@ -0,0 +1,50 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/health"
+)
+
+func TestChecker(t *testing.T) {
+	ctx := context.Background()
+	passing := func(ctx context.Context) error { return nil }
+
+	t.Run("should be ready when every check passes", func(t *testing.T) {
+		checker := health.NewChecker()
+		checker.Add("database", passing)
+		checker.Add("outbox_dispatcher", passing)
+
+		report := checker.Ready(ctx)
+
+		assert.True(t, report.Ready())
+		assert.Equal(t, map[string]string{"database": health.StatusOK, "outbox_dispatcher": health.StatusOK}, report.Checks)
+	})
+
+	t.Run("should report the failing check", func(t *testing.T) {
+		checker := health.NewChecker()
+		checker.Add("database", passing)
+		checker.Add("migrations", func(ctx context.Context) error { return errors.New("pending migrations") })
+
+		report := checker.Ready(ctx)
+
+		assert.False(t, report.Ready())
+		assert.Equal(t, health.StatusNotReady, report.Status)
+		assert.Equal(t, health.StatusOK, report.Checks["database"])
+		assert.Equal(t, "pending migrations", report.Checks["migrations"])
+	})
+
+	t.Run("should not be ready once shutting down", func(t *testing.T) {
+		checker := health.NewChecker()
+		checker.Add("database", passing)
+
+		checker.SetShuttingDown()
+		report := checker.Ready(ctx)
+
+		assert.False(t, report.Ready())
+		assert.Equal(t, "shutting down", report.Checks["shutdown"])
+	})
+}
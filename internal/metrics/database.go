@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository"
+	"gorm.io/gorm"
+)
+
+const startedKey = "metrics:started"
+
+// InstrumentDatabase records the duration of every statement run by the repositories with
+// GORM callbacks, so the repositories do not time their queries themselves
+func (m *Metrics) InstrumentDatabase(db *gorm.DB) error {
+	callbacks := db.Callback()
+	return errors.Join(
+		callbacks.Create().Before("gorm:create").Register("metrics:before_create", startTimer),
+		callbacks.Create().After("gorm:create").Register("metrics:after_create", m.observeQuery("create")),
+		callbacks.Query().Before("gorm:query").Register("metrics:before_query", startTimer),
+		callbacks.Query().After("gorm:query").Register("metrics:after_query", m.observeQuery("query")),
+		callbacks.Update().Before("gorm:update").Register("metrics:before_update", startTimer),
+		callbacks.Update().After("gorm:update").Register("metrics:after_update", m.observeQuery("update")),
+		callbacks.Delete().Before("gorm:delete").Register("metrics:before_delete", startTimer),
+		callbacks.Delete().After("gorm:delete").Register("metrics:after_delete", m.observeQuery("delete")),
+		callbacks.Row().Before("gorm:row").Register("metrics:before_row", startTimer),
+		callbacks.Row().After("gorm:row").Register("metrics:after_row", m.observeQuery("row")),
+		callbacks.Raw().Before("gorm:raw").Register("metrics:before_raw", startTimer),
+		callbacks.Raw().After("gorm:raw").Register("metrics:after_raw", m.observeQuery("raw")),
+	)
+}
+
+func startTimer(db *gorm.DB) {
+	db.InstanceSet(startedKey, time.Now())
+}
+
+func (m *Metrics) observeQuery(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(startedKey)
+		if !ok {
+			return
+		}
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+		m.queryDuration.WithLabelValues(table, operation).Observe(time.Since(value.(time.Time)).Seconds())
+	}
+}
+
+// retriesCollector exposes the retry counters of the transaction executor
+type retriesCollector struct {
+	executor *repository.TxExecutor
+	retries  *prometheus.Desc
+}
+
+// NewRetriesCollector reads the retries of the transactions, by operation, on each scrape
+func NewRetriesCollector(executor *repository.TxExecutor) prometheus.Collector {
+	return &retriesCollector{
+		executor: executor,
+		retries: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "transaction_retries_total"),
+			"Transactions retried after a retryable error, by operation.",
+			[]string{"operation"}, nil,
+		),
+	}
+}
+
+func (c *retriesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.retries
+}
+
+func (c *retriesCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, counter := range c.executor.Retries() {
+		ch <- prometheus.MustNewConstMetric(c.retries, prometheus.CounterValue, float64(counter.Retries), counter.Operation)
+	}
+}
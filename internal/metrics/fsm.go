@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/looplab/fsm"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+const afterEvent = "after_event"
+
+// instrumentedRegistrar wraps the callbacks of the loan state machine, so the events and the
+// time spent in every callback are recorded without the callbacks knowing about it
+type instrumentedRegistrar struct {
+	registrar loan.CallbackRegistrar
+	metrics   *Metrics
+}
+
+// InstrumentCallbacks records the outcome of every loan state machine event, and the duration
+// of its callbacks
+func (m *Metrics) InstrumentCallbacks(registrar loan.CallbackRegistrar) loan.CallbackRegistrar {
+	return &instrumentedRegistrar{
+		registrar: registrar,
+		metrics:   m,
+	}
+}
+
+func (r *instrumentedRegistrar) GetCallbacks() fsm.Callbacks {
+	callbacks := fsm.Callbacks{}
+	for name, callback := range r.registrar.GetCallbacks() {
+		callbacks[name] = r.wrap(name, callback)
+	}
+
+	// after_event runs last, once the after_<event> callbacks had their chance to cancel
+	next := callbacks[afterEvent]
+	callbacks[afterEvent] = func(ctx context.Context, e *fsm.Event) {
+		if next != nil {
+			next(ctx, e)
+		}
+		if e.Err == nil {
+			r.metrics.fsmEvents.WithLabelValues(e.Event, OutcomeSucceeded, "").Inc()
+		}
+	}
+
+	return callbacks
+}
+
+// wrap times the callback, and counts the event as cancelled when the callback cancels it
+func (r *instrumentedRegistrar) wrap(name string, callback fsm.Callback) fsm.Callback {
+	return func(ctx context.Context, e *fsm.Event) {
+		cancelled := e.Err != nil
+		started := time.Now()
+
+		callback(ctx, e)
+
+		r.metrics.fsmCallbackDuration.WithLabelValues(name).Observe(time.Since(started).Seconds())
+		if !cancelled && e.Err != nil {
+			r.metrics.fsmEvents.WithLabelValues(e.Event, OutcomeCancelled, Reason(e.Err)).Inc()
+		}
+	}
+}
+
+// Reason keeps the fixed part of an error message, before the details of the underlying
+// error, so the reason label only takes a few values
+func Reason(err error) string {
+	reason, _, _ := strings.Cut(err.Error(), ": ")
+	return reason
+}
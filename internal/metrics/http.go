@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Middleware records the duration of the HTTP requests by route template, such as
+// /api/v1/loans/:id/:status, rather than by path, so the loan IDs do not become labels
+func (m *Metrics) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			started := time.Now()
+
+			err := next(c)
+			if err != nil {
+				// Let Echo write the error response, so its status code is recorded
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = "unmatched"
+			}
+			code := strconv.Itoa(c.Response().Status)
+			m.httpDuration.WithLabelValues(c.Request().Method, route, code).Observe(time.Since(started).Seconds())
+
+			return nil
+		}
+	}
+}
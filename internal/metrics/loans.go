@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+const collectTimeout = 5 * time.Second
+
+// loanCollector reads the loan totals from the repository on each scrape, so they are right
+// after a restart and with several instances of the service
+type loanCollector struct {
+	repository      loan.Repository
+	loans           *prometheus.Desc
+	fundedAmount    *prometheus.Desc
+	disbursedAmount *prometheus.Desc
+}
+
+// NewLoanCollector exposes the number of loans per status and the total funded and disbursed amounts
+func NewLoanCollector(r loan.Repository) prometheus.Collector {
+	return &loanCollector{
+		repository: r,
+		loans: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "loans"),
+			"Loans by status.",
+			[]string{"status"}, nil,
+		),
+		fundedAmount: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "funded_amount"),
+			"Total principal of the fully invested loans, disbursed or not.",
+			nil, nil,
+		),
+		disbursedAmount: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "disbursed_amount"),
+			"Total principal of the disbursed loans.",
+			nil, nil,
+		),
+	}
+}
+
+func (c *loanCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.loans
+	ch <- c.fundedAmount
+	ch <- c.disbursedAmount
+}
+
+func (c *loanCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	byStatus, err := c.repository.SumByStatus(ctx, "")
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.loans, err)
+		return
+	}
+
+	// Every status is reported, so a status without loans shows 0 instead of disappearing
+	counts := map[loan.Status]int64{}
+	var funded, disbursed float64
+	for _, summary := range byStatus {
+		counts[summary.Status] = summary.Count
+		switch summary.Status {
+		case loan.StatusInvested:
+			funded += summary.Amount
+		case loan.StatusDisbursed:
+			funded += summary.Amount
+			disbursed += summary.Amount
+		}
+	}
+
+	for _, status := range loan.Statuses {
+		ch <- prometheus.MustNewConstMetric(c.loans, prometheus.GaugeValue, float64(counts[status]), string(status))
+	}
+	ch <- prometheus.MustNewConstMetric(c.fundedAmount, prometheus.GaugeValue, funded)
+	ch <- prometheus.MustNewConstMetric(c.disbursedAmount, prometheus.GaugeValue, disbursed)
+}
@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "loan_service"
+
+// Outcomes of a state machine event
+const (
+	OutcomeSucceeded = "succeeded"
+	OutcomeCancelled = "cancelled"
+)
+
+// Metrics holds the collectors of the service, exposed in the Prometheus format on /metrics.
+// They are registered on their own registry, so several instances can live side by side in tests.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	fsmEvents           *prometheus.CounterVec
+	fsmCallbackDuration *prometheus.HistogramVec
+	httpDuration        *prometheus.HistogramVec
+	queryDuration       *prometheus.HistogramVec
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		fsmEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "fsm_events_total",
+			Help:      "Loan state machine events by outcome, with the reason of the cancelled ones.",
+		}, []string{"event", "outcome", "reason"}),
+		fsmCallbackDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "fsm_callback_duration_seconds",
+			Help:      "Duration of the loan state machine callbacks.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"callback"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Duration of the HTTP requests by route and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "code"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "repository_query_duration_seconds",
+			Help:      "Duration of the database statements of the repositories by table and operation.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"table", "operation"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.fsmEvents,
+		m.fsmCallbackDuration,
+		m.httpDuration,
+		m.queryDuration,
+	)
+	return m
+}
+
+// Register adds collectors reading their values on each scrape, such as the loan totals
+func (m *Metrics) Register(collector prometheus.Collector) error {
+	return m.registry.Register(collector)
+}
+
+// Handler serves the metrics. A collector failing to read its values does not hide the others.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
+		ErrorHandling: promhttp.ContinueOnError,
+	})
+}
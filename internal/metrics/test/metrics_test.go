@@ -0,0 +1,99 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/looplab/fsm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/metrics"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/memory"
+)
+
+// approveRegistrar cancels the approval when the loan has no approver
+type approveRegistrar struct{}
+
+func (approveRegistrar) GetCallbacks() fsm.Callbacks {
+	return fsm.Callbacks{
+		"before_" + loan.EventApprove: func(ctx context.Context, e *fsm.Event) {
+			if e.Args[0].(string) == "" {
+				e.Cancel(errors.New("employee not found: no approver given"))
+			}
+		},
+	}
+}
+
+func scrape(t *testing.T, m *metrics.Metrics) string {
+	recorder := httptest.NewRecorder()
+	m.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestInstrumentCallbacks(t *testing.T) {
+	t.Run("should count the events by outcome and reason", func(t *testing.T) {
+		m := metrics.NewMetrics()
+		registrar := m.InstrumentCallbacks(approveRegistrar{})
+		events := fsm.Events{{Name: loan.EventApprove, Src: []string{string(loan.StatusProposed)}, Dst: string(loan.StatusApproved)}}
+
+		err := fsm.NewFSM(string(loan.StatusProposed), events, registrar.GetCallbacks()).Event(context.Background(), loan.EventApprove, "")
+		assert.Error(t, err)
+		err = fsm.NewFSM(string(loan.StatusProposed), events, registrar.GetCallbacks()).Event(context.Background(), loan.EventApprove, "employee-1")
+		assert.NoError(t, err)
+
+		body := scrape(t, m)
+		assert.Contains(t, body, `loan_service_fsm_events_total{event="approve",outcome="cancelled",reason="employee not found"} 1`)
+		assert.Contains(t, body, `loan_service_fsm_events_total{event="approve",outcome="succeeded",reason=""} 1`)
+		assert.Contains(t, body, `loan_service_fsm_callback_duration_seconds_count{callback="before_approve"} 2`)
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("should record the requests by route template", func(t *testing.T) {
+		m := metrics.NewMetrics()
+		e := echo.New()
+		e.Use(m.Middleware())
+		e.GET("/loans/:id", func(c echo.Context) error {
+			return echo.NewHTTPError(http.StatusNotFound, "loan not found")
+		})
+
+		for _, id := range []string{"loan-1", "loan-2"} {
+			e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/loans/"+id, nil))
+		}
+
+		assert.Contains(t, scrape(t, m), `loan_service_http_request_duration_seconds_count{code="404",method="GET",route="/loans/:id"} 2`)
+	})
+}
+
+func TestLoanCollector(t *testing.T) {
+	t.Run("should report the loans per status and the funded and disbursed amounts", func(t *testing.T) {
+		ctx := context.Background()
+		repository := memory.NewLoanRepository(memory.NewStore())
+		for i, status := range []loan.Status{loan.StatusProposed, loan.StatusInvested, loan.StatusDisbursed, loan.StatusDisbursed} {
+			l := loan.NewLoan(string(rune('a'+i)), "borrower-1", 1000*float64(i+1), 10, 5, interest.Terms{})
+			l.Status = status
+			require.NoError(t, repository.Create(ctx, l))
+		}
+
+		m := metrics.NewMetrics()
+		require.NoError(t, m.Register(metrics.NewLoanCollector(repository)))
+
+		body := scrape(t, m)
+		assert.Contains(t, body, `loan_service_loans{status="proposed"} 1`)
+		assert.Contains(t, body, `loan_service_loans{status="disbursed"} 2`)
+		assert.Contains(t, body, `loan_service_loans{status="expired"} 0`)
+		assert.Contains(t, body, "loan_service_funded_amount 9000")
+		assert.Contains(t, body, "loan_service_disbursed_amount 7000")
+	})
+}
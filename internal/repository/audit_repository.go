@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/model"
+	"gorm.io/gorm"
+)
+
+type AuditRepository struct {
+	db       *gorm.DB
+	executor *TxExecutor
+}
+
+func NewAuditRepository(db *gorm.DB, executor *TxExecutor) *AuditRepository {
+	return &AuditRepository{
+		db:       db,
+		executor: executor,
+	}
+}
+
+func (r *AuditRepository) Append(ctx context.Context, entry *audit.Entry) error {
+	entryModel := model.AuditEntryFromEntity(entry)
+
+	// Use CockroachDB transaction retry logic
+	return r.executor.Execute(ctx, "audit.append", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Create(entryModel).Error
+	})
+}
+
+func (r *AuditRepository) Count(ctx context.Context, filter audit.AuditFilter) (int64, error) {
+	var count int64
+	query := r.executor.DB(ctx).Model(&model.AuditEntry{})
+
+	query = r.applyFilter(query, filter)
+
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *AuditRepository) List(ctx context.Context, filter audit.AuditFilter) ([]*audit.Entry, error) {
+	var entryModels []*model.AuditEntry
+
+	query := r.executor.DB(ctx)
+	query = r.applyFilter(query, filter)
+
+	// Apply pagination, newest entries first
+	filter.WithDefaults()
+	query = query.Order("created_at DESC").Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
+
+	if err := query.Find(&entryModels).Error; err != nil {
+		return nil, err
+	}
+
+	entries := make([]*audit.Entry, 0, len(entryModels))
+	for _, entryModel := range entryModels {
+		entries = append(entries, entryModel.AuditEntryToDomain())
+	}
+
+	return entries, nil
+}
+
+func (r *AuditRepository) applyFilter(query *gorm.DB, filter audit.AuditFilter) *gorm.DB {
+	if filter.LoanID != nil && *filter.LoanID != "" {
+		query = query.Where("loan_id = ?", *filter.LoanID)
+	}
+	if filter.Actor != nil && *filter.Actor != "" {
+		query = query.Where("actor = ?", *filter.Actor)
+	}
+	if filter.Action != nil && *filter.Action != "" {
+		query = query.Where("action = ?", *filter.Action)
+	}
+	if filter.Outcome != nil && *filter.Outcome != "" {
+		query = query.Where("outcome = ?", *filter.Outcome)
+	}
+	if filter.RequestID != nil && *filter.RequestID != "" {
+		query = query.Where("request_id = ?", *filter.RequestID)
+	}
+
+	return query
+}
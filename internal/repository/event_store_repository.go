@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/model"
+	"gorm.io/gorm"
+)
+
+type EventStoreRepository struct {
+	db       *gorm.DB
+	executor *TxExecutor
+}
+
+func NewEventStoreRepository(db *gorm.DB, executor *TxExecutor) *EventStoreRepository {
+	return &EventStoreRepository{
+		db:       db,
+		executor: executor,
+	}
+}
+
+func (r *EventStoreRepository) Load(ctx context.Context, loanID string, afterVersion int) ([]loan.DomainEvent, error) {
+	var eventModels []*model.LoanEvent
+	err := r.executor.DB(ctx).
+		Where("loan_id = ? AND version > ?", loanID, afterVersion).
+		Order("version").
+		Find(&eventModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]loan.DomainEvent, 0, len(eventModels))
+	for _, eventModel := range eventModels {
+		event, err := eventModel.LoanEventToDomain()
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (r *EventStoreRepository) LatestSnapshot(ctx context.Context, loanID string) (*loan.Snapshot, error) {
+	var snapshotModels []*model.LoanSnapshot
+	err := r.executor.DB(ctx).
+		Where("loan_id = ?", loanID).
+		Order("version DESC").
+		Limit(1).
+		Find(&snapshotModels).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(snapshotModels) == 0 {
+		return nil, nil
+	}
+
+	return snapshotModels[0].LoanSnapshotToDomain()
+}
+
+func (r *EventStoreRepository) SaveSnapshot(ctx context.Context, snapshot *loan.Snapshot) error {
+	snapshotModel, err := model.LoanSnapshotFromEntity(snapshot)
+	if err != nil {
+		return err
+	}
+
+	// Use CockroachDB transaction retry logic
+	return r.executor.Execute(ctx, "event_store.save_snapshot", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Save(snapshotModel).Error
+	})
+}
+
+func (r *EventStoreRepository) StreamIDs(ctx context.Context) ([]string, error) {
+	var loanIDs []string
+	err := r.executor.DB(ctx).Model(&model.LoanEvent{}).
+		Distinct("loan_id").
+		Order("loan_id").
+		Pluck("loan_id", &loanIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return loanIDs, nil
+}
+
+// appendLoanEvents adds the pending events of a loan to its stream within tx. Two concurrent
+// writers of the same loan get the same versions, and the unique stream index refuses the second one.
+func appendLoanEvents(ctx context.Context, tx *gorm.DB, events []loan.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var lastVersion int
+	err := tx.WithContext(ctx).Model(&model.LoanEvent{}).
+		Select("COALESCE(MAX(version), 0)").
+		Where("loan_id = ?", events[0].LoanID).
+		Scan(&lastVersion).Error
+	if err != nil {
+		return err
+	}
+
+	loanEvents, err := model.LoanEventsFromDomain(events, lastVersion)
+	if err != nil {
+		return err
+	}
+
+	return tx.WithContext(ctx).Create(&loanEvents).Error
+}
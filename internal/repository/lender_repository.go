@@ -10,20 +10,22 @@ import (
 )
 
 type LenderRepository struct {
-	db *gorm.DB
+	db       *gorm.DB
+	executor *TxExecutor
 }
 
-func NewLenderRepository(db *gorm.DB) *LenderRepository {
+func NewLenderRepository(db *gorm.DB, executor *TxExecutor) *LenderRepository {
 	return &LenderRepository{
-		db: db,
+		db:       db,
+		executor: executor,
 	}
 }
 
 func (r *LenderRepository) Get(ctx context.Context, id string) (*lender.Lender, error) {
 	var lenderModel model.Lender
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&lenderModel).Error; err != nil {
+	if err := r.executor.DB(ctx).Where("id = ?", id).First(&lenderModel).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("lender not found")
+			return nil, lender.ErrLenderNotFound
 		}
 		return nil, err
 	}
@@ -35,36 +37,56 @@ func (r *LenderRepository) Create(ctx context.Context, lenderEntity *lender.Lend
 	lenderModel := model.LenderFromEntity(lenderEntity)
 
 	// Use CockroachDB transaction retry logic
-	return r.executeWithRetry(func(tx *gorm.DB) error {
+	err := r.executor.Execute(ctx, "lender.create", func(tx *gorm.DB) error {
 		return tx.WithContext(ctx).Create(lenderModel).Error
 	})
+
+	// Concurrent registrations can slip past the service check, rely on the unique indexes
+	switch uniqueViolationColumn(err, "email", "id_number") {
+	case "email":
+		return lender.ErrEmailTaken
+	case "id_number":
+		return lender.ErrIDNumberTaken
+	}
+
+	return err
+}
+
+func (r *LenderRepository) CreateBatch(ctx context.Context, lenders []*lender.Lender) error {
+	lenderModels := make([]*model.Lender, 0, len(lenders))
+	for _, lenderEntity := range lenders {
+		lenderModels = append(lenderModels, model.LenderFromEntity(lenderEntity))
+	}
+
+	// Use CockroachDB transaction retry logic
+	err := r.executor.Execute(ctx, "lender.create_batch", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Create(&lenderModels).Error
+	})
+
+	switch uniqueViolationColumn(err, "email", "id_number") {
+	case "email":
+		return lender.ErrEmailTaken
+	case "id_number":
+		return lender.ErrIDNumberTaken
+	}
+
+	return err
 }
 
 func (r *LenderRepository) Save(ctx context.Context, lenderEntity *lender.Lender) error {
 	lenderModel := model.LenderFromEntity(lenderEntity)
 
 	// Use CockroachDB transaction retry logic
-	return r.executeWithRetry(func(tx *gorm.DB) error {
+	return r.executor.Execute(ctx, "lender.save", func(tx *gorm.DB) error {
 		return tx.WithContext(ctx).Save(lenderModel).Error
 	})
 }
 
 func (r *LenderRepository) Count(ctx context.Context, filter lender.LenderFilter) (int64, error) {
 	var count int64
-	query := r.db.WithContext(ctx).Model(&model.Lender{})
+	query := r.executor.DB(ctx).Model(&model.Lender{})
 
-	if filter.FullName != nil && *filter.FullName != "" {
-		query = query.Where("full_name = ?", *filter.FullName)
-	}
-	if filter.Email != nil && *filter.Email != "" {
-		query = query.Where("email = ?", *filter.Email)
-	}
-	if filter.PhoneNumber != nil && *filter.PhoneNumber != "" {
-		query = query.Where("phone_number = ?", *filter.PhoneNumber)
-	}
-	if filter.IDNumber != nil && *filter.IDNumber != "" {
-		query = query.Where("id_number = ?", *filter.IDNumber)
-	}
+	query = r.applyFilter(query, filter)
 
 	if err := query.Count(&count).Error; err != nil {
 		return 0, err
@@ -76,20 +98,8 @@ func (r *LenderRepository) Count(ctx context.Context, filter lender.LenderFilter
 func (r *LenderRepository) List(ctx context.Context, filter lender.LenderFilter) ([]*lender.Lender, error) {
 	var lenderModels []*model.Lender
 
-	query := r.db.WithContext(ctx)
-
-	if filter.FullName != nil && *filter.FullName != "" {
-		query = query.Where("full_name = ?", *filter.FullName)
-	}
-	if filter.Email != nil && *filter.Email != "" {
-		query = query.Where("email = ?", *filter.Email)
-	}
-	if filter.PhoneNumber != nil && *filter.PhoneNumber != "" {
-		query = query.Where("phone_number = ?", *filter.PhoneNumber)
-	}
-	if filter.IDNumber != nil && *filter.IDNumber != "" {
-		query = query.Where("id_number = ?", *filter.IDNumber)
-	}
+	query := r.executor.DB(ctx)
+	query = r.applyFilter(query, filter)
 
 	if filter.Page > 0 && filter.PageSize > 0 {
 		query = query.Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
@@ -107,34 +117,12 @@ func (r *LenderRepository) List(ctx context.Context, filter lender.LenderFilter)
 	return lenders, nil
 }
 
-/* Helper methods. DO NOT MODIFY THIS, this code is generated from CockroachDB */
-
-func (r *LenderRepository) executeWithRetry(operation func(tx *gorm.DB) error) error {
-	maxRetries := 5
-
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		tx := r.db.Begin()
-
-		err := operation(tx)
-		if err != nil {
-			tx.Rollback()
-
-			if attempt < maxRetries-1 && isCockroachRetryError(err) {
-				continue
-			}
-
-			return err
-		}
-
-		if err := tx.Commit().Error; err != nil {
-			if attempt < maxRetries-1 && isCockroachRetryError(err) {
-				continue
-			}
-			return err
-		}
-
-		return nil // Success
-	}
-
-	return errors.New("transaction failed after multiple retries")
+func (r *LenderRepository) applyFilter(query *gorm.DB, filter lender.LenderFilter) *gorm.DB {
+	return applyPartyFilter(query, partyFilter{
+		FullName:    filter.FullName,
+		Email:       filter.Email,
+		PhoneNumber: filter.PhoneNumber,
+		IDNumber:    filter.IDNumber,
+		Query:       filter.Query,
+	})
 }
@@ -10,18 +10,20 @@ import (
 )
 
 type LoanLenderRepository struct {
-	db *gorm.DB
+	db       *gorm.DB
+	executor *TxExecutor
 }
 
-func NewLoanLenderRepository(db *gorm.DB) *LoanLenderRepository {
+func NewLoanLenderRepository(db *gorm.DB, executor *TxExecutor) *LoanLenderRepository {
 	return &LoanLenderRepository{
-		db: db,
+		db:       db,
+		executor: executor,
 	}
 }
 
 func (r *LoanLenderRepository) Get(ctx context.Context, id string) (*loanlender.LoanLender, error) {
 	var loanLenderModel model.LoanLender
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&loanLenderModel).Error; err != nil {
+	if err := r.executor.DB(ctx).Where("id = ?", id).First(&loanLenderModel).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.New("loan-lender relationship not found")
 		}
@@ -33,7 +35,7 @@ func (r *LoanLenderRepository) Get(ctx context.Context, id string) (*loanlender.
 
 func (r *LoanLenderRepository) GetByLoanID(ctx context.Context, loanID string) ([]*loanlender.LoanLender, error) {
 	var loanLenderModels []*model.LoanLender
-	if err := r.db.WithContext(ctx).Where("loan_id = ?", loanID).Find(&loanLenderModels).Error; err != nil {
+	if err := r.executor.DB(ctx).Where("loan_id = ?", loanID).Order("invested_at, id").Find(&loanLenderModels).Error; err != nil {
 		return nil, err
 	}
 
@@ -47,7 +49,7 @@ func (r *LoanLenderRepository) GetByLoanID(ctx context.Context, loanID string) (
 
 func (r *LoanLenderRepository) GetByLenderID(ctx context.Context, lenderID string) ([]*loanlender.LoanLender, error) {
 	var loanLenderModels []*model.LoanLender
-	if err := r.db.WithContext(ctx).Where("lender_id = ?", lenderID).Find(&loanLenderModels).Error; err != nil {
+	if err := r.executor.DB(ctx).Where("lender_id = ?", lenderID).Order("invested_at, id").Find(&loanLenderModels).Error; err != nil {
 		return nil, err
 	}
 
@@ -63,7 +65,7 @@ func (r *LoanLenderRepository) Create(ctx context.Context, loanLenderEntity *loa
 	loanLenderModel := model.LoanLenderFromEntity(loanLenderEntity)
 
 	// Use CockroachDB transaction retry logic
-	return r.executeWithRetry(func(tx *gorm.DB) error {
+	return r.executor.Execute(ctx, "loan_lender.create", func(tx *gorm.DB) error {
 		return tx.WithContext(ctx).Create(loanLenderModel).Error
 	})
 }
@@ -72,14 +74,14 @@ func (r *LoanLenderRepository) Save(ctx context.Context, loanLenderEntity *loanl
 	loanLenderModel := model.LoanLenderFromEntity(loanLenderEntity)
 
 	// Use CockroachDB transaction retry logic
-	return r.executeWithRetry(func(tx *gorm.DB) error {
+	return r.executor.Execute(ctx, "loan_lender.save", func(tx *gorm.DB) error {
 		return tx.WithContext(ctx).Save(loanLenderModel).Error
 	})
 }
 
 func (r *LoanLenderRepository) Count(ctx context.Context, filter loanlender.LoanLenderFilter) (int64, error) {
 	var count int64
-	query := r.db.WithContext(ctx).Model(&model.LoanLender{})
+	query := r.executor.DB(ctx).Model(&model.LoanLender{})
 
 	query = r.applyFilter(query, filter)
 
@@ -93,7 +95,7 @@ func (r *LoanLenderRepository) Count(ctx context.Context, filter loanlender.Loan
 func (r *LoanLenderRepository) List(ctx context.Context, filter loanlender.LoanLenderFilter) ([]*loanlender.LoanLender, error) {
 	var loanLenderModels []*model.LoanLender
 
-	query := r.db.WithContext(ctx)
+	query := r.executor.DB(ctx)
 	query = r.applyFilter(query, filter)
 
 	// Apply pagination
@@ -112,6 +114,97 @@ func (r *LoanLenderRepository) List(ctx context.Context, filter loanlender.LoanL
 	return loanLenders, nil
 }
 
+// releasedExpectedReturn sums the expected return of investments, none for the released loans
+const releasedExpectedReturn = "SUM(CASE WHEN loans.status IN ? THEN 0 ELSE loan_lenders.expected_return END) AS expected_return"
+
+// SummarizeByLenderID aggregates the investments of a lender by the status of the loans they funded
+func (r *LoanLenderRepository) SummarizeByLenderID(ctx context.Context, lenderID string) ([]loanlender.PortfolioStatus, error) {
+	var rows []loanlender.PortfolioStatus
+	err := r.executor.DB(ctx).
+		Table("loan_lenders").
+		Select("loans.status AS status, COUNT(DISTINCT loan_lenders.loan_id) AS loans, "+
+			"SUM(loan_lenders.amount) AS invested, "+releasedExpectedReturn, loanlender.ReleasedLoanStatuses).
+		Joins("JOIN loans ON loans.id = loan_lenders.loan_id").
+		Where("loan_lenders.lender_id = ?", lenderID).
+		Group("loans.status").
+		Order("loans.status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// positionRow is the per-loan aggregate of a lender's investments
+type positionRow struct {
+	LoanID          string
+	LoanStatus      string
+	LoanAmount      float64
+	ROI             float64
+	Invested        float64
+	ExpectedReturn  float64
+	FirstInvestedAt aggregateTime
+	LastInvestedAt  aggregateTime
+}
+
+// ListPositions aggregates the investments of a lender per loan, most recent first
+func (r *LoanLenderRepository) ListPositions(ctx context.Context, filter loanlender.PositionFilter) ([]*loanlender.Position, error) {
+	filter.WithDefaults()
+
+	var rows []positionRow
+	err := r.executor.DB(ctx).
+		Table("loan_lenders").
+		Select("loan_lenders.loan_id AS loan_id, loans.status AS loan_status, loans.amount AS loan_amount, loans.roi AS roi, "+
+			"SUM(loan_lenders.amount) AS invested, "+releasedExpectedReturn+", MIN(loan_lenders.invested_at) AS first_invested_at, "+
+			"MAX(loan_lenders.invested_at) AS last_invested_at", loanlender.ReleasedLoanStatuses).
+		Joins("JOIN loans ON loans.id = loan_lenders.loan_id").
+		Where("loan_lenders.lender_id = ?", filter.LenderID).
+		Group("loan_lenders.loan_id, loans.status, loans.amount, loans.roi").
+		Order("last_invested_at DESC").
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]*loanlender.Position, 0, len(rows))
+	for _, row := range rows {
+		position := &loanlender.Position{
+			LoanID:          row.LoanID,
+			LoanStatus:      row.LoanStatus,
+			LoanAmount:      row.LoanAmount,
+			ROI:             row.ROI,
+			Invested:        row.Invested,
+			ExpectedReturn:  row.ExpectedReturn,
+			FirstInvestedAt: row.FirstInvestedAt.Time,
+			LastInvestedAt:  row.LastInvestedAt.Time,
+		}
+		if row.LoanAmount > 0 {
+			position.SharePercentage = row.Invested / row.LoanAmount * 100
+		}
+		positions = append(positions, position)
+	}
+
+	return positions, nil
+}
+
+// CountPositions returns the number of distinct loans a lender invested in
+func (r *LoanLenderRepository) CountPositions(ctx context.Context, filter loanlender.PositionFilter) (int64, error) {
+	var count int64
+	err := r.executor.DB(ctx).
+		Model(&model.LoanLender{}).
+		Where("lender_id = ?", filter.LenderID).
+		Distinct("loan_id").
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func (r *LoanLenderRepository) applyFilter(query *gorm.DB, filter loanlender.LoanLenderFilter) *gorm.DB {
 	if filter.LoanID != nil && *filter.LoanID != "" {
 		query = query.Where("loan_id = ?", *filter.LoanID)
@@ -134,35 +227,3 @@ func (r *LoanLenderRepository) applyFilter(query *gorm.DB, filter loanlender.Loa
 
 	return query
 }
-
-/* Helper methods. DO NOT MODIFY THIS, this code is generated from CockroachDB */
-
-func (r *LoanLenderRepository) executeWithRetry(operation func(tx *gorm.DB) error) error {
-	maxRetries := 5
-
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		tx := r.db.Begin()
-
-		err := operation(tx)
-		if err != nil {
-			tx.Rollback()
-
-			if attempt < maxRetries-1 && isCockroachRetryError(err) {
-				continue
-			}
-
-			return err
-		}
-
-		if err := tx.Commit().Error; err != nil {
-			if attempt < maxRetries-1 && isCockroachRetryError(err) {
-				continue
-			}
-			return err
-		}
-
-		return nil // Success
-	}
-
-	return errors.New("transaction failed after multiple retries")
-}
@@ -3,7 +3,6 @@ package repository
 import (
 	"context"
 	"errors"
-	"strings"
 
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/model"
@@ -11,23 +10,26 @@ import (
 )
 
 type LoanRepository struct {
-	db *gorm.DB
+	db       *gorm.DB
+	executor *TxExecutor
 }
 
-func NewLoanRepository(db *gorm.DB) *LoanRepository {
+func NewLoanRepository(db *gorm.DB, executor *TxExecutor) *LoanRepository {
 	return &LoanRepository{
-		db: db,
+		db:       db,
+		executor: executor,
 	}
 }
 
 func (r *LoanRepository) Get(ctx context.Context, id string) (*loan.Loan, error) {
 	var loanModel model.Loan
-	if err := r.db.WithContext(ctx).
+	if err := r.executor.DB(ctx).
 		Preload("SurveyDocument").
 		Preload("AgreementDocument").
+		Preload("StatusTransitions", orderTransitions).
 		Where("id = ?", id).First(&loanModel).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("loan not found")
+			return nil, loan.ErrLoanNotFound
 		}
 		return nil, err
 	}
@@ -37,33 +39,85 @@ func (r *LoanRepository) Get(ctx context.Context, id string) (*loan.Loan, error)
 
 func (r *LoanRepository) Create(ctx context.Context, loanEntity *loan.Loan) error {
 	loanModel := model.LoanFromEntity(loanEntity)
+	transitions := model.NewLoanStatusTransitions(loanEntity)
 
-	// Use CockroachDB transaction retry logic
-	return r.executeWithRetry(func(tx *gorm.DB) error {
-		return tx.WithContext(ctx).Create(loanModel).Error
+	// Use CockroachDB transaction retry logic, the new transitions and the recorded events
+	// (to the outbox) are written in the same transaction
+	err := r.executor.Execute(ctx, "loan.create", func(tx *gorm.DB) error {
+		if err := tx.WithContext(ctx).Create(loanModel).Error; err != nil {
+			return err
+		}
+		if len(transitions) > 0 {
+			if err := tx.WithContext(ctx).Create(transitions).Error; err != nil {
+				return err
+			}
+		}
+		return insertLoanEvents(ctx, tx, loanEntity.PendingEvents())
 	})
+	if err != nil {
+		return err
+	}
+
+	markTransitionsSaved(loanEntity, transitions)
+	loanEntity.ClearEvents()
+	return nil
 }
 
 func (r *LoanRepository) Save(ctx context.Context, loanEntity *loan.Loan) error {
 	loanModel := model.LoanFromEntity(loanEntity)
+	transitions := model.NewLoanStatusTransitions(loanEntity)
+
+	// Use CockroachDB transaction retry logic, the new transitions and the recorded events
+	// (to the outbox) are written in the same transaction
+	err := r.executor.Execute(ctx, "loan.save", func(tx *gorm.DB) error {
+		if err := tx.WithContext(ctx).Save(loanModel).Error; err != nil {
+			return err
+		}
+		if len(transitions) > 0 {
+			if err := tx.WithContext(ctx).Create(transitions).Error; err != nil {
+				return err
+			}
+		}
+		return insertLoanEvents(ctx, tx, loanEntity.PendingEvents())
+	})
+	if err != nil {
+		return err
+	}
+
+	markTransitionsSaved(loanEntity, transitions)
+	loanEntity.ClearEvents()
+	return nil
+}
+
+// ReplaceProjection overwrites the stored loan and all its status transitions with the given state,
+// used to rebuild the loans table from the event store
+func (r *LoanRepository) ReplaceProjection(ctx context.Context, loanEntity *loan.Loan) error {
+	loanModel := model.LoanFromEntity(loanEntity)
+	transitions := make([]*model.LoanStatusTransition, 0, len(loanEntity.StatusTransitions))
+	for _, t := range loanEntity.StatusTransitions {
+		transitions = append(transitions, model.LoanStatusTransitionFromEntity(loanEntity.ID, t))
+	}
 
 	// Use CockroachDB transaction retry logic
-	return r.executeWithRetry(func(tx *gorm.DB) error {
-		return tx.WithContext(ctx).Save(loanModel).Error
+	return r.executor.Execute(ctx, "loan.replace_projection", func(tx *gorm.DB) error {
+		if err := tx.WithContext(ctx).Save(loanModel).Error; err != nil {
+			return err
+		}
+		if err := tx.WithContext(ctx).Where("loan_id = ?", loanEntity.ID).Delete(&model.LoanStatusTransition{}).Error; err != nil {
+			return err
+		}
+		if len(transitions) == 0 {
+			return nil
+		}
+		return tx.WithContext(ctx).Create(transitions).Error
 	})
 }
 
 func (r *LoanRepository) Count(ctx context.Context, filter loan.LoanFilter) (int64, error) {
 	var count int64
-	query := r.db.WithContext(ctx).Model(&model.Loan{})
+	query := r.executor.DB(ctx).Model(&model.Loan{})
 
-	if filter.MaxAmount != nil && *filter.MaxAmount != 0 {
-		query = query.Where("amount < ?", *filter.MaxAmount)
-	}
-
-	if filter.MinAmount != nil && *filter.MinAmount != 0 {
-		query = query.Where("amount > ?", *filter.MinAmount)
-	}
+	query = r.applyFilter(query, filter)
 
 	if err := query.Count(&count).Error; err != nil {
 		return 0, err
@@ -74,16 +128,16 @@ func (r *LoanRepository) Count(ctx context.Context, filter loan.LoanFilter) (int
 
 func (r *LoanRepository) List(ctx context.Context, filter loan.LoanFilter) ([]*loan.Loan, error) {
 	var loanModels []*model.Loan
-	query := r.db.WithContext(ctx).Model(&model.Loan{})
+	query := r.executor.DB(ctx).Model(&model.Loan{})
 
-	query = query.Preload("SurveyDocument").Preload("AgreementDocument")
+	query = query.Preload("SurveyDocument").Preload("AgreementDocument").Preload("StatusTransitions", orderTransitions)
 
-	if filter.MaxAmount != nil && *filter.MaxAmount != 0 {
-		query = query.Where("amount < ?", *filter.MaxAmount)
-	}
+	query = r.applyFilter(query, filter)
 
-	if filter.MinAmount != nil && *filter.MinAmount != 0 {
-		query = query.Where("amount > ?", *filter.MinAmount)
+	// Apply pagination when requested, newest loans first
+	if filter.Paginated() {
+		filter.WithDefaults()
+		query = query.Order("created_at DESC").Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
 	}
 
 	if err := query.Find(&loanModels).Error; err != nil {
@@ -98,50 +152,133 @@ func (r *LoanRepository) List(ctx context.Context, filter loan.LoanFilter) ([]*l
 	return loans, nil
 }
 
-/* Helper methods. DO NOT MODIFY THIS, this code is generated from CockroachDB */
+func (r *LoanRepository) SumByStatus(ctx context.Context, borrowerID string) ([]loan.StatusAmount, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+		Amount float64
+	}
 
-func (r *LoanRepository) executeWithRetry(operation func(tx *gorm.DB) error) error {
-	maxRetries := 5
+	query := r.executor.DB(ctx).Model(&model.Loan{}).
+		Select("status, COUNT(*) AS count, COALESCE(SUM(amount), 0) AS amount")
+	if borrowerID != "" {
+		query = query.Where("borrower_id = ?", borrowerID)
+	}
 
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		tx := r.db.Begin()
+	err := query.
+		Group("status").
+		Order("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
 
-		err := operation(tx)
-		if err != nil {
-			tx.Rollback()
+	summaries := make([]loan.StatusAmount, len(rows))
+	for i, row := range rows {
+		summaries[i] = loan.StatusAmount{
+			Status: loan.Status(row.Status),
+			Count:  row.Count,
+			Amount: row.Amount,
+		}
+	}
 
-			if attempt < maxRetries-1 && isCockroachRetryError(err) {
-				continue
-			}
+	return summaries, nil
+}
 
-			return err
-		}
+func (r *LoanRepository) CountTransitions(ctx context.Context, filter loan.TransitionFilter) (int64, error) {
+	var count int64
+	query := r.executor.DB(ctx).Model(&model.LoanStatusTransition{})
 
-		if err := tx.Commit().Error; err != nil {
-			if attempt < maxRetries-1 && isCockroachRetryError(err) {
-				continue
-			}
-			return err
-		}
+	query = r.applyTransitionFilter(query, filter)
+
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *LoanRepository) ListTransitions(ctx context.Context, filter loan.TransitionFilter) ([]loan.StatusTransition, error) {
+	var transitionModels []*model.LoanStatusTransition
+	query := r.executor.DB(ctx).Model(&model.LoanStatusTransition{})
+
+	query = r.applyTransitionFilter(query, filter)
+
+	// Apply pagination, newest transitions first
+	filter.WithDefaults()
+	query = query.Order("date DESC").Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
+
+	if err := query.Find(&transitionModels).Error; err != nil {
+		return nil, err
+	}
+
+	transitions := make([]loan.StatusTransition, 0, len(transitionModels))
+	for _, transitionModel := range transitionModels {
+		transitions = append(transitions, transitionModel.LoanStatusTransitionToDomain())
+	}
+
+	return transitions, nil
+}
 
-		return nil // Success
+func (r *LoanRepository) applyTransitionFilter(query *gorm.DB, filter loan.TransitionFilter) *gorm.DB {
+	if filter.LoanID != nil && *filter.LoanID != "" {
+		query = query.Where("loan_id = ?", *filter.LoanID)
 	}
 
-	return errors.New("transaction failed after multiple retries")
+	if filter.To != nil && *filter.To != "" {
+		query = query.Where("to_status = ?", *filter.To)
+	}
+
+	if filter.PerformedBy != nil && *filter.PerformedBy != "" {
+		query = query.Where("performed_by = ?", *filter.PerformedBy)
+	}
+
+	if filter.Since != nil {
+		query = query.Where("date >= ?", *filter.Since)
+	}
+
+	if filter.Until != nil {
+		query = query.Where("date < ?", *filter.Until)
+	}
+
+	return query
 }
 
-func isCockroachRetryError(err error) bool {
-	// CockroachDB retry error codes typically contain 40001 or
-	// message about transaction retry
-	return err != nil && (errors.Is(err, gorm.ErrInvalidTransaction) ||
-		containsAny(err.Error(), []string{"40001", "retry transaction", "restart transaction"}))
+// orderTransitions preloads the status transitions of a loan in chronological order
+func orderTransitions(db *gorm.DB) *gorm.DB {
+	return db.Order("date")
 }
 
-func containsAny(s string, substrings []string) bool {
-	for _, substr := range substrings {
-		if strings.Contains(s, substr) {
-			return true
+// markTransitionsSaved sets the IDs of the newly persisted transitions on the loan,
+// so they are not inserted again on the next save
+func markTransitionsSaved(loanEntity *loan.Loan, transitions []*model.LoanStatusTransition) {
+	next := 0
+	for i := range loanEntity.StatusTransitions {
+		if loanEntity.StatusTransitions[i].ID != "" || next >= len(transitions) {
+			continue
 		}
+		loanEntity.StatusTransitions[i].ID = transitions[next].ID
+		loanEntity.StatusTransitions[i].LoanID = transitions[next].LoanID
+		next++
+	}
+}
+
+func (r *LoanRepository) applyFilter(query *gorm.DB, filter loan.LoanFilter) *gorm.DB {
+	if filter.BorrowerID != nil && *filter.BorrowerID != "" {
+		query = query.Where("borrower_id = ?", *filter.BorrowerID)
 	}
-	return false
+
+	if filter.Status != nil && *filter.Status != "" {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	if filter.MaxAmount != nil && *filter.MaxAmount != 0 {
+		query = query.Where("amount < ?", *filter.MaxAmount)
+	}
+
+	if filter.MinAmount != nil && *filter.MinAmount != 0 {
+		query = query.Where("amount > ?", *filter.MinAmount)
+	}
+
+	return query
 }
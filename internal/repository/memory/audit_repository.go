@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
+)
+
+type AuditRepository struct {
+	store *Store
+}
+
+var _ audit.Repository = (*AuditRepository)(nil)
+
+func NewAuditRepository(store *Store) *AuditRepository {
+	return &AuditRepository{
+		store: store,
+	}
+}
+
+func (r *AuditRepository) Append(ctx context.Context, entry *audit.Entry) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored := *entry
+	r.store.auditEntries = append(r.store.auditEntries, &stored)
+
+	return nil
+}
+
+func (r *AuditRepository) Count(ctx context.Context, filter audit.AuditFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.find(filter))), nil
+}
+
+func (r *AuditRepository) List(ctx context.Context, filter audit.AuditFilter) ([]*audit.Entry, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := r.find(filter)
+
+	// Apply pagination, newest entries first
+	filter.WithDefaults()
+	sortByTime(matches,
+		func(e *audit.Entry) time.Time { return e.CreatedAt },
+		func(e *audit.Entry) string { return e.ID }, true)
+
+	entries := []*audit.Entry{}
+	for _, stored := range paginate(matches, filter.Page, filter.PageSize) {
+		entry := *stored
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+func (r *AuditRepository) find(filter audit.AuditFilter) []*audit.Entry {
+	var matches []*audit.Entry
+	for _, e := range r.store.auditEntries {
+		if filter.LoanID != nil && *filter.LoanID != "" && e.LoanID != *filter.LoanID {
+			continue
+		}
+		if filter.Actor != nil && *filter.Actor != "" && e.Actor != *filter.Actor {
+			continue
+		}
+		if filter.Action != nil && *filter.Action != "" && e.Action != *filter.Action {
+			continue
+		}
+		if filter.Outcome != nil && *filter.Outcome != "" && e.Outcome != *filter.Outcome {
+			continue
+		}
+		if filter.RequestID != nil && *filter.RequestID != "" && e.RequestID != *filter.RequestID {
+			continue
+		}
+		matches = append(matches, e)
+	}
+	return matches
+}
@@ -0,0 +1,143 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+)
+
+type BorrowerRepository struct {
+	store *Store
+}
+
+var _ borrower.Repository = (*BorrowerRepository)(nil)
+
+func NewBorrowerRepository(store *Store) *BorrowerRepository {
+	return &BorrowerRepository{
+		store: store,
+	}
+}
+
+func (r *BorrowerRepository) Get(ctx context.Context, id string) (*borrower.Borrower, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.borrowers[id]
+	if !ok {
+		return nil, borrower.ErrBorrowerNotFound
+	}
+
+	borrowerEntity := *stored
+	return &borrowerEntity, nil
+}
+
+func (r *BorrowerRepository) Create(ctx context.Context, borrowerEntity *borrower.Borrower) error {
+	return r.CreateBatch(ctx, []*borrower.Borrower{borrowerEntity})
+}
+
+func (r *BorrowerRepository) CreateBatch(ctx context.Context, borrowers []*borrower.Borrower) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, borrowerEntity := range borrowers {
+		if _, ok := r.store.borrowers[borrowerEntity.ID]; ok {
+			return fmt.Errorf("borrower %s already exists", borrowerEntity.ID)
+		}
+	}
+
+	return r.put(borrowers)
+}
+
+func (r *BorrowerRepository) Save(ctx context.Context, borrowerEntity *borrower.Borrower) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.put([]*borrower.Borrower{borrowerEntity})
+}
+
+func (r *BorrowerRepository) Count(ctx context.Context, filter borrower.BorrowerFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.find(filter))), nil
+}
+
+func (r *BorrowerRepository) List(ctx context.Context, filter borrower.BorrowerFilter) ([]*borrower.Borrower, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := r.find(filter)
+	sortByTime(matches,
+		func(b *borrower.Borrower) time.Time { return b.CreatedAt },
+		func(b *borrower.Borrower) string { return b.ID }, false)
+
+	if filter.Page > 0 && filter.PageSize > 0 {
+		matches = paginate(matches, filter.Page, filter.PageSize)
+	}
+
+	var borrowers []*borrower.Borrower
+	for _, stored := range matches {
+		borrowerEntity := *stored
+		borrowers = append(borrowers, &borrowerEntity)
+	}
+
+	return borrowers, nil
+}
+
+// put saves the borrowers unless one of them violates the unique email or ID number,
+// the store must be locked for writing
+func (r *BorrowerRepository) put(borrowers []*borrower.Borrower) error {
+	stored := make([]party, 0, len(r.store.borrowers))
+	for _, borrowerEntity := range r.store.borrowers {
+		stored = append(stored, borrowerParty(borrowerEntity))
+	}
+	parties := make([]party, 0, len(borrowers))
+	for _, borrowerEntity := range borrowers {
+		parties = append(parties, borrowerParty(borrowerEntity))
+	}
+
+	switch uniqueViolationColumn(stored, parties) {
+	case "email":
+		return borrower.ErrEmailTaken
+	case "id_number":
+		return borrower.ErrIDNumberTaken
+	}
+
+	for _, borrowerEntity := range borrowers {
+		copied := *borrowerEntity
+		r.store.borrowers[copied.ID] = &copied
+	}
+
+	return nil
+}
+
+func (r *BorrowerRepository) find(filter borrower.BorrowerFilter) []*borrower.Borrower {
+	criteria := partyFilter{
+		FullName:    filter.FullName,
+		Email:       filter.Email,
+		PhoneNumber: filter.PhoneNumber,
+		IDNumber:    filter.IDNumber,
+		Query:       filter.Query,
+	}
+
+	var matches []*borrower.Borrower
+	for _, borrowerEntity := range r.store.borrowers {
+		if borrowerParty(borrowerEntity).matches(criteria) {
+			matches = append(matches, borrowerEntity)
+		}
+	}
+	return matches
+}
+
+func borrowerParty(b *borrower.Borrower) party {
+	return party{
+		ID:          b.ID,
+		FullName:    b.FullName,
+		Email:       b.Email,
+		PhoneNumber: b.PhoneNumber,
+		IDNumber:    b.IDNumber,
+		CreatedAt:   b.CreatedAt,
+	}
+}
@@ -0,0 +1,117 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/document"
+)
+
+type DocumentRepository struct {
+	store *Store
+}
+
+var _ document.Repository = (*DocumentRepository)(nil)
+
+func NewDocumentRepository(store *Store) *DocumentRepository {
+	return &DocumentRepository{
+		store: store,
+	}
+}
+
+func (r *DocumentRepository) Get(ctx context.Context, id string) (*document.Document, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.documents[id]
+	if !ok {
+		return nil, errors.New("document not found")
+	}
+
+	documentEntity := *stored
+	return &documentEntity, nil
+}
+
+func (r *DocumentRepository) Create(ctx context.Context, documentEntity *document.Document) (string, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.documents[documentEntity.ID]; ok {
+		return "", fmt.Errorf("document %s already exists", documentEntity.ID)
+	}
+
+	stored := *documentEntity
+	r.store.documents[stored.ID] = &stored
+
+	return documentEntity.ID, nil
+}
+
+func (r *DocumentRepository) Save(ctx context.Context, documentEntity *document.Document) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored := *documentEntity
+	r.store.documents[stored.ID] = &stored
+
+	return nil
+}
+
+func (r *DocumentRepository) Count(ctx context.Context, filter document.DocumentFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.find(filter))), nil
+}
+
+func (r *DocumentRepository) List(ctx context.Context, filter document.DocumentFilter) ([]*document.Document, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := r.find(filter)
+	sortByTime(matches,
+		func(d *document.Document) time.Time { return d.CreatedAt },
+		func(d *document.Document) string { return d.ID }, false)
+
+	// Apply pagination if provided
+	if filter.Page > 0 && filter.PageSize > 0 {
+		matches = paginate(matches, filter.Page, filter.PageSize)
+	}
+
+	documents := make([]*document.Document, len(matches))
+	for i, stored := range matches {
+		documentEntity := *stored
+		documents[i] = &documentEntity
+	}
+
+	return documents, nil
+}
+
+func (r *DocumentRepository) find(filter document.DocumentFilter) []*document.Document {
+	// Documents belong to a loan as its survey or agreement document
+	var loanDocuments map[string]bool
+	if filter.LoanID != nil && *filter.LoanID != "" {
+		loanDocuments = map[string]bool{}
+		if l, ok := r.store.loans[*filter.LoanID]; ok {
+			if l.SurveyDocumentID != nil {
+				loanDocuments[*l.SurveyDocumentID] = true
+			}
+			if l.AgreementDocumentID != nil {
+				loanDocuments[*l.AgreementDocumentID] = true
+			}
+		}
+	}
+
+	var matches []*document.Document
+	for _, d := range r.store.documents {
+		if loanDocuments != nil && !loanDocuments[d.ID] {
+			continue
+		}
+		if filter.FileName != nil && *filter.FileName != "" && d.FileName != *filter.FileName {
+			continue
+		}
+		matches = append(matches, d)
+	}
+	return matches
+}
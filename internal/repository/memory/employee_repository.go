@@ -0,0 +1,143 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+)
+
+type EmployeeRepository struct {
+	store *Store
+}
+
+var _ employee.Repository = (*EmployeeRepository)(nil)
+
+func NewEmployeeRepository(store *Store) *EmployeeRepository {
+	return &EmployeeRepository{
+		store: store,
+	}
+}
+
+func (r *EmployeeRepository) Get(ctx context.Context, id string) (*employee.Employee, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.employees[id]
+	if !ok {
+		return nil, employee.ErrEmployeeNotFound
+	}
+
+	employeeEntity := *stored
+	return &employeeEntity, nil
+}
+
+func (r *EmployeeRepository) Create(ctx context.Context, employeeEntity *employee.Employee) error {
+	return r.CreateBatch(ctx, []*employee.Employee{employeeEntity})
+}
+
+func (r *EmployeeRepository) CreateBatch(ctx context.Context, employees []*employee.Employee) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, employeeEntity := range employees {
+		if _, ok := r.store.employees[employeeEntity.ID]; ok {
+			return fmt.Errorf("employee %s already exists", employeeEntity.ID)
+		}
+	}
+
+	return r.put(employees)
+}
+
+func (r *EmployeeRepository) Save(ctx context.Context, employeeEntity *employee.Employee) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.put([]*employee.Employee{employeeEntity})
+}
+
+func (r *EmployeeRepository) Count(ctx context.Context, filter employee.EmployeeFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.find(filter))), nil
+}
+
+func (r *EmployeeRepository) List(ctx context.Context, filter employee.EmployeeFilter) ([]*employee.Employee, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := r.find(filter)
+	sortByTime(matches,
+		func(e *employee.Employee) time.Time { return e.CreatedAt },
+		func(e *employee.Employee) string { return e.ID }, false)
+
+	if filter.Page > 0 && filter.PageSize > 0 {
+		matches = paginate(matches, filter.Page, filter.PageSize)
+	}
+
+	var employees []*employee.Employee
+	for _, stored := range matches {
+		employeeEntity := *stored
+		employees = append(employees, &employeeEntity)
+	}
+
+	return employees, nil
+}
+
+// put saves the employees unless one of them violates the unique email or ID number,
+// the store must be locked for writing
+func (r *EmployeeRepository) put(employees []*employee.Employee) error {
+	stored := make([]party, 0, len(r.store.employees))
+	for _, employeeEntity := range r.store.employees {
+		stored = append(stored, employeeParty(employeeEntity))
+	}
+	parties := make([]party, 0, len(employees))
+	for _, employeeEntity := range employees {
+		parties = append(parties, employeeParty(employeeEntity))
+	}
+
+	switch uniqueViolationColumn(stored, parties) {
+	case "email":
+		return employee.ErrEmailTaken
+	case "id_number":
+		return employee.ErrIDNumberTaken
+	}
+
+	for _, employeeEntity := range employees {
+		copied := *employeeEntity
+		r.store.employees[copied.ID] = &copied
+	}
+
+	return nil
+}
+
+func (r *EmployeeRepository) find(filter employee.EmployeeFilter) []*employee.Employee {
+	criteria := partyFilter{
+		FullName:    filter.FullName,
+		Email:       filter.Email,
+		PhoneNumber: filter.PhoneNumber,
+		IDNumber:    filter.IDNumber,
+		Query:       filter.Query,
+	}
+
+	var matches []*employee.Employee
+	for _, employeeEntity := range r.store.employees {
+		if employeeParty(employeeEntity).matches(criteria) {
+			matches = append(matches, employeeEntity)
+		}
+	}
+	return matches
+}
+
+func employeeParty(e *employee.Employee) party {
+	return party{
+		ID:          e.ID,
+		FullName:    e.FullName,
+		Email:       e.Email,
+		PhoneNumber: e.PhoneNumber,
+		IDNumber:    e.IDNumber,
+		CreatedAt:   e.CreatedAt,
+	}
+}
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+type EventStore struct {
+	store *Store
+}
+
+var _ loan.EventStore = (*EventStore)(nil)
+
+func NewEventStore(store *Store) *EventStore {
+	return &EventStore{
+		store: store,
+	}
+}
+
+func (s *EventStore) Load(ctx context.Context, loanID string, afterVersion int) ([]loan.DomainEvent, error) {
+	s.store.mu.RLock()
+	defer s.store.mu.RUnlock()
+
+	events := []loan.DomainEvent{}
+	for _, event := range s.store.loanEvents[loanID] {
+		if event.Version > afterVersion {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+func (s *EventStore) LatestSnapshot(ctx context.Context, loanID string) (*loan.Snapshot, error) {
+	s.store.mu.RLock()
+	defer s.store.mu.RUnlock()
+
+	snapshot, ok := s.store.loanSnapshots[loanID]
+	if !ok {
+		return nil, nil
+	}
+
+	return &loan.Snapshot{
+		LoanID:  snapshot.LoanID,
+		Version: snapshot.Version,
+		Loan:    cloneLoan(snapshot.Loan, true),
+	}, nil
+}
+
+func (s *EventStore) SaveSnapshot(ctx context.Context, snapshot *loan.Snapshot) error {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+
+	// Only the latest snapshot is ever read
+	if latest, ok := s.store.loanSnapshots[snapshot.LoanID]; ok && latest.Version > snapshot.Version {
+		return nil
+	}
+
+	s.store.loanSnapshots[snapshot.LoanID] = &loan.Snapshot{
+		LoanID:  snapshot.LoanID,
+		Version: snapshot.Version,
+		Loan:    cloneLoan(snapshot.Loan, true),
+	}
+
+	return nil
+}
+
+func (s *EventStore) StreamIDs(ctx context.Context) ([]string, error) {
+	s.store.mu.RLock()
+	defer s.store.mu.RUnlock()
+
+	loanIDs := make([]string, 0, len(s.store.loanEvents))
+	for loanID := range s.store.loanEvents {
+		loanIDs = append(loanIDs, loanID)
+	}
+	sort.Strings(loanIDs)
+
+	return loanIDs, nil
+}
+
+// appendLoanEvents adds the pending events of a loan to its stream, numbering them after
+// the last stored version. The store must be locked for writing.
+func (s *Store) appendLoanEvents(events []loan.DomainEvent) {
+	for _, event := range events {
+		stream := s.loanEvents[event.LoanID]
+		event.Version = len(stream) + 1
+		s.loanEvents[event.LoanID] = append(stream, event)
+	}
+}
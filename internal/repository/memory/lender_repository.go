@@ -0,0 +1,143 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+)
+
+type LenderRepository struct {
+	store *Store
+}
+
+var _ lender.Repository = (*LenderRepository)(nil)
+
+func NewLenderRepository(store *Store) *LenderRepository {
+	return &LenderRepository{
+		store: store,
+	}
+}
+
+func (r *LenderRepository) Get(ctx context.Context, id string) (*lender.Lender, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.lenders[id]
+	if !ok {
+		return nil, lender.ErrLenderNotFound
+	}
+
+	lenderEntity := *stored
+	return &lenderEntity, nil
+}
+
+func (r *LenderRepository) Create(ctx context.Context, lenderEntity *lender.Lender) error {
+	return r.CreateBatch(ctx, []*lender.Lender{lenderEntity})
+}
+
+func (r *LenderRepository) CreateBatch(ctx context.Context, lenders []*lender.Lender) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, lenderEntity := range lenders {
+		if _, ok := r.store.lenders[lenderEntity.ID]; ok {
+			return fmt.Errorf("lender %s already exists", lenderEntity.ID)
+		}
+	}
+
+	return r.put(lenders)
+}
+
+func (r *LenderRepository) Save(ctx context.Context, lenderEntity *lender.Lender) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.put([]*lender.Lender{lenderEntity})
+}
+
+func (r *LenderRepository) Count(ctx context.Context, filter lender.LenderFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.find(filter))), nil
+}
+
+func (r *LenderRepository) List(ctx context.Context, filter lender.LenderFilter) ([]*lender.Lender, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := r.find(filter)
+	sortByTime(matches,
+		func(l *lender.Lender) time.Time { return l.CreatedAt },
+		func(l *lender.Lender) string { return l.ID }, false)
+
+	if filter.Page > 0 && filter.PageSize > 0 {
+		matches = paginate(matches, filter.Page, filter.PageSize)
+	}
+
+	var lenders []*lender.Lender
+	for _, stored := range matches {
+		lenderEntity := *stored
+		lenders = append(lenders, &lenderEntity)
+	}
+
+	return lenders, nil
+}
+
+// put saves the lenders unless one of them violates the unique email or ID number,
+// the store must be locked for writing
+func (r *LenderRepository) put(lenders []*lender.Lender) error {
+	stored := make([]party, 0, len(r.store.lenders))
+	for _, lenderEntity := range r.store.lenders {
+		stored = append(stored, lenderParty(lenderEntity))
+	}
+	parties := make([]party, 0, len(lenders))
+	for _, lenderEntity := range lenders {
+		parties = append(parties, lenderParty(lenderEntity))
+	}
+
+	switch uniqueViolationColumn(stored, parties) {
+	case "email":
+		return lender.ErrEmailTaken
+	case "id_number":
+		return lender.ErrIDNumberTaken
+	}
+
+	for _, lenderEntity := range lenders {
+		copied := *lenderEntity
+		r.store.lenders[copied.ID] = &copied
+	}
+
+	return nil
+}
+
+func (r *LenderRepository) find(filter lender.LenderFilter) []*lender.Lender {
+	criteria := partyFilter{
+		FullName:    filter.FullName,
+		Email:       filter.Email,
+		PhoneNumber: filter.PhoneNumber,
+		IDNumber:    filter.IDNumber,
+		Query:       filter.Query,
+	}
+
+	var matches []*lender.Lender
+	for _, lenderEntity := range r.store.lenders {
+		if lenderParty(lenderEntity).matches(criteria) {
+			matches = append(matches, lenderEntity)
+		}
+	}
+	return matches
+}
+
+func lenderParty(l *lender.Lender) party {
+	return party{
+		ID:          l.ID,
+		FullName:    l.FullName,
+		Email:       l.Email,
+		PhoneNumber: l.PhoneNumber,
+		IDNumber:    l.IDNumber,
+		CreatedAt:   l.CreatedAt,
+	}
+}
@@ -0,0 +1,237 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
+)
+
+type LoanLenderRepository struct {
+	store *Store
+}
+
+var _ loanlender.Repository = (*LoanLenderRepository)(nil)
+
+func NewLoanLenderRepository(store *Store) *LoanLenderRepository {
+	return &LoanLenderRepository{
+		store: store,
+	}
+}
+
+func (r *LoanLenderRepository) Get(ctx context.Context, id string) (*loanlender.LoanLender, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.loanLenders[id]
+	if !ok {
+		return nil, errors.New("loan-lender relationship not found")
+	}
+
+	loanLenderEntity := *stored
+	return &loanLenderEntity, nil
+}
+
+func (r *LoanLenderRepository) GetByLoanID(ctx context.Context, loanID string) ([]*loanlender.LoanLender, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return copyLoanLenders(r.find(loanlender.LoanLenderFilter{LoanID: &loanID})), nil
+}
+
+func (r *LoanLenderRepository) GetByLenderID(ctx context.Context, lenderID string) ([]*loanlender.LoanLender, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return copyLoanLenders(r.find(loanlender.LoanLenderFilter{LenderID: &lenderID})), nil
+}
+
+func (r *LoanLenderRepository) Create(ctx context.Context, loanLenderEntity *loanlender.LoanLender) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.loanLenders[loanLenderEntity.ID]; ok {
+		return fmt.Errorf("loan-lender relationship %s already exists", loanLenderEntity.ID)
+	}
+
+	stored := *loanLenderEntity
+	r.store.loanLenders[stored.ID] = &stored
+
+	return nil
+}
+
+func (r *LoanLenderRepository) Save(ctx context.Context, loanLenderEntity *loanlender.LoanLender) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored := *loanLenderEntity
+	r.store.loanLenders[stored.ID] = &stored
+
+	return nil
+}
+
+func (r *LoanLenderRepository) Count(ctx context.Context, filter loanlender.LoanLenderFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.find(filter))), nil
+}
+
+func (r *LoanLenderRepository) List(ctx context.Context, filter loanlender.LoanLenderFilter) ([]*loanlender.LoanLender, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	// Apply pagination
+	filter.WithDefaults()
+
+	return copyLoanLenders(paginate(r.find(filter), filter.Page, filter.PageSize)), nil
+}
+
+// SummarizeByLenderID aggregates the investments of a lender by the status of the loans they funded
+func (r *LoanLenderRepository) SummarizeByLenderID(ctx context.Context, lenderID string) ([]loanlender.PortfolioStatus, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	byStatus := map[string]*loanlender.PortfolioStatus{}
+	loans := map[string]map[string]bool{}
+	for _, ll := range r.store.loanLenders {
+		l, ok := r.store.loans[ll.LoanID]
+		if ll.LenderID != lenderID || !ok {
+			continue
+		}
+
+		status := string(l.Status)
+		summary, ok := byStatus[status]
+		if !ok {
+			summary = &loanlender.PortfolioStatus{Status: status}
+			byStatus[status] = summary
+			loans[status] = map[string]bool{}
+		}
+		loans[status][ll.LoanID] = true
+		summary.Loans = int64(len(loans[status]))
+		summary.Invested += ll.Amount
+		if !loanlender.IsReleased(status) {
+			summary.ExpectedReturn += ll.ExpectedReturn
+		}
+	}
+
+	var rows []loanlender.PortfolioStatus
+	for _, summary := range byStatus {
+		rows = append(rows, *summary)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Status < rows[j].Status
+	})
+
+	return rows, nil
+}
+
+// ListPositions aggregates the investments of a lender per loan, most recent first
+func (r *LoanLenderRepository) ListPositions(ctx context.Context, filter loanlender.PositionFilter) ([]*loanlender.Position, error) {
+	filter.WithDefaults()
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	byLoan := map[string]*loanlender.Position{}
+	var positions []*loanlender.Position
+	for _, ll := range r.store.loanLenders {
+		l, ok := r.store.loans[ll.LoanID]
+		if ll.LenderID != filter.LenderID || !ok {
+			continue
+		}
+
+		position, ok := byLoan[ll.LoanID]
+		if !ok {
+			position = &loanlender.Position{
+				LoanID:          ll.LoanID,
+				LoanStatus:      string(l.Status),
+				LoanAmount:      l.Amount,
+				ROI:             l.ROI,
+				FirstInvestedAt: ll.InvestedAt,
+				LastInvestedAt:  ll.InvestedAt,
+			}
+			byLoan[ll.LoanID] = position
+			positions = append(positions, position)
+		}
+		position.Invested += ll.Amount
+		if !loanlender.IsReleased(position.LoanStatus) {
+			position.ExpectedReturn += ll.ExpectedReturn
+		}
+		if ll.InvestedAt.Before(position.FirstInvestedAt) {
+			position.FirstInvestedAt = ll.InvestedAt
+		}
+		if ll.InvestedAt.After(position.LastInvestedAt) {
+			position.LastInvestedAt = ll.InvestedAt
+		}
+	}
+
+	for _, position := range positions {
+		if position.LoanAmount > 0 {
+			position.SharePercentage = position.Invested / position.LoanAmount * 100
+		}
+	}
+	sortByTime(positions,
+		func(p *loanlender.Position) time.Time { return p.LastInvestedAt },
+		func(p *loanlender.Position) string { return p.LoanID }, true)
+
+	return append([]*loanlender.Position{}, paginate(positions, filter.Page, filter.PageSize)...), nil
+}
+
+// CountPositions returns the number of distinct loans a lender invested in
+func (r *LoanLenderRepository) CountPositions(ctx context.Context, filter loanlender.PositionFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	loans := map[string]bool{}
+	for _, ll := range r.store.loanLenders {
+		if ll.LenderID == filter.LenderID {
+			loans[ll.LoanID] = true
+		}
+	}
+
+	return int64(len(loans)), nil
+}
+
+// find returns the matching investments in the order they were made
+func (r *LoanLenderRepository) find(filter loanlender.LoanLenderFilter) []*loanlender.LoanLender {
+	var matches []*loanlender.LoanLender
+	for _, ll := range r.store.loanLenders {
+		if filter.LoanID != nil && *filter.LoanID != "" && ll.LoanID != *filter.LoanID {
+			continue
+		}
+		if filter.LenderID != nil && *filter.LenderID != "" && ll.LenderID != *filter.LenderID {
+			continue
+		}
+		if filter.MinAmount != nil && ll.Amount < *filter.MinAmount {
+			continue
+		}
+		if filter.MaxAmount != nil && ll.Amount > *filter.MaxAmount {
+			continue
+		}
+		if filter.InvestedFrom != nil && ll.InvestedAt.Before(*filter.InvestedFrom) {
+			continue
+		}
+		if filter.InvestedTo != nil && ll.InvestedAt.After(*filter.InvestedTo) {
+			continue
+		}
+		matches = append(matches, ll)
+	}
+
+	sortByTime(matches,
+		func(ll *loanlender.LoanLender) time.Time { return ll.InvestedAt },
+		func(ll *loanlender.LoanLender) string { return ll.ID }, false)
+	return matches
+}
+
+func copyLoanLenders(stored []*loanlender.LoanLender) []*loanlender.LoanLender {
+	var loanLenders []*loanlender.LoanLender
+	for _, ll := range stored {
+		loanLenderEntity := *ll
+		loanLenders = append(loanLenders, &loanLenderEntity)
+	}
+	return loanLenders
+}
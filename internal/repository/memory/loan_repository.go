@@ -0,0 +1,281 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/document"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/model"
+)
+
+type LoanRepository struct {
+	store *Store
+}
+
+var _ loan.Repository = (*LoanRepository)(nil)
+
+func NewLoanRepository(store *Store) *LoanRepository {
+	return &LoanRepository{
+		store: store,
+	}
+}
+
+func (r *LoanRepository) Get(ctx context.Context, id string) (*loan.Loan, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.loans[id]
+	if !ok {
+		return nil, loan.ErrLoanNotFound
+	}
+
+	return r.toDomain(stored), nil
+}
+
+func (r *LoanRepository) Create(ctx context.Context, loanEntity *loan.Loan) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.loans[loanEntity.ID]; ok {
+		return fmt.Errorf("loan %s already exists", loanEntity.ID)
+	}
+
+	return r.save(loanEntity)
+}
+
+func (r *LoanRepository) Save(ctx context.Context, loanEntity *loan.Loan) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.save(loanEntity)
+}
+
+// ReplaceProjection overwrites the stored loan and all its status transitions with the given state,
+// used to rebuild the loans from the event store
+func (r *LoanRepository) ReplaceProjection(ctx context.Context, loanEntity *loan.Loan) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.loans[loanEntity.ID] = cloneLoan(loanEntity, false)
+
+	transitions := r.store.transitions[:0:0]
+	for _, t := range r.store.transitions {
+		if t.LoanID != loanEntity.ID {
+			transitions = append(transitions, t)
+		}
+	}
+	for _, t := range loanEntity.StatusTransitions {
+		t.LoanID = loanEntity.ID
+		transitions = append(transitions, t)
+	}
+	r.store.transitions = transitions
+
+	return nil
+}
+
+func (r *LoanRepository) Count(ctx context.Context, filter loan.LoanFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.find(filter))), nil
+}
+
+func (r *LoanRepository) List(ctx context.Context, filter loan.LoanFilter) ([]*loan.Loan, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := r.find(filter)
+
+	// Apply pagination when requested, newest loans first, and list every loan oldest first otherwise
+	sortByTime(matches,
+		func(l *loan.Loan) time.Time { return l.CreatedAt },
+		func(l *loan.Loan) string { return l.ID }, filter.Paginated())
+	if filter.Paginated() {
+		filter.WithDefaults()
+		matches = paginate(matches, filter.Page, filter.PageSize)
+	}
+
+	var loans []*loan.Loan
+	for _, stored := range matches {
+		loans = append(loans, r.toDomain(stored))
+	}
+
+	return loans, nil
+}
+
+func (r *LoanRepository) SumByStatus(ctx context.Context, borrowerID string) ([]loan.StatusAmount, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	byStatus := map[loan.Status]*loan.StatusAmount{}
+	for _, l := range r.store.loans {
+		if borrowerID != "" && l.BorrowerID != borrowerID {
+			continue
+		}
+		summary, ok := byStatus[l.Status]
+		if !ok {
+			summary = &loan.StatusAmount{Status: l.Status}
+			byStatus[l.Status] = summary
+		}
+		summary.Count++
+		summary.Amount += l.Amount
+	}
+
+	summaries := make([]loan.StatusAmount, 0, len(byStatus))
+	for _, summary := range byStatus {
+		summaries = append(summaries, *summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Status < summaries[j].Status
+	})
+
+	return summaries, nil
+}
+
+func (r *LoanRepository) CountTransitions(ctx context.Context, filter loan.TransitionFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.findTransitions(filter))), nil
+}
+
+func (r *LoanRepository) ListTransitions(ctx context.Context, filter loan.TransitionFilter) ([]loan.StatusTransition, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := r.findTransitions(filter)
+
+	// Apply pagination, newest transitions first
+	filter.WithDefaults()
+	sortByTime(matches,
+		func(t loan.StatusTransition) time.Time { return t.Date },
+		func(t loan.StatusTransition) string { return t.ID }, true)
+
+	return append([]loan.StatusTransition{}, paginate(matches, filter.Page, filter.PageSize)...), nil
+}
+
+// save stores the loan with its new transitions and the recorded events (to the outbox),
+// the store must be locked for writing
+func (r *LoanRepository) save(loanEntity *loan.Loan) error {
+	events := loanEntity.PendingEvents()
+
+	// Convert the events first, nothing is stored when one cannot be
+	messages, err := model.OutboxMessagesFromLoanEvents(events)
+	if err != nil {
+		return err
+	}
+
+	r.store.loans[loanEntity.ID] = cloneLoan(loanEntity, false)
+
+	// Set the IDs of the new transitions on the loan, so they are not stored again on the next save
+	for i := range loanEntity.StatusTransitions {
+		t := &loanEntity.StatusTransitions[i]
+		if t.ID != "" {
+			continue
+		}
+		t.ID = uuid.New().String()
+		t.LoanID = loanEntity.ID
+		r.store.transitions = append(r.store.transitions, *t)
+	}
+
+	r.store.appendLoanEvents(events)
+	for _, message := range messages {
+		r.store.outboxMessages[message.ID] = message.OutboxMessageToDomain()
+	}
+
+	loanEntity.ClearEvents()
+	return nil
+}
+
+// toDomain returns a copy of the stored loan with its status transitions in chronological
+// order and its documents
+func (r *LoanRepository) toDomain(stored *loan.Loan) *loan.Loan {
+	l := cloneLoan(stored, false)
+
+	for _, t := range r.store.transitions {
+		if t.LoanID == l.ID {
+			l.StatusTransitions = append(l.StatusTransitions, t)
+		}
+	}
+	sortByTime(l.StatusTransitions,
+		func(t loan.StatusTransition) time.Time { return t.Date },
+		func(t loan.StatusTransition) string { return t.ID }, false)
+
+	l.SurveyDocument = r.document(l.SurveyDocumentID)
+	l.AgreementDocument = r.document(l.AgreementDocumentID)
+
+	return l
+}
+
+func (r *LoanRepository) document(id *string) *document.Document {
+	if id == nil {
+		return nil
+	}
+	stored, ok := r.store.documents[*id]
+	if !ok {
+		return nil
+	}
+	d := *stored
+	return &d
+}
+
+func (r *LoanRepository) find(filter loan.LoanFilter) []*loan.Loan {
+	var matches []*loan.Loan
+	for _, l := range r.store.loans {
+		if filter.BorrowerID != nil && *filter.BorrowerID != "" && l.BorrowerID != *filter.BorrowerID {
+			continue
+		}
+		if filter.Status != nil && *filter.Status != "" && l.Status != *filter.Status {
+			continue
+		}
+		if filter.MaxAmount != nil && *filter.MaxAmount != 0 && l.Amount >= *filter.MaxAmount {
+			continue
+		}
+		if filter.MinAmount != nil && *filter.MinAmount != 0 && l.Amount <= *filter.MinAmount {
+			continue
+		}
+		matches = append(matches, l)
+	}
+	return matches
+}
+
+func (r *LoanRepository) findTransitions(filter loan.TransitionFilter) []loan.StatusTransition {
+	var matches []loan.StatusTransition
+	for _, t := range r.store.transitions {
+		if filter.LoanID != nil && *filter.LoanID != "" && t.LoanID != *filter.LoanID {
+			continue
+		}
+		if filter.To != nil && *filter.To != "" && t.To != *filter.To {
+			continue
+		}
+		if filter.PerformedBy != nil && *filter.PerformedBy != "" && t.PerformedBy != *filter.PerformedBy {
+			continue
+		}
+		if filter.Since != nil && t.Date.Before(*filter.Since) {
+			continue
+		}
+		if filter.Until != nil && !t.Date.Before(*filter.Until) {
+			continue
+		}
+		matches = append(matches, t)
+	}
+	return matches
+}
+
+// cloneLoan copies a loan without its pending events, and without its status transitions
+// unless withTransitions is set, as those are stored on their own
+func cloneLoan(l *loan.Loan, withTransitions bool) *loan.Loan {
+	c := *l
+	c.ClearEvents()
+	c.StatusTransitions = nil
+	if withTransitions {
+		c.StatusTransitions = append([]loan.StatusTransition(nil), l.StatusTransitions...)
+	}
+	c.SurveyDocument = nil
+	c.AgreementDocument = nil
+	return &c
+}
@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/notification"
+)
+
+type NotificationRepository struct {
+	store *Store
+}
+
+var _ notification.Repository = (*NotificationRepository)(nil)
+
+func NewNotificationRepository(store *Store) *NotificationRepository {
+	return &NotificationRepository{
+		store: store,
+	}
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, notificationEntity *notification.Notification) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored := *notificationEntity
+	r.store.notifications = append(r.store.notifications, &stored)
+
+	return nil
+}
+
+func (r *NotificationRepository) HasBeenSent(ctx context.Context, messageID, recipientID string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, n := range r.store.notifications {
+		if n.MessageID == messageID && n.RecipientID == recipientID && n.Status == notification.StatusSent {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *NotificationRepository) Count(ctx context.Context, filter notification.NotificationFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.find(filter))), nil
+}
+
+func (r *NotificationRepository) List(ctx context.Context, filter notification.NotificationFilter) ([]*notification.Notification, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := r.find(filter)
+
+	// Apply pagination
+	filter.WithDefaults()
+	sortByTime(matches,
+		func(n *notification.Notification) time.Time { return n.CreatedAt },
+		func(n *notification.Notification) string { return n.ID }, true)
+
+	notifications := []*notification.Notification{}
+	for _, stored := range paginate(matches, filter.Page, filter.PageSize) {
+		notificationEntity := *stored
+		notifications = append(notifications, &notificationEntity)
+	}
+
+	return notifications, nil
+}
+
+func (r *NotificationRepository) find(filter notification.NotificationFilter) []*notification.Notification {
+	var matches []*notification.Notification
+	for _, n := range r.store.notifications {
+		if filter.RecipientType != nil && *filter.RecipientType != "" && n.RecipientType != *filter.RecipientType {
+			continue
+		}
+		if filter.RecipientID != nil && *filter.RecipientID != "" && n.RecipientID != *filter.RecipientID {
+			continue
+		}
+		if filter.LoanID != nil && *filter.LoanID != "" && n.LoanID != *filter.LoanID {
+			continue
+		}
+		if filter.EventType != nil && *filter.EventType != "" && n.EventType != *filter.EventType {
+			continue
+		}
+		if filter.Status != nil && *filter.Status != "" && n.Status != *filter.Status {
+			continue
+		}
+		matches = append(matches, n)
+	}
+	return matches
+}
@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/outbox"
+)
+
+type OutboxRepository struct {
+	store *Store
+}
+
+var _ outbox.Repository = (*OutboxRepository)(nil)
+
+func NewOutboxRepository(store *Store) *OutboxRepository {
+	return &OutboxRepository{
+		store: store,
+	}
+}
+
+func (r *OutboxRepository) FetchPending(ctx context.Context, limit int) ([]*outbox.Message, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	now := time.Now()
+	var pending []*outbox.Message
+	for _, m := range r.store.outboxMessages {
+		if m.Status == outbox.StatusPending && !m.NextAttemptAt.After(now) {
+			pending = append(pending, m)
+		}
+	}
+	sortByTime(pending,
+		func(m *outbox.Message) time.Time { return m.OccurredAt },
+		func(m *outbox.Message) string { return m.ID }, false)
+
+	messages := []*outbox.Message{}
+	for _, stored := range paginate(pending, 1, limit) {
+		message := *stored
+		messages = append(messages, &message)
+	}
+
+	return messages, nil
+}
+
+func (r *OutboxRepository) Save(ctx context.Context, message *outbox.Message) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored := *message
+	r.store.outboxMessages[stored.ID] = &stored
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"strings"
+	"time"
+)
+
+// party holds the fields shared by borrowers, lenders and employees
+type party struct {
+	ID          string
+	FullName    string
+	Email       string
+	PhoneNumber string
+	IDNumber    string
+	CreatedAt   time.Time
+}
+
+// partyFilter holds the search criteria shared by borrowers, lenders and employees
+type partyFilter struct {
+	FullName    *string
+	Email       *string
+	PhoneNumber *string
+	IDNumber    *string
+	Query       *string
+}
+
+// matches applies the search of the database repositories: the full name matches partially
+// and the email case-insensitively, while `Query` searches across all the identifying fields at once
+func (p party) matches(filter partyFilter) bool {
+	if filter.FullName != nil && *filter.FullName != "" && !containsFold(p.FullName, *filter.FullName) {
+		return false
+	}
+	if filter.Email != nil && *filter.Email != "" && !strings.EqualFold(p.Email, *filter.Email) {
+		return false
+	}
+	if filter.PhoneNumber != nil && *filter.PhoneNumber != "" && p.PhoneNumber != *filter.PhoneNumber {
+		return false
+	}
+	if filter.IDNumber != nil && *filter.IDNumber != "" && p.IDNumber != *filter.IDNumber {
+		return false
+	}
+	if filter.Query != nil && *filter.Query != "" {
+		q := *filter.Query
+		if !containsFold(p.FullName, q) && !containsFold(p.Email, q) && !containsFold(p.PhoneNumber, q) && !containsFold(p.IDNumber, q) {
+			return false
+		}
+	}
+	return true
+}
+
+// uniqueViolationColumn returns which column of the unique indexes, email or id_number, the new
+// parties would violate, or an empty string when they can all be stored. Parties replacing a
+// stored party with the same ID do not conflict with it, and emails differing only in case do.
+func uniqueViolationColumn(stored []party, parties []party) string {
+	emails := map[string]string{}
+	idNumbers := map[string]string{}
+	for _, p := range stored {
+		emails[strings.ToLower(p.Email)] = p.ID
+		idNumbers[p.IDNumber] = p.ID
+	}
+
+	for _, p := range parties {
+		if id, ok := emails[strings.ToLower(p.Email)]; ok && id != p.ID {
+			return "email"
+		}
+		if id, ok := idNumbers[p.IDNumber]; ok && id != p.ID {
+			return "id_number"
+		}
+		emails[strings.ToLower(p.Email)] = p.ID
+		idNumbers[p.IDNumber] = p.ID
+	}
+
+	return ""
+}
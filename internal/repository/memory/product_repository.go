@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
+)
+
+type ProductRepository struct {
+	store *Store
+}
+
+var _ product.Repository = (*ProductRepository)(nil)
+
+func NewProductRepository(store *Store) *ProductRepository {
+	return &ProductRepository{
+		store: store,
+	}
+}
+
+func (r *ProductRepository) Get(ctx context.Context, id string) (*product.LoanProduct, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.products[id]
+	if !ok {
+		return nil, product.ErrProductNotFound
+	}
+
+	return copyProduct(stored), nil
+}
+
+func (r *ProductRepository) Create(ctx context.Context, productEntity *product.LoanProduct) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.products[productEntity.ID]; ok {
+		return fmt.Errorf("loan product %s already exists", productEntity.ID)
+	}
+	r.store.products[productEntity.ID] = copyProduct(productEntity)
+
+	return nil
+}
+
+func (r *ProductRepository) Save(ctx context.Context, productEntity *product.LoanProduct) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.products[productEntity.ID] = copyProduct(productEntity)
+
+	return nil
+}
+
+func (r *ProductRepository) Delete(ctx context.Context, id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.products[id]; !ok {
+		return product.ErrProductNotFound
+	}
+	delete(r.store.products, id)
+
+	return nil
+}
+
+func (r *ProductRepository) Count(ctx context.Context, filter product.ProductFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.find(filter))), nil
+}
+
+func (r *ProductRepository) List(ctx context.Context, filter product.ProductFilter) ([]*product.LoanProduct, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := r.find(filter)
+
+	// Apply pagination
+	filter.WithDefaults()
+	sortByTime(matches,
+		func(p *product.LoanProduct) time.Time { return p.CreatedAt },
+		func(p *product.LoanProduct) string { return p.ID }, false)
+
+	products := []*product.LoanProduct{}
+	for _, stored := range paginate(matches, filter.Page, filter.PageSize) {
+		products = append(products, copyProduct(stored))
+	}
+
+	return products, nil
+}
+
+func (r *ProductRepository) find(filter product.ProductFilter) []*product.LoanProduct {
+	var matches []*product.LoanProduct
+	for _, p := range r.store.products {
+		if filter.Name != nil && *filter.Name != "" && !containsFold(p.Name, *filter.Name) {
+			continue
+		}
+		matches = append(matches, p)
+	}
+	return matches
+}
+
+func copyProduct(p *product.LoanProduct) *product.LoanProduct {
+	stored := *p
+	stored.TenorMonths = append([]int{}, p.TenorMonths...)
+	stored.RequiredDocuments = append([]string{}, p.RequiredDocuments...)
+	return &stored
+}
@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/document"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/notification"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/outbox"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/webhook"
+)
+
+// Store holds the data of the in-memory repositories. The repositories built on the same
+// store share its lock, so a loan is saved together with its transitions, events and
+// outbox messages like in the database transaction.
+//
+// Entities are copied in and out of the store, changing a returned entity has no effect
+// until it is saved.
+type Store struct {
+	mu sync.RWMutex
+
+	loans              map[string]*loan.Loan // Without their status transitions
+	transitions        []loan.StatusTransition
+	loanEvents         map[string][]loan.DomainEvent
+	loanSnapshots      map[string]*loan.Snapshot // Latest snapshot by loan ID
+	borrowers          map[string]*borrower.Borrower
+	lenders            map[string]*lender.Lender
+	employees          map[string]*employee.Employee
+	products           map[string]*product.LoanProduct
+	documents          map[string]*document.Document
+	loanLenders        map[string]*loanlender.LoanLender
+	wallets            map[string]*wallet.Wallet // By lender ID
+	walletTransactions []*wallet.Transaction
+	outboxMessages     map[string]*outbox.Message
+	subscriptions      map[string]*webhook.Subscription
+	deliveries         map[string]*webhook.Delivery
+	deliveryKeys       map[string]bool // One automatic delivery per subscription and message
+	notifications      []*notification.Notification
+	auditEntries       []*audit.Entry
+}
+
+func NewStore() *Store {
+	return &Store{
+		loans:          map[string]*loan.Loan{},
+		loanEvents:     map[string][]loan.DomainEvent{},
+		loanSnapshots:  map[string]*loan.Snapshot{},
+		borrowers:      map[string]*borrower.Borrower{},
+		lenders:        map[string]*lender.Lender{},
+		employees:      map[string]*employee.Employee{},
+		products:       map[string]*product.LoanProduct{},
+		documents:      map[string]*document.Document{},
+		loanLenders:    map[string]*loanlender.LoanLender{},
+		wallets:        map[string]*wallet.Wallet{},
+		outboxMessages: map[string]*outbox.Message{},
+		subscriptions:  map[string]*webhook.Subscription{},
+		deliveries:     map[string]*webhook.Delivery{},
+		deliveryKeys:   map[string]bool{},
+	}
+}
+
+// paginate returns the items of a page, pages start at 1
+func paginate[T any](items []T, page, pageSize int) []T {
+	start := (page - 1) * pageSize
+	if start >= len(items) {
+		return nil
+	}
+	return items[start:min(start+pageSize, len(items))]
+}
+
+// sortByTime orders items by the given time, newest first when desc is set.
+// Items created at the same time keep their order by ID.
+func sortByTime[T any](items []T, at func(T) time.Time, id func(T) string, desc bool) {
+	sort.Slice(items, func(i, j int) bool {
+		ti, tj := at(items[i]), at(items[j])
+		if ti.Equal(tj) {
+			return id(items[i]) < id(items[j])
+		}
+		if desc {
+			return ti.After(tj)
+		}
+		return ti.Before(tj)
+	})
+}
+
+// containsFold reports whether term appears in s, ignoring case
+func containsFold(s, term string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(strings.TrimSpace(term)))
+}
@@ -0,0 +1,337 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/outbox"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/memory"
+	fxpkg "github.com/theodorusyoga/loan-service-state-machine/pkg/fx"
+	"go.uber.org/fx"
+)
+
+// services are built by the application modules on the in-memory repositories,
+// with the real state machine callbacks
+type services struct {
+	loans      *loan.LoanService
+	borrowers  *borrower.BorrowerService
+	lenders    *lender.LenderService
+	employees  *employee.EmployeeService
+	products   *product.ProductService
+	wallets    *wallet.WalletService
+	portfolios *loanlender.LoanLenderService
+	audit      *audit.AuditService
+	outbox     outbox.Repository
+}
+
+func newServices(t *testing.T, eventSourcing bool) *services {
+	cfg := &config.Config{}
+	cfg.Database.Type = config.DatabaseTypeMemory
+	cfg.EventSourcing.Enabled = eventSourcing
+
+	s := &services{}
+	app := fx.New(
+		fx.NopLogger,
+		fx.Supply(cfg),
+		fxpkg.InfrastructureModule,
+		fxpkg.DomainModule,
+		fx.Provide(fxpkg.ProvideValidator),
+		fx.Populate(&s.loans, &s.borrowers, &s.lenders, &s.employees, &s.products, &s.wallets, &s.portfolios, &s.audit, &s.outbox),
+	)
+	require.NoError(t, app.Err())
+
+	return s
+}
+
+func TestLoanLifecycle(t *testing.T) {
+	for _, eventSourcing := range []bool{false, true} {
+		name := "loans table"
+		if eventSourcing {
+			name = "event sourcing"
+		}
+
+		t.Run("should take a loan from proposal to disbursement with "+name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newServices(t, eventSourcing)
+
+			b, err := s.borrowers.CreateBorrower(ctx, "Jane Doe", "jane@example.com", "0812000001", "3171000000000001", nil)
+			require.NoError(t, err)
+			officer, err := s.employees.CreateEmployee(ctx, "John Doe", "john@example.com", "0812000002", "3171000000000002")
+			require.NoError(t, err)
+			p, err := s.products.CreateProduct(ctx, product.Details{
+				Name: "Micro loan", MinAmount: 500, MaxAmount: 5000, MinRate: 8, MaxRate: 12, MinROI: 6, MaxROI: 10,
+				TenorMonths: []int{12}, InterestMethod: interest.MethodFlat,
+			})
+			require.NoError(t, err)
+
+			var investors []*lender.Lender
+			for _, party := range [][]string{
+				{"Ann Smith", "ann@example.com", "0812000003", "3171000000000003"},
+				{"Bob Smith", "bob@example.com", "0812000004", "3171000000000004"},
+			} {
+				l, err := s.lenders.CreateLender(ctx, party[0], party[1], party[2], party[3])
+				require.NoError(t, err)
+				_, err = s.wallets.Deposit(ctx, l.ID, 1000, "Top up")
+				require.NoError(t, err)
+				investors = append(investors, l)
+			}
+
+			created, err := s.loans.CreateLoan(ctx, b.ID, p.ID, 1000, 10, 8, interest.Terms{})
+			require.NoError(t, err)
+
+			l, err := s.loans.GetByID(ctx, created.ID)
+			require.NoError(t, err)
+			require.NoError(t, s.loans.ApproveLoan(ctx, l, officer.ID, "survey.jpg"))
+
+			l, err = s.loans.GetByID(ctx, created.ID)
+			require.NoError(t, err)
+			_, err = s.loans.InvestLoan(ctx, l, investors[0], 400)
+			require.NoError(t, err)
+
+			l, err = s.loans.GetByID(ctx, created.ID)
+			require.NoError(t, err)
+			invested, err := s.loans.InvestLoan(ctx, l, investors[1], 600)
+			require.NoError(t, err)
+			assert.Equal(t, 0.0, invested.RemainingAmount)
+
+			l, err = s.loans.GetByID(ctx, created.ID)
+			require.NoError(t, err)
+			disbursed, err := s.loans.DisburseLoan(ctx, l, officer.ID, "signed.pdf")
+			require.NoError(t, err)
+
+			// The return at the loan ROI is split between the investments by their share
+			require.Len(t, disbursed.Investors, 2)
+			for i, investor := range investors {
+				assert.Equal(t, investor.ID, disbursed.Investors[i].LenderID)
+				assert.Equal(t, []float64{32, 48}[i], disbursed.Investors[i].ExpectedReturn)
+				assert.Equal(t, []float64{432, 648}[i], disbursed.Investors[i].Payout)
+			}
+			assert.Equal(t, 1080.0, disbursed.InvestorROI)
+
+			l, err = s.loans.GetByID(ctx, created.ID)
+			require.NoError(t, err)
+			assert.Equal(t, loan.StatusDisbursed, l.Status)
+			assert.Equal(t, officer.ID, *l.ApprovedBy)
+			require.NotNil(t, l.SurveyDocument)
+			assert.Equal(t, "survey.jpg", l.SurveyDocument.FileName)
+			require.NotNil(t, l.AgreementDocument)
+			assert.Equal(t, "signed.pdf", l.AgreementDocument.FileName)
+			require.NotNil(t, l.ProductID)
+			assert.Equal(t, p.ID, *l.ProductID)
+
+			statuses := []loan.Status{}
+			for _, transition := range l.StatusTransitions {
+				statuses = append(statuses, transition.To)
+			}
+			assert.Equal(t, []loan.Status{loan.StatusProposed, loan.StatusApproved, loan.StatusInvested, loan.StatusDisbursed}, statuses)
+
+			// The reserved funds are paid out to the borrower on disbursement
+			for i, investor := range investors {
+				w, err := s.wallets.GetByLenderID(ctx, investor.ID)
+				require.NoError(t, err)
+				assert.Equal(t, 1000-[]float64{400, 600}[i], w.Balance)
+				assert.Equal(t, 0.0, w.Reserved)
+			}
+
+			// The disbursed loan releases the borrower's credit, the new proposal uses it
+			creditLimit := 1500.0
+			_, err = s.borrowers.UpdateCreditLimit(ctx, b.ID, &creditLimit)
+			require.NoError(t, err)
+			_, err = s.loans.CreateLoan(ctx, b.ID, p.ID, 1000, 10, 8, interest.Terms{})
+			require.NoError(t, err)
+			_, err = s.loans.CreateLoan(ctx, b.ID, p.ID, 1000, 10, 8, interest.Terms{})
+			assert.ErrorIs(t, err, loan.ErrCreditLimitExceeded)
+
+			portfolio, err := s.portfolios.GetPortfolio(ctx, loanlender.PositionFilter{LenderID: investors[1].ID})
+			require.NoError(t, err)
+			assert.Equal(t, 600.0, portfolio.TotalInvested)
+			assert.InDelta(t, 48.0, portfolio.ExpectedReturn, 0.001)
+
+			// Every event waits in the outbox, in the order it happened
+			messages, err := s.outbox.FetchPending(ctx, 10)
+			require.NoError(t, err)
+			eventTypes := []string{}
+			for _, m := range messages {
+				eventTypes = append(eventTypes, m.EventType)
+			}
+			assert.Equal(t, []string{
+				string(loan.EventTypeLoanCreated),
+				string(loan.EventTypeLoanApproved),
+				string(loan.EventTypeLoanInvestmentReceived),
+				string(loan.EventTypeLoanInvestmentReceived),
+				string(loan.EventTypeLoanFullyFunded),
+				string(loan.EventTypeLoanDisbursed),
+				string(loan.EventTypeLoanCreated), // The borrower's new loan
+			}, eventTypes)
+
+			entries, err := s.audit.ListEntries(ctx, audit.AuditFilter{LoanID: &l.ID})
+			require.NoError(t, err)
+			assert.Equal(t, int64(5), entries.Pagination.TotalItems)
+		})
+	}
+}
+
+func TestWalletConcurrency(t *testing.T) {
+	ctx := context.Background()
+	s := newServices(t, false)
+
+	saver, err := s.lenders.CreateLender(ctx, "Sam Smith", "sam@example.com", "0812000009", "3171000000000009")
+	require.NoError(t, err)
+	_, err = s.wallets.Deposit(ctx, saver.ID, 1000, "Top up")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 10)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = s.wallets.Withdraw(ctx, saver.ID, 300, "Cash out")
+		}()
+	}
+	wg.Wait()
+
+	withdrawn := 0
+	for _, err := range errs {
+		if err == nil {
+			withdrawn++
+		} else {
+			assert.ErrorIs(t, err, wallet.ErrInsufficientBalance)
+		}
+	}
+	assert.Equal(t, 3, withdrawn)
+
+	w, err := s.wallets.GetByLenderID(ctx, saver.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, w.Balance)
+}
+
+func TestListLoans(t *testing.T) {
+	ctx := context.Background()
+	s := newServices(t, false)
+
+	b, err := s.borrowers.CreateBorrower(ctx, "Jane Doe", "jane@example.com", "0812000001", "3171000000000001", nil)
+	require.NoError(t, err)
+	p, err := s.products.CreateProduct(ctx, product.Details{
+		Name: "Micro loan", MinAmount: 500, MaxAmount: 5000, MinRate: 8, MaxRate: 12, MinROI: 6, MaxROI: 10,
+		TenorMonths: []int{12}, InterestMethod: interest.MethodFlat,
+	})
+	require.NoError(t, err)
+	for range 12 {
+		_, err := s.loans.CreateLoan(ctx, b.ID, p.ID, 1000, 10, 8, interest.Terms{})
+		require.NoError(t, err)
+	}
+
+	t.Run("should list the first page when none is requested", func(t *testing.T) {
+		result, err := s.loans.ListLoans(ctx, loan.LoanFilter{})
+		require.NoError(t, err)
+
+		assert.Len(t, result.Data, 10)
+		assert.Equal(t, domain.PaginationInfo{CurrentPage: 1, PageSize: 10, TotalItems: 12, TotalPages: 2}, result.Pagination)
+	})
+
+	t.Run("should list the requested page", func(t *testing.T) {
+		result, err := s.loans.ListLoans(ctx, loan.LoanFilter{Page: 2})
+		require.NoError(t, err)
+
+		assert.Len(t, result.Data, 2)
+		assert.Equal(t, 2, result.Pagination.CurrentPage)
+	})
+}
+
+func TestReleasedInvestments(t *testing.T) {
+	ctx := context.Background()
+	s := newServices(t, false)
+
+	b, err := s.borrowers.CreateBorrower(ctx, "Jane Doe", "jane@example.com", "0812000001", "3171000000000001", nil)
+	require.NoError(t, err)
+	officer, err := s.employees.CreateEmployee(ctx, "John Doe", "john@example.com", "0812000002", "3171000000000002")
+	require.NoError(t, err)
+	investor, err := s.lenders.CreateLender(ctx, "Ann Smith", "ann@example.com", "0812000003", "3171000000000003")
+	require.NoError(t, err)
+	_, err = s.wallets.Deposit(ctx, investor.ID, 1000, "Top up")
+	require.NoError(t, err)
+	p, err := s.products.CreateProduct(ctx, product.Details{
+		Name: "Micro loan", MinAmount: 500, MaxAmount: 5000, MinRate: 8, MaxRate: 12, MinROI: 6, MaxROI: 10,
+		TenorMonths: []int{12}, InterestMethod: interest.MethodFlat,
+	})
+	require.NoError(t, err)
+
+	created, err := s.loans.CreateLoan(ctx, b.ID, p.ID, 1000, 10, 8, interest.Terms{})
+	require.NoError(t, err)
+	l, err := s.loans.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.NoError(t, s.loans.ApproveLoan(ctx, l, officer.ID, "survey.jpg"))
+	l, err = s.loans.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	_, err = s.loans.InvestLoan(ctx, l, investor, 500)
+	require.NoError(t, err)
+	l, err = s.loans.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.NoError(t, s.loans.CancelLoan(ctx, l, officer.ID, "Borrower withdrew"))
+
+	// The cancelled loan returned the funds, it earns nothing and is left out of the totals
+	portfolio, err := s.portfolios.GetPortfolio(ctx, loanlender.PositionFilter{LenderID: investor.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, portfolio.TotalInvested)
+	assert.Equal(t, 0.0, portfolio.ExpectedReturn)
+	assert.Equal(t, 500.0, portfolio.Released)
+	assert.Equal(t, []loanlender.PortfolioStatus{{Status: "cancelled", Loans: 1, Invested: 500}}, portfolio.ByStatus)
+	positions := portfolio.Positions.Data.([]*loanlender.Position)
+	require.Len(t, positions, 1)
+	assert.Equal(t, 0.0, positions[0].ExpectedReturn)
+}
+
+func TestPartyUniqueness(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewBorrowerRepository(memory.NewStore())
+
+	require.NoError(t, repo.Create(ctx, borrower.NewBorrower("Jane Doe", "jane@example.com", "0812000001", "3171000000000001")))
+
+	t.Run("should refuse a taken email or ID number", func(t *testing.T) {
+		err := repo.Create(ctx, borrower.NewBorrower("Jim Doe", "jane@example.com", "0812000002", "3171000000000002"))
+		assert.ErrorIs(t, err, borrower.ErrEmailTaken)
+
+		err = repo.Create(ctx, borrower.NewBorrower("Jim Doe", "jim@example.com", "0812000002", "3171000000000001"))
+		assert.ErrorIs(t, err, borrower.ErrIDNumberTaken)
+	})
+
+	t.Run("should insert none of a batch when one borrower is refused", func(t *testing.T) {
+		err := repo.CreateBatch(ctx, []*borrower.Borrower{
+			borrower.NewBorrower("Jim Doe", "jim@example.com", "0812000002", "3171000000000002"),
+			borrower.NewBorrower("Joe Doe", "jim@example.com", "0812000003", "3171000000000003"),
+		})
+		assert.ErrorIs(t, err, borrower.ErrEmailTaken)
+
+		count, err := repo.Count(ctx, borrower.BorrowerFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("should find borrowers like the database search", func(t *testing.T) {
+		query := "JANE"
+		found, err := repo.List(ctx, borrower.BorrowerFilter{Query: &query})
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+
+		// Changing a returned borrower does not change the stored one
+		found[0].FullName = "Changed"
+		stored, err := repo.Get(ctx, found[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Jane Doe", stored.FullName)
+	})
+}
@@ -0,0 +1,23 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+// Transactor runs the state machine events on the in-memory repositories, which have no
+// transactions. Nothing is rolled back: the changes made before a callback refuses a transition,
+// such as the funds reserved for an investment that could not be recorded, are kept. The tests
+// relying on the rollback only run on the database backends.
+type Transactor struct{}
+
+var _ loan.Transactor = Transactor{}
+
+func NewTransactor() Transactor {
+	return Transactor{}
+}
+
+func (Transactor) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
+)
+
+type WalletRepository struct {
+	store *Store
+}
+
+var _ wallet.Repository = (*WalletRepository)(nil)
+
+func NewWalletRepository(store *Store) *WalletRepository {
+	return &WalletRepository{
+		store: store,
+	}
+}
+
+func (r *WalletRepository) GetByLenderID(ctx context.Context, lenderID string) (*wallet.Wallet, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.wallets[lenderID]
+	if !ok {
+		return nil, wallet.ErrWalletNotFound
+	}
+
+	walletEntity := *stored
+	return &walletEntity, nil
+}
+
+func (r *WalletRepository) Create(ctx context.Context, walletEntity *wallet.Wallet) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.wallets[walletEntity.LenderID]; !ok {
+		stored := *walletEntity
+		r.store.wallets[walletEntity.LenderID] = &stored
+	}
+
+	return nil
+}
+
+func (r *WalletRepository) Apply(ctx context.Context, walletEntity *wallet.Wallet, transaction *wallet.Transaction) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// The transaction is applied to the stored wallet, which may have changed since it was read
+	storedWallet := wallet.Wallet{ID: walletEntity.ID, LenderID: walletEntity.LenderID, CreatedAt: walletEntity.CreatedAt}
+	if stored, ok := r.store.wallets[walletEntity.LenderID]; ok {
+		storedWallet = *stored
+	}
+	if err := transaction.ApplyTo(&storedWallet); err != nil {
+		return err
+	}
+
+	storedTransaction := *transaction
+	r.store.wallets[storedWallet.LenderID] = &storedWallet
+	r.store.walletTransactions = append(r.store.walletTransactions, &storedTransaction)
+	*walletEntity = storedWallet
+
+	return nil
+}
+
+func (r *WalletRepository) CountTransactions(ctx context.Context, filter wallet.TransactionFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.find(filter))), nil
+}
+
+func (r *WalletRepository) ListTransactions(ctx context.Context, filter wallet.TransactionFilter) ([]*wallet.Transaction, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := r.find(filter)
+
+	// Apply pagination
+	filter.WithDefaults()
+	sortByTime(matches,
+		func(t *wallet.Transaction) time.Time { return t.CreatedAt },
+		func(t *wallet.Transaction) string { return t.ID }, true)
+	matches = paginate(matches, filter.Page, filter.PageSize)
+
+	transactions := make([]*wallet.Transaction, len(matches))
+	for i, stored := range matches {
+		transaction := *stored
+		transactions[i] = &transaction
+	}
+
+	return transactions, nil
+}
+
+func (r *WalletRepository) find(filter wallet.TransactionFilter) []*wallet.Transaction {
+	var matches []*wallet.Transaction
+	for _, t := range r.store.walletTransactions {
+		if filter.LenderID != nil && *filter.LenderID != "" && t.LenderID != *filter.LenderID {
+			continue
+		}
+		if filter.LoanID != nil && *filter.LoanID != "" && (t.LoanID == nil || *t.LoanID != *filter.LoanID) {
+			continue
+		}
+		if filter.Type != nil && *filter.Type != "" && t.Type != *filter.Type {
+			continue
+		}
+		if filter.From != nil && t.CreatedAt.Before(*filter.From) {
+			continue
+		}
+		if filter.To != nil && t.CreatedAt.After(*filter.To) {
+			continue
+		}
+		matches = append(matches, t)
+	}
+	return matches
+}
@@ -0,0 +1,216 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/webhook"
+)
+
+type WebhookRepository struct {
+	store *Store
+}
+
+var _ webhook.Repository = (*WebhookRepository)(nil)
+
+func NewWebhookRepository(store *Store) *WebhookRepository {
+	return &WebhookRepository{
+		store: store,
+	}
+}
+
+func (r *WebhookRepository) GetSubscription(ctx context.Context, id string) (*webhook.Subscription, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.subscriptions[id]
+	if !ok {
+		return nil, webhook.ErrSubscriptionNotFound
+	}
+
+	return copySubscription(stored), nil
+}
+
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, subscription *webhook.Subscription) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.subscriptions[subscription.ID]; ok {
+		return fmt.Errorf("webhook subscription %s already exists", subscription.ID)
+	}
+	r.store.subscriptions[subscription.ID] = copySubscription(subscription)
+
+	return nil
+}
+
+func (r *WebhookRepository) SaveSubscription(ctx context.Context, subscription *webhook.Subscription) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.subscriptions[subscription.ID] = copySubscription(subscription)
+
+	return nil
+}
+
+func (r *WebhookRepository) CountSubscriptions(ctx context.Context, filter webhook.SubscriptionFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.findSubscriptions(filter))), nil
+}
+
+func (r *WebhookRepository) ListSubscriptions(ctx context.Context, filter webhook.SubscriptionFilter) ([]*webhook.Subscription, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := r.findSubscriptions(filter)
+
+	// Apply pagination
+	filter.WithDefaults()
+	sortByTime(matches,
+		func(s *webhook.Subscription) time.Time { return s.CreatedAt },
+		func(s *webhook.Subscription) string { return s.ID }, false)
+
+	subscriptions := []*webhook.Subscription{}
+	for _, stored := range paginate(matches, filter.Page, filter.PageSize) {
+		subscriptions = append(subscriptions, copySubscription(stored))
+	}
+
+	return subscriptions, nil
+}
+
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.deliveries[id]
+	if !ok {
+		return nil, webhook.ErrDeliveryNotFound
+	}
+
+	delivery := *stored
+	return &delivery, nil
+}
+
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.deliveries[delivery.ID]; ok {
+		return fmt.Errorf("webhook delivery %s already exists", delivery.ID)
+	}
+
+	key := dedupKey(delivery)
+	if r.store.deliveryKeys[key] {
+		return nil
+	}
+
+	stored := *delivery
+	r.store.deliveries[stored.ID] = &stored
+	r.store.deliveryKeys[key] = true
+
+	return nil
+}
+
+func (r *WebhookRepository) SaveDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored := *delivery
+	r.store.deliveries[stored.ID] = &stored
+	r.store.deliveryKeys[dedupKey(delivery)] = true
+
+	return nil
+}
+
+func (r *WebhookRepository) CountDeliveries(ctx context.Context, filter webhook.DeliveryFilter) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(len(r.findDeliveries(filter))), nil
+}
+
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, filter webhook.DeliveryFilter) ([]*webhook.Delivery, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := r.findDeliveries(filter)
+
+	// Apply pagination
+	filter.WithDefaults()
+	sortByTime(matches,
+		func(d *webhook.Delivery) time.Time { return d.CreatedAt },
+		func(d *webhook.Delivery) string { return d.ID }, true)
+
+	return copyDeliveries(paginate(matches, filter.Page, filter.PageSize)), nil
+}
+
+func (r *WebhookRepository) FetchDueDeliveries(ctx context.Context, limit int) ([]*webhook.Delivery, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	now := time.Now()
+	var due []*webhook.Delivery
+	for _, d := range r.store.deliveries {
+		if d.Status == webhook.DeliveryStatusPending && !d.NextAttemptAt.After(now) {
+			due = append(due, d)
+		}
+	}
+	sortByTime(due,
+		func(d *webhook.Delivery) time.Time { return d.NextAttemptAt },
+		func(d *webhook.Delivery) string { return d.ID }, false)
+
+	return copyDeliveries(paginate(due, 1, limit)), nil
+}
+
+func (r *WebhookRepository) findSubscriptions(filter webhook.SubscriptionFilter) []*webhook.Subscription {
+	var matches []*webhook.Subscription
+	for _, s := range r.store.subscriptions {
+		if filter.Active != nil && s.Active != *filter.Active {
+			continue
+		}
+		matches = append(matches, s)
+	}
+	return matches
+}
+
+func (r *WebhookRepository) findDeliveries(filter webhook.DeliveryFilter) []*webhook.Delivery {
+	var matches []*webhook.Delivery
+	for _, d := range r.store.deliveries {
+		if filter.SubscriptionID != nil && *filter.SubscriptionID != "" && d.SubscriptionID != *filter.SubscriptionID {
+			continue
+		}
+		if filter.Status != nil && *filter.Status != "" && d.Status != *filter.Status {
+			continue
+		}
+		if filter.EventType != nil && *filter.EventType != "" && d.EventType != *filter.EventType {
+			continue
+		}
+		matches = append(matches, d)
+	}
+	return matches
+}
+
+// dedupKey allows one automatic delivery per subscription and message, redeliveries are always stored
+func dedupKey(d *webhook.Delivery) string {
+	if d.RedeliveryOf != nil {
+		return d.ID
+	}
+	return d.SubscriptionID + ":" + d.MessageID
+}
+
+func copySubscription(s *webhook.Subscription) *webhook.Subscription {
+	subscription := *s
+	subscription.EventTypes = append([]string{}, s.EventTypes...)
+	return &subscription
+}
+
+func copyDeliveries(stored []*webhook.Delivery) []*webhook.Delivery {
+	deliveries := make([]*webhook.Delivery, 0, len(stored))
+	for _, d := range stored {
+		delivery := *d
+		deliveries = append(deliveries, &delivery)
+	}
+	return deliveries
+}
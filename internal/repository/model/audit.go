@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
+)
+
+func (AuditEntry) TableName() string {
+	return "audit_entries"
+}
+
+type AuditEntry struct {
+	ID         string `gorm:"type:uuid;primary_key"`
+	LoanID     string `gorm:"type:uuid;index"`
+	Action     string `gorm:"type:varchar(20);index"`
+	Actor      string `gorm:"index"`
+	FromStatus string `gorm:"type:varchar(20)"`
+	ToStatus   string `gorm:"type:varchar(20)"`
+	Outcome    string `gorm:"type:varchar(20);index"`
+	Error      *string
+	RequestID  string    `gorm:"index"`
+	CreatedAt  time.Time `gorm:"index"`
+}
+
+func (m *AuditEntry) AuditEntryToDomain() *audit.Entry {
+	return &audit.Entry{
+		ID:         m.ID,
+		LoanID:     m.LoanID,
+		Action:     m.Action,
+		Actor:      m.Actor,
+		FromStatus: m.FromStatus,
+		ToStatus:   m.ToStatus,
+		Outcome:    audit.Outcome(m.Outcome),
+		Error:      m.Error,
+		RequestID:  m.RequestID,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+func AuditEntryFromEntity(e *audit.Entry) *AuditEntry {
+	return &AuditEntry{
+		ID:         e.ID,
+		LoanID:     e.LoanID,
+		Action:     e.Action,
+		Actor:      e.Actor,
+		FromStatus: e.FromStatus,
+		ToStatus:   e.ToStatus,
+		Outcome:    string(e.Outcome),
+		Error:      e.Error,
+		RequestID:  e.RequestID,
+		CreatedAt:  e.CreatedAt,
+	}
+}
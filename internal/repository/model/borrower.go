@@ -11,11 +11,12 @@ func (Borrower) TableName() string {
 }
 
 type Borrower struct {
-	ID          string    `gorm:"type:uuid;primary_key"`
-	FullName    string    `gorm:"type:varchar(100)"`
-	Email       string    `gorm:"type:varchar(100);index"`
-	PhoneNumber string    `gorm:"type:varchar(20)"`
-	IDNumber    string    `gorm:"type:varchar(50);index"`
+	ID          string `gorm:"type:uuid;primary_key"`
+	FullName    string `gorm:"type:varchar(100)"`
+	Email       string `gorm:"type:varchar(100);uniqueIndex"`
+	PhoneNumber string `gorm:"type:varchar(20)"`
+	IDNumber    string `gorm:"type:varchar(50);uniqueIndex"`
+	CreditLimit *float64
 	CreatedAt   time.Time `gorm:"index"`
 	UpdatedAt   time.Time
 }
@@ -27,6 +28,7 @@ func (m *Borrower) BorrowerToEntity() *borrower.Borrower {
 		Email:       m.Email,
 		PhoneNumber: m.PhoneNumber,
 		IDNumber:    m.IDNumber,
+		CreditLimit: m.CreditLimit,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
 	}
@@ -39,6 +41,7 @@ func BorrowerFromEntity(b *borrower.Borrower) *Borrower {
 		Email:       b.Email,
 		PhoneNumber: b.PhoneNumber,
 		IDNumber:    b.IDNumber,
+		CreditLimit: b.CreditLimit,
 		CreatedAt:   b.CreatedAt,
 		UpdatedAt:   b.UpdatedAt,
 	}
@@ -1,9 +1,9 @@
 package model
 
 import (
-	"encoding/json"
 	"time"
 
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
 )
 
@@ -12,38 +12,47 @@ func (Loan) TableName() string {
 }
 
 type Loan struct {
-	ID                  string `gorm:"type:uuid;primary_key"`
-	BorrowerID          string `gorm:"type:uuid;index"`
+	ID                  string  `gorm:"type:uuid;primary_key"`
+	BorrowerID          string  `gorm:"type:uuid;index"`
+	ProductID           *string `gorm:"type:uuid;index"`
 	Amount              float64
 	Rate                float64
 	ROI                 float64
+	TenorMonths         int
+	InterestMethod      string `gorm:"type:varchar(20)"`
+	DayCount            string `gorm:"type:varchar(10)"`
+	TotalInterest       float64
+	TotalRepayment      float64
 	Status              string `gorm:"index;type:varchar(20)"`
 	ApprovalDate        *time.Time
 	ApprovedBy          *string
 	InvestmentDate      *time.Time
 	DisbursementDate    *time.Time
 	DisbursedBy         *string
-	SurveyDocumentID    *string   `gorm:"type:uuid"`
-	SurveyDocument      *Document `gorm:"foreignKey:ID;references:SurveyDocumentID"`
-	AgreementDocumentID *string   `gorm:"type:uuid"`
-	AgreementDocument   *Document `gorm:"foreignKey:ID;references:AgreementDocumentID"`
-	StatusTransitions   JSON      `gorm:"type:jsonb"` // Store as JSONB for CockroachDB
-	CreatedAt           time.Time `gorm:"index"`
+	SurveyDocumentID    *string                `gorm:"type:uuid"`
+	SurveyDocument      *Document              `gorm:"foreignKey:ID;references:SurveyDocumentID"`
+	AgreementDocumentID *string                `gorm:"type:uuid"`
+	AgreementDocument   *Document              `gorm:"foreignKey:ID;references:AgreementDocumentID"`
+	StatusTransitions   []LoanStatusTransition `gorm:"foreignKey:LoanID"` // Inserted by the repository, never updated
+	CreatedAt           time.Time              `gorm:"index"`
 	UpdatedAt           time.Time
 }
 
 func (m *Loan) LoanToEntity() *loan.Loan {
-	var transitions []loan.StatusTransition
-	if len(m.StatusTransitions) > 0 {
-		_ = json.Unmarshal(m.StatusTransitions, &transitions)
-	}
+	transitions := m.statusTransitionsToDomain()
 
 	return &loan.Loan{
 		ID:                  m.ID,
 		BorrowerID:          m.BorrowerID,
+		ProductID:           m.ProductID,
 		Amount:              m.Amount,
 		Rate:                m.Rate,
 		ROI:                 m.ROI,
+		TenorMonths:         m.TenorMonths,
+		InterestMethod:      interest.Method(m.InterestMethod),
+		DayCount:            interest.DayCount(m.DayCount),
+		TotalInterest:       m.TotalInterest,
+		TotalRepayment:      m.TotalRepayment,
 		Status:              loan.Status(m.Status),
 		ApprovalDate:        m.ApprovalDate,
 		ApprovedBy:          m.ApprovedBy,
@@ -59,42 +68,46 @@ func (m *Loan) LoanToEntity() *loan.Loan {
 }
 
 func LoanFromEntity(l *loan.Loan) *Loan {
-	json, err := json.Marshal(l.StatusTransitions)
-	if err != nil {
-		return nil
-	}
-
 	return &Loan{
-		ID:                l.ID,
-		BorrowerID:        l.BorrowerID,
-		Amount:            l.Amount,
-		Rate:              l.Rate,
-		ROI:               l.ROI,
-		Status:            string(l.Status),
-		SurveyDocumentID:  l.SurveyDocumentID,
-		ApprovalDate:      l.ApprovalDate,
-		ApprovedBy:        l.ApprovedBy,
-		InvestmentDate:    l.InvestmentDate,
-		DisbursementDate:  l.DisbursementDate,
-		DisbursedBy:       l.DisbursedBy,
-		StatusTransitions: json,
-		CreatedAt:         l.CreatedAt,
-		UpdatedAt:         l.UpdatedAt,
+		ID:                  l.ID,
+		BorrowerID:          l.BorrowerID,
+		ProductID:           l.ProductID,
+		Amount:              l.Amount,
+		Rate:                l.Rate,
+		ROI:                 l.ROI,
+		TenorMonths:         l.TenorMonths,
+		InterestMethod:      string(l.InterestMethod),
+		DayCount:            string(l.DayCount),
+		TotalInterest:       l.TotalInterest,
+		TotalRepayment:      l.TotalRepayment,
+		Status:              string(l.Status),
+		SurveyDocumentID:    l.SurveyDocumentID,
+		AgreementDocumentID: l.AgreementDocumentID,
+		ApprovalDate:        l.ApprovalDate,
+		ApprovedBy:          l.ApprovedBy,
+		InvestmentDate:      l.InvestmentDate,
+		DisbursementDate:    l.DisbursementDate,
+		DisbursedBy:         l.DisbursedBy,
+		CreatedAt:           l.CreatedAt,
+		UpdatedAt:           l.UpdatedAt,
 	}
 }
 
 func (m *Loan) LoanToDomain() *loan.Loan {
-	var transitions []loan.StatusTransition
-	if len(m.StatusTransitions) > 0 {
-		_ = json.Unmarshal(m.StatusTransitions, &transitions)
-	}
+	transitions := m.statusTransitionsToDomain()
 
 	domainLoan := &loan.Loan{
 		ID:                  m.ID,
 		BorrowerID:          m.BorrowerID,
+		ProductID:           m.ProductID,
 		Amount:              m.Amount,
 		Rate:                m.Rate,
 		ROI:                 m.ROI,
+		TenorMonths:         m.TenorMonths,
+		InterestMethod:      interest.Method(m.InterestMethod),
+		DayCount:            interest.DayCount(m.DayCount),
+		TotalInterest:       m.TotalInterest,
+		TotalRepayment:      m.TotalRepayment,
 		Status:              loan.Status(m.Status),
 		SurveyDocumentID:    m.SurveyDocumentID,
 		AgreementDocumentID: m.AgreementDocumentID,
@@ -104,6 +117,7 @@ func (m *Loan) LoanToDomain() *loan.Loan {
 		ApprovedBy:          m.ApprovedBy,
 		InvestmentDate:      m.InvestmentDate,
 		DisbursementDate:    m.DisbursementDate,
+		DisbursedBy:         m.DisbursedBy,
 		StatusTransitions:   transitions,
 	}
 
@@ -116,3 +130,11 @@ func (m *Loan) LoanToDomain() *loan.Loan {
 
 	return domainLoan
 }
+
+func (m *Loan) statusTransitionsToDomain() []loan.StatusTransition {
+	transitions := make([]loan.StatusTransition, 0, len(m.StatusTransitions))
+	for _, t := range m.StatusTransitions {
+		transitions = append(transitions, t.LoanStatusTransitionToDomain())
+	}
+	return transitions
+}
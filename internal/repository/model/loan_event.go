@@ -0,0 +1,99 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+func (LoanEvent) TableName() string {
+	return "loan_events"
+}
+
+type LoanEvent struct {
+	ID         string `gorm:"type:uuid;primary_key"`
+	LoanID     string `gorm:"type:uuid;uniqueIndex:idx_loan_events_stream,priority:1"`
+	Version    int    `gorm:"uniqueIndex:idx_loan_events_stream,priority:2"`
+	EventType  string `gorm:"type:varchar(50)"`
+	Payload    JSON   `gorm:"type:jsonb"`
+	OccurredAt time.Time
+}
+
+func (m *LoanEvent) LoanEventToDomain() (loan.DomainEvent, error) {
+	payload, err := loan.DecodeEventPayload(loan.EventType(m.EventType), m.Payload)
+	if err != nil {
+		return loan.DomainEvent{}, err
+	}
+
+	return loan.DomainEvent{
+		ID:         m.ID,
+		Type:       loan.EventType(m.EventType),
+		LoanID:     m.LoanID,
+		Version:    m.Version,
+		Payload:    payload,
+		OccurredAt: m.OccurredAt,
+	}, nil
+}
+
+// LoanEventsFromDomain converts the pending events of a loan into its stream, numbering them
+// from the version following lastVersion
+func LoanEventsFromDomain(events []loan.DomainEvent, lastVersion int) ([]*LoanEvent, error) {
+	loanEvents := make([]*LoanEvent, 0, len(events))
+
+	for i, event := range events {
+		payload, err := json.Marshal(event.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		loanEvents = append(loanEvents, &LoanEvent{
+			ID:         event.ID,
+			LoanID:     event.LoanID,
+			Version:    lastVersion + i + 1,
+			EventType:  string(event.Type),
+			Payload:    payload,
+			OccurredAt: event.OccurredAt,
+		})
+	}
+
+	return loanEvents, nil
+}
+
+func (LoanSnapshot) TableName() string {
+	return "loan_snapshots"
+}
+
+type LoanSnapshot struct {
+	LoanID    string `gorm:"type:uuid;primary_key"`
+	Version   int    `gorm:"primary_key;autoIncrement:false"`
+	State     JSON   `gorm:"type:jsonb"`
+	CreatedAt time.Time
+}
+
+func (m *LoanSnapshot) LoanSnapshotToDomain() (*loan.Snapshot, error) {
+	var state loan.Loan
+	if err := json.Unmarshal(m.State, &state); err != nil {
+		return nil, err
+	}
+
+	return &loan.Snapshot{
+		LoanID:  m.LoanID,
+		Version: m.Version,
+		Loan:    &state,
+	}, nil
+}
+
+func LoanSnapshotFromEntity(s *loan.Snapshot) (*LoanSnapshot, error) {
+	state, err := json.Marshal(s.Loan)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoanSnapshot{
+		LoanID:    s.LoanID,
+		Version:   s.Version,
+		State:     state,
+		CreatedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/notification"
+)
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+type Notification struct {
+	ID            string `gorm:"type:uuid;primary_key"`
+	MessageID     string `gorm:"type:uuid;index:idx_notifications_message_recipient,priority:1"`
+	LoanID        string `gorm:"type:uuid;index"`
+	EventType     string `gorm:"type:varchar(50);index"`
+	RecipientType string `gorm:"type:varchar(20)"`
+	RecipientID   string `gorm:"type:uuid;index;index:idx_notifications_message_recipient,priority:2"`
+	Email         string
+	Channel       string `gorm:"type:varchar(20)"`
+	Subject       string
+	Body          string
+	Status        string `gorm:"type:varchar(20);index"`
+	Error         *string
+	CreatedAt     time.Time `gorm:"index"`
+}
+
+func (m *Notification) NotificationToDomain() *notification.Notification {
+	return &notification.Notification{
+		ID:            m.ID,
+		MessageID:     m.MessageID,
+		LoanID:        m.LoanID,
+		EventType:     m.EventType,
+		RecipientType: notification.RecipientType(m.RecipientType),
+		RecipientID:   m.RecipientID,
+		Email:         m.Email,
+		Channel:       m.Channel,
+		Subject:       m.Subject,
+		Body:          m.Body,
+		Status:        notification.Status(m.Status),
+		Error:         m.Error,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+func NotificationFromEntity(n *notification.Notification) *Notification {
+	return &Notification{
+		ID:            n.ID,
+		MessageID:     n.MessageID,
+		LoanID:        n.LoanID,
+		EventType:     n.EventType,
+		RecipientType: string(n.RecipientType),
+		RecipientID:   n.RecipientID,
+		Email:         n.Email,
+		Channel:       n.Channel,
+		Subject:       n.Subject,
+		Body:          n.Body,
+		Status:        string(n.Status),
+		Error:         n.Error,
+		CreatedAt:     n.CreatedAt,
+	}
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/outbox"
+)
+
+const AggregateTypeLoan = "loan"
+
+func (OutboxMessage) TableName() string {
+	return "outbox_messages"
+}
+
+type OutboxMessage struct {
+	ID            string `gorm:"type:uuid;primary_key"`
+	AggregateType string `gorm:"type:varchar(50)"`
+	AggregateID   string `gorm:"type:uuid;index"`
+	EventType     string `gorm:"type:varchar(50);index"`
+	Payload       JSON   `gorm:"type:jsonb"`
+	Status        string `gorm:"type:varchar(20);index:idx_outbox_messages_pending,priority:1"`
+	Attempts      int
+	LastError     *string
+	NextAttemptAt time.Time `gorm:"index:idx_outbox_messages_pending,priority:2"`
+	OccurredAt    time.Time
+	SentAt        *time.Time
+	CreatedAt     time.Time
+}
+
+func (m *OutboxMessage) OutboxMessageToDomain() *outbox.Message {
+	return &outbox.Message{
+		ID:            m.ID,
+		AggregateType: m.AggregateType,
+		AggregateID:   m.AggregateID,
+		EventType:     m.EventType,
+		Payload:       json.RawMessage(m.Payload),
+		Status:        outbox.Status(m.Status),
+		Attempts:      m.Attempts,
+		LastError:     m.LastError,
+		NextAttemptAt: m.NextAttemptAt,
+		OccurredAt:    m.OccurredAt,
+		SentAt:        m.SentAt,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+func OutboxMessageFromEntity(m *outbox.Message) *OutboxMessage {
+	return &OutboxMessage{
+		ID:            m.ID,
+		AggregateType: m.AggregateType,
+		AggregateID:   m.AggregateID,
+		EventType:     m.EventType,
+		Payload:       JSON(m.Payload),
+		Status:        string(m.Status),
+		Attempts:      m.Attempts,
+		LastError:     m.LastError,
+		NextAttemptAt: m.NextAttemptAt,
+		OccurredAt:    m.OccurredAt,
+		SentAt:        m.SentAt,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+// OutboxMessagesFromLoanEvents converts the pending events of a loan into outbox messages
+func OutboxMessagesFromLoanEvents(events []loan.DomainEvent) ([]*OutboxMessage, error) {
+	messages := make([]*OutboxMessage, 0, len(events))
+	now := time.Now()
+
+	for _, event := range events {
+		payload, err := json.Marshal(event.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, &OutboxMessage{
+			ID:            event.ID,
+			AggregateType: AggregateTypeLoan,
+			AggregateID:   event.LoanID,
+			EventType:     string(event.Type),
+			Payload:       payload,
+			Status:        string(outbox.StatusPending),
+			NextAttemptAt: event.OccurredAt,
+			OccurredAt:    event.OccurredAt,
+			CreatedAt:     now,
+		})
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
+)
+
+func (LoanProduct) TableName() string {
+	return "loan_products"
+}
+
+type LoanProduct struct {
+	ID                string `gorm:"type:uuid;primary_key"`
+	Name              string `gorm:"type:varchar(100)"`
+	MinAmount         float64
+	MaxAmount         float64
+	MinRate           float64
+	MaxRate           float64
+	MinROI            float64
+	MaxROI            float64
+	TenorMonths       JSON      `gorm:"type:jsonb"`
+	InterestMethod    string    `gorm:"type:varchar(20)"`
+	RequiredDocuments JSON      `gorm:"type:jsonb"`
+	CreatedAt         time.Time `gorm:"index"`
+	UpdatedAt         time.Time
+}
+
+func (m *LoanProduct) LoanProductToDomain() *product.LoanProduct {
+	var tenorMonths []int
+	if len(m.TenorMonths) > 0 {
+		_ = json.Unmarshal(m.TenorMonths, &tenorMonths)
+	}
+	var requiredDocuments []string
+	if len(m.RequiredDocuments) > 0 {
+		_ = json.Unmarshal(m.RequiredDocuments, &requiredDocuments)
+	}
+
+	return &product.LoanProduct{
+		ID: m.ID,
+		Details: product.Details{
+			Name:              m.Name,
+			MinAmount:         m.MinAmount,
+			MaxAmount:         m.MaxAmount,
+			MinRate:           m.MinRate,
+			MaxRate:           m.MaxRate,
+			MinROI:            m.MinROI,
+			MaxROI:            m.MaxROI,
+			TenorMonths:       tenorMonths,
+			InterestMethod:    interest.Method(m.InterestMethod),
+			RequiredDocuments: requiredDocuments,
+		},
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func LoanProductFromEntity(p *product.LoanProduct) *LoanProduct {
+	tenorMonths := p.TenorMonths
+	if tenorMonths == nil {
+		tenorMonths = []int{}
+	}
+	requiredDocuments := p.RequiredDocuments
+	if requiredDocuments == nil {
+		requiredDocuments = []string{}
+	}
+	tenorMonthsJSON, err := json.Marshal(tenorMonths)
+	if err != nil {
+		return nil
+	}
+	requiredDocumentsJSON, err := json.Marshal(requiredDocuments)
+	if err != nil {
+		return nil
+	}
+
+	return &LoanProduct{
+		ID:                p.ID,
+		Name:              p.Name,
+		MinAmount:         p.MinAmount,
+		MaxAmount:         p.MaxAmount,
+		MinRate:           p.MinRate,
+		MaxRate:           p.MaxRate,
+		MinROI:            p.MinROI,
+		MaxROI:            p.MaxROI,
+		TenorMonths:       tenorMonthsJSON,
+		InterestMethod:    string(p.InterestMethod),
+		RequiredDocuments: requiredDocumentsJSON,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+func (LoanStatusTransition) TableName() string {
+	return "loan_status_transitions"
+}
+
+type LoanStatusTransition struct {
+	ID          string `gorm:"type:uuid;primary_key"`
+	LoanID      string `gorm:"type:uuid;index"`
+	FromStatus  string `gorm:"type:varchar(20)"`
+	ToStatus    string `gorm:"type:varchar(20);index"`
+	Description string
+	PerformedBy string    `gorm:"index"`
+	Date        time.Time `gorm:"index"`
+}
+
+func (m *LoanStatusTransition) LoanStatusTransitionToDomain() loan.StatusTransition {
+	return loan.StatusTransition{
+		ID:          m.ID,
+		LoanID:      m.LoanID,
+		From:        loan.Status(m.FromStatus),
+		To:          loan.Status(m.ToStatus),
+		Date:        m.Date,
+		Description: m.Description,
+		PerformedBy: m.PerformedBy,
+	}
+}
+
+// NewLoanStatusTransitions returns the transitions of the loan that have not been persisted yet,
+// with newly generated IDs
+func NewLoanStatusTransitions(l *loan.Loan) []*LoanStatusTransition {
+	var transitions []*LoanStatusTransition
+	for _, t := range l.StatusTransitions {
+		if t.ID != "" {
+			continue
+		}
+		t.ID = uuid.New().String()
+		transitions = append(transitions, LoanStatusTransitionFromEntity(l.ID, t))
+	}
+	return transitions
+}
+
+func LoanStatusTransitionFromEntity(loanID string, t loan.StatusTransition) *LoanStatusTransition {
+	return &LoanStatusTransition{
+		ID:          t.ID,
+		LoanID:      loanID,
+		FromStatus:  string(t.From),
+		ToStatus:    string(t.To),
+		Description: t.Description,
+		PerformedBy: t.PerformedBy,
+		Date:        t.Date,
+	}
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
+)
+
+func (Wallet) TableName() string {
+	return "wallets"
+}
+
+func (WalletTransaction) TableName() string {
+	return "wallet_transactions"
+}
+
+type Wallet struct {
+	ID        string `gorm:"type:uuid;primary_key"`
+	LenderID  string `gorm:"type:uuid;uniqueIndex"`
+	Balance   float64
+	Reserved  float64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WalletTransaction struct {
+	ID            string  `gorm:"type:uuid;primary_key"`
+	WalletID      string  `gorm:"type:uuid;index"`
+	LenderID      string  `gorm:"type:uuid;index"`
+	LoanID        *string `gorm:"type:uuid;index"`
+	Type          string  `gorm:"type:varchar(20);index"`
+	Amount        float64
+	BalanceAfter  float64
+	ReservedAfter float64
+	Description   string    `gorm:"type:varchar(255)"`
+	CreatedAt     time.Time `gorm:"index"`
+}
+
+func (m *Wallet) WalletToDomain() *wallet.Wallet {
+	return &wallet.Wallet{
+		ID:        m.ID,
+		LenderID:  m.LenderID,
+		Balance:   m.Balance,
+		Reserved:  m.Reserved,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func WalletFromEntity(w *wallet.Wallet) *Wallet {
+	return &Wallet{
+		ID:        w.ID,
+		LenderID:  w.LenderID,
+		Balance:   w.Balance,
+		Reserved:  w.Reserved,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func (m *WalletTransaction) WalletTransactionToDomain() *wallet.Transaction {
+	return &wallet.Transaction{
+		ID:            m.ID,
+		WalletID:      m.WalletID,
+		LenderID:      m.LenderID,
+		LoanID:        m.LoanID,
+		Type:          wallet.TransactionType(m.Type),
+		Amount:        m.Amount,
+		BalanceAfter:  m.BalanceAfter,
+		ReservedAfter: m.ReservedAfter,
+		Description:   m.Description,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+func WalletTransactionFromEntity(t *wallet.Transaction) *WalletTransaction {
+	return &WalletTransaction{
+		ID:            t.ID,
+		WalletID:      t.WalletID,
+		LenderID:      t.LenderID,
+		LoanID:        t.LoanID,
+		Type:          string(t.Type),
+		Amount:        t.Amount,
+		BalanceAfter:  t.BalanceAfter,
+		ReservedAfter: t.ReservedAfter,
+		Description:   t.Description,
+		CreatedAt:     t.CreatedAt,
+	}
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/webhook"
+)
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+type WebhookSubscription struct {
+	ID         string `gorm:"type:uuid;primary_key"`
+	URL        string `gorm:"type:varchar(2048)"`
+	EventTypes JSON   `gorm:"type:jsonb"`
+	Secret     string `gorm:"type:varchar(255)"`
+	Active     bool   `gorm:"index"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type WebhookDelivery struct {
+	ID             string  `gorm:"type:uuid;primary_key"`
+	SubscriptionID string  `gorm:"type:uuid;index"`
+	MessageID      string  `gorm:"type:uuid;index"`
+	RedeliveryOf   *string `gorm:"type:uuid"`
+	DedupKey       string  `gorm:"type:varchar(100);uniqueIndex"` // One automatic delivery per subscription and message
+	EventType      string  `gorm:"type:varchar(50);index"`
+	Payload        JSON    `gorm:"type:jsonb"`
+	Status         string  `gorm:"type:varchar(20);index:idx_webhook_deliveries_due,priority:1"`
+	Attempts       int
+	ResponseCode   *int
+	LastError      *string
+	NextAttemptAt  time.Time `gorm:"index:idx_webhook_deliveries_due,priority:2"`
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time `gorm:"index"`
+	UpdatedAt      time.Time
+}
+
+func (m *WebhookSubscription) WebhookSubscriptionToDomain() *webhook.Subscription {
+	var eventTypes []string
+	if len(m.EventTypes) > 0 {
+		_ = json.Unmarshal(m.EventTypes, &eventTypes)
+	}
+
+	return &webhook.Subscription{
+		ID:         m.ID,
+		URL:        m.URL,
+		EventTypes: eventTypes,
+		Secret:     m.Secret,
+		Active:     m.Active,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+func WebhookSubscriptionFromEntity(s *webhook.Subscription) *WebhookSubscription {
+	eventTypes := s.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	eventTypesJSON, err := json.Marshal(eventTypes)
+	if err != nil {
+		return nil
+	}
+
+	return &WebhookSubscription{
+		ID:         s.ID,
+		URL:        s.URL,
+		EventTypes: eventTypesJSON,
+		Secret:     s.Secret,
+		Active:     s.Active,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+func (m *WebhookDelivery) WebhookDeliveryToDomain() *webhook.Delivery {
+	return &webhook.Delivery{
+		ID:             m.ID,
+		SubscriptionID: m.SubscriptionID,
+		MessageID:      m.MessageID,
+		RedeliveryOf:   m.RedeliveryOf,
+		EventType:      m.EventType,
+		Payload:        json.RawMessage(m.Payload),
+		Status:         webhook.DeliveryStatus(m.Status),
+		Attempts:       m.Attempts,
+		ResponseCode:   m.ResponseCode,
+		LastError:      m.LastError,
+		NextAttemptAt:  m.NextAttemptAt,
+		DeliveredAt:    m.DeliveredAt,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func WebhookDeliveryFromEntity(d *webhook.Delivery) *WebhookDelivery {
+	// Manual redeliveries are unique by themselves
+	dedupKey := d.SubscriptionID + ":" + d.MessageID
+	if d.RedeliveryOf != nil {
+		dedupKey = d.ID
+	}
+
+	return &WebhookDelivery{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		MessageID:      d.MessageID,
+		RedeliveryOf:   d.RedeliveryOf,
+		DedupKey:       dedupKey,
+		EventType:      d.EventType,
+		Payload:        JSON(d.Payload),
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		ResponseCode:   d.ResponseCode,
+		LastError:      d.LastError,
+		NextAttemptAt:  d.NextAttemptAt,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/notification"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/model"
+	"gorm.io/gorm"
+)
+
+type NotificationRepository struct {
+	db       *gorm.DB
+	executor *TxExecutor
+}
+
+func NewNotificationRepository(db *gorm.DB, executor *TxExecutor) *NotificationRepository {
+	return &NotificationRepository{
+		db:       db,
+		executor: executor,
+	}
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, notificationEntity *notification.Notification) error {
+	notificationModel := model.NotificationFromEntity(notificationEntity)
+
+	// Use CockroachDB transaction retry logic
+	return r.executor.Execute(ctx, "notification.create", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Create(notificationModel).Error
+	})
+}
+
+func (r *NotificationRepository) HasBeenSent(ctx context.Context, messageID, recipientID string) (bool, error) {
+	var count int64
+	err := r.executor.DB(ctx).Model(&model.Notification{}).
+		Where("message_id = ? AND recipient_id = ? AND status = ?", messageID, recipientID, notification.StatusSent).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *NotificationRepository) Count(ctx context.Context, filter notification.NotificationFilter) (int64, error) {
+	var count int64
+	query := r.executor.DB(ctx).Model(&model.Notification{})
+
+	query = r.applyFilter(query, filter)
+
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *NotificationRepository) List(ctx context.Context, filter notification.NotificationFilter) ([]*notification.Notification, error) {
+	var notificationModels []*model.Notification
+
+	query := r.executor.DB(ctx)
+	query = r.applyFilter(query, filter)
+
+	// Apply pagination
+	filter.WithDefaults()
+	query = query.Order("created_at DESC").Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
+
+	if err := query.Find(&notificationModels).Error; err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*notification.Notification, 0, len(notificationModels))
+	for _, notificationModel := range notificationModels {
+		notifications = append(notifications, notificationModel.NotificationToDomain())
+	}
+
+	return notifications, nil
+}
+
+func (r *NotificationRepository) applyFilter(query *gorm.DB, filter notification.NotificationFilter) *gorm.DB {
+	if filter.RecipientType != nil && *filter.RecipientType != "" {
+		query = query.Where("recipient_type = ?", *filter.RecipientType)
+	}
+	if filter.RecipientID != nil && *filter.RecipientID != "" {
+		query = query.Where("recipient_id = ?", *filter.RecipientID)
+	}
+	if filter.LoanID != nil && *filter.LoanID != "" {
+		query = query.Where("loan_id = ?", *filter.LoanID)
+	}
+	if filter.EventType != nil && *filter.EventType != "" {
+		query = query.Where("event_type = ?", *filter.EventType)
+	}
+	if filter.Status != nil && *filter.Status != "" {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	return query
+}
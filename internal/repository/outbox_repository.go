@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/outbox"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/model"
+	"gorm.io/gorm"
+)
+
+type OutboxRepository struct {
+	db       *gorm.DB
+	executor *TxExecutor
+}
+
+func NewOutboxRepository(db *gorm.DB, executor *TxExecutor) *OutboxRepository {
+	return &OutboxRepository{
+		db:       db,
+		executor: executor,
+	}
+}
+
+func (r *OutboxRepository) FetchPending(ctx context.Context, limit int) ([]*outbox.Message, error) {
+	var messageModels []*model.OutboxMessage
+	err := r.executor.DB(ctx).
+		Where("status = ? AND next_attempt_at <= ?", outbox.StatusPending, time.Now()).
+		Order("occurred_at").
+		Limit(limit).
+		Find(&messageModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*outbox.Message, 0, len(messageModels))
+	for _, messageModel := range messageModels {
+		messages = append(messages, messageModel.OutboxMessageToDomain())
+	}
+
+	return messages, nil
+}
+
+func (r *OutboxRepository) Save(ctx context.Context, message *outbox.Message) error {
+	messageModel := model.OutboxMessageFromEntity(message)
+
+	// Use CockroachDB transaction retry logic
+	return r.executor.Execute(ctx, "outbox.save", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Save(messageModel).Error
+	})
+}
+
+// insertLoanEvents writes the pending events of a loan to its event stream and to the outbox within tx
+func insertLoanEvents(ctx context.Context, tx *gorm.DB, events []loan.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	if err := appendLoanEvents(ctx, tx, events); err != nil {
+		return err
+	}
+
+	messages, err := model.OutboxMessagesFromLoanEvents(events)
+	if err != nil {
+		return err
+	}
+
+	return tx.WithContext(ctx).Create(&messages).Error
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/model"
+	"gorm.io/gorm"
+)
+
+type ProductRepository struct {
+	db       *gorm.DB
+	executor *TxExecutor
+}
+
+func NewProductRepository(db *gorm.DB, executor *TxExecutor) *ProductRepository {
+	return &ProductRepository{
+		db:       db,
+		executor: executor,
+	}
+}
+
+func (r *ProductRepository) Get(ctx context.Context, id string) (*product.LoanProduct, error) {
+	var productModel model.LoanProduct
+	if err := r.executor.DB(ctx).Where("id = ?", id).First(&productModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, product.ErrProductNotFound
+		}
+		return nil, err
+	}
+
+	return productModel.LoanProductToDomain(), nil
+}
+
+func (r *ProductRepository) Create(ctx context.Context, productEntity *product.LoanProduct) error {
+	productModel := model.LoanProductFromEntity(productEntity)
+
+	// Use CockroachDB transaction retry logic
+	return r.executor.Execute(ctx, "product.create", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Create(productModel).Error
+	})
+}
+
+func (r *ProductRepository) Save(ctx context.Context, productEntity *product.LoanProduct) error {
+	productModel := model.LoanProductFromEntity(productEntity)
+
+	// Use CockroachDB transaction retry logic
+	return r.executor.Execute(ctx, "product.save", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Save(productModel).Error
+	})
+}
+
+func (r *ProductRepository) Delete(ctx context.Context, id string) error {
+	// Use CockroachDB transaction retry logic
+	return r.executor.Execute(ctx, "product.delete", func(tx *gorm.DB) error {
+		result := tx.WithContext(ctx).Where("id = ?", id).Delete(&model.LoanProduct{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return product.ErrProductNotFound
+		}
+		return nil
+	})
+}
+
+func (r *ProductRepository) Count(ctx context.Context, filter product.ProductFilter) (int64, error) {
+	var count int64
+	query := r.executor.DB(ctx).Model(&model.LoanProduct{})
+
+	query = r.applyFilter(query, filter)
+
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *ProductRepository) List(ctx context.Context, filter product.ProductFilter) ([]*product.LoanProduct, error) {
+	var productModels []*model.LoanProduct
+
+	query := r.executor.DB(ctx)
+	query = r.applyFilter(query, filter)
+
+	// Apply pagination
+	filter.WithDefaults()
+	query = query.Order("created_at").Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
+
+	if err := query.Find(&productModels).Error; err != nil {
+		return nil, err
+	}
+
+	products := make([]*product.LoanProduct, 0, len(productModels))
+	for _, productModel := range productModels {
+		products = append(products, productModel.LoanProductToDomain())
+	}
+
+	return products, nil
+}
+
+func (r *ProductRepository) applyFilter(query *gorm.DB, filter product.ProductFilter) *gorm.DB {
+	if filter.Name != nil && *filter.Name != "" {
+		query = query.Where(`LOWER(name) LIKE ? ESCAPE '\'`, containsPattern(*filter.Name))
+	}
+	return query
+}
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// RetryPolicy tells how often and how long a transaction is retried after a retryable error
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration // Doubled on each retry, up to MaxBackoff
+	MaxBackoff     time.Duration
+	Deadline       time.Duration // For all the attempts together
+}
+
+func NewRetryPolicy(cfg *config.Config) RetryPolicy {
+	retry := cfg.Database.Retry
+	policy := RetryPolicy{
+		MaxAttempts:    retry.MaxAttempts,
+		InitialBackoff: retry.InitialBackoff,
+		MaxBackoff:     retry.MaxBackoff,
+		Deadline:       retry.Deadline,
+	}
+	return *policy.WithDefaults()
+}
+
+func (p *RetryPolicy) WithDefaults() *RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 5
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = 10 * time.Millisecond
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = time.Second
+	}
+	if p.Deadline <= 0 {
+		p.Deadline = 5 * time.Second
+	}
+	return p
+}
+
+// backoff returns the wait before a retry, a random duration up to the exponential backoff
+// so that conflicting transactions do not retry at the same time again
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	ceiling := p.MaxBackoff
+	if shift := retry - 1; shift < 32 {
+		ceiling = min(p.InitialBackoff<<shift, p.MaxBackoff)
+	}
+	return rand.N(ceiling) + 1
+}
+
+// TxExecutor runs the transactions of the repositories, retrying them with the policy when
+// they fail with a retryable error. It counts the retries of each operation.
+type TxExecutor struct {
+	db     *gorm.DB
+	policy RetryPolicy
+
+	mu      sync.Mutex
+	retries map[string]int64
+}
+
+func NewTxExecutor(db *gorm.DB, policy RetryPolicy) *TxExecutor {
+	return &TxExecutor{
+		db:      db,
+		policy:  *policy.WithDefaults(),
+		retries: map[string]int64{},
+	}
+}
+
+// Execute runs operation in a transaction, which is rolled back when it returns an error.
+// It gives up when the context is done, the deadline has passed or the attempts are used.
+// Within Transaction, operation joins the transaction of ctx instead.
+func (e *TxExecutor) Execute(ctx context.Context, name string, operation func(tx *gorm.DB) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return operation(tx)
+	}
+
+	deadline := time.Now().Add(e.policy.Deadline)
+
+	for attempt := 1; ; attempt++ {
+		err := e.db.WithContext(ctx).Transaction(operation)
+		if err == nil || !isCockroachRetryError(err) {
+			return err
+		}
+
+		if attempt >= e.policy.MaxAttempts {
+			return fmt.Errorf("%s failed after %d attempts: %w", name, attempt, err)
+		}
+		wait := e.policy.backoff(attempt)
+		if time.Now().Add(wait).After(deadline) {
+			return fmt.Errorf("%s failed, retry deadline of %s reached after %d attempts: %w", name, e.policy.Deadline, attempt, err)
+		}
+
+		e.countRetry(name)
+		logging.FromContext(ctx).Warn("Retrying transaction after a retryable error",
+			zap.String("operation", name), zap.Int("attempt", attempt), zap.Duration("backoff", wait), zap.Error(err))
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+type txKey struct{}
+
+// Transaction runs fn in one transaction, joined by the repositories it calls with ctx, and
+// rolls it back when fn returns an error. It is not retried, fn may have changed the entities
+// it was given: a retryable error is returned to the caller.
+func (e *TxExecutor) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return e.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// DB returns the database for the queries of a repository, the transaction of ctx within Transaction
+// so that they read its writes
+func (e *TxExecutor) DB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return e.db.WithContext(ctx)
+}
+
+func (e *TxExecutor) countRetry(name string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.retries[name]++
+}
+
+// OperationRetries is the number of retries of an operation since the start
+type OperationRetries struct {
+	Operation string `json:"operation"`
+	Retries   int64  `json:"retries"`
+}
+
+// Retries returns the retry counters of the operations retried at least once, by operation name
+func (e *TxExecutor) Retries() []OperationRetries {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	counters := make([]OperationRetries, 0, len(e.retries))
+	for name, retries := range e.retries {
+		counters = append(counters, OperationRetries{Operation: name, Retries: retries})
+	}
+	sort.Slice(counters, func(i, j int) bool {
+		return counters[i].Operation < counters[j].Operation
+	})
+	return counters
+}
+
+func isCockroachRetryError(err error) bool {
+	// CockroachDB retry error codes typically contain 40001 or
+	// message about transaction retry
+	return err != nil && (errors.Is(err, gorm.ErrInvalidTransaction) ||
+		containsAny(err.Error(), []string{"40001", "retry transaction", "restart transaction"}))
+}
+
+func containsAny(s string, substrings []string) bool {
+	for _, substr := range substrings {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+	return false
+}
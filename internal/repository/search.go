@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// partyFilter holds the search criteria shared by borrowers, lenders and employees
+type partyFilter struct {
+	FullName    *string
+	Email       *string
+	PhoneNumber *string
+	IDNumber    *string
+	Query       *string
+}
+
+// applyPartyFilter matches the full name partially and the email case-insensitively,
+// while `Query` searches across all the identifying columns at once
+func applyPartyFilter(query *gorm.DB, filter partyFilter) *gorm.DB {
+	if filter.FullName != nil && *filter.FullName != "" {
+		query = query.Where(`LOWER(full_name) LIKE ? ESCAPE '\'`, containsPattern(*filter.FullName))
+	}
+	if filter.Email != nil && *filter.Email != "" {
+		query = query.Where("LOWER(email) = ?", strings.ToLower(*filter.Email))
+	}
+	if filter.PhoneNumber != nil && *filter.PhoneNumber != "" {
+		query = query.Where("phone_number = ?", *filter.PhoneNumber)
+	}
+	if filter.IDNumber != nil && *filter.IDNumber != "" {
+		query = query.Where("id_number = ?", *filter.IDNumber)
+	}
+	if filter.Query != nil && *filter.Query != "" {
+		pattern := containsPattern(*filter.Query)
+		query = query.Where(
+			`LOWER(full_name) LIKE @q ESCAPE '\' OR LOWER(email) LIKE @q ESCAPE '\' OR LOWER(phone_number) LIKE @q ESCAPE '\' OR LOWER(id_number) LIKE @q ESCAPE '\'`,
+			map[string]interface{}{"q": pattern},
+		)
+	}
+
+	return query
+}
+
+// containsPattern builds a lower-cased LIKE pattern matching the term anywhere,
+// escaping the wildcards the user may have typed
+func containsPattern(term string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + replacer.Replace(strings.ToLower(strings.TrimSpace(term))) + "%"
+}
+
+// uniqueViolationColumn returns which of the given columns caused a unique constraint
+// violation, or an empty string when err is not a unique violation
+func uniqueViolationColumn(err error, columns ...string) string {
+	if err == nil || !containsAny(err.Error(), []string{"23505", "duplicate key", "UNIQUE constraint failed"}) {
+		return ""
+	}
+
+	for _, column := range columns {
+		if strings.Contains(err.Error(), column) {
+			return column
+		}
+	}
+
+	return ""
+}
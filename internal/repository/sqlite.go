@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+// sqliteParams are added to the SQLite DSN unless it sets them already. Transactions take
+// the write lock when they begin and wait for it, instead of failing when two of them
+// write at once, so the retries written for CockroachDB are never needed.
+var sqliteParams = []struct{ name, value string }{
+	{"_txlock", "immediate"},
+	{"_pragma", "busy_timeout(5000)"},
+	{"_pragma", "foreign_keys(1)"},
+	{"_pragma", "journal_mode(WAL)"},
+	{"_time_format", "sqlite"},
+}
+
+// sqliteDialector opens an SQLite database file, creating the columns declared with the
+// PostgreSQL uuid and jsonb types of the models as text
+type sqliteDialector struct {
+	*sqlite.Dialector
+}
+
+func newSQLiteDialector(dsn string) gorm.Dialector {
+	return sqliteDialector{Dialector: sqlite.Open(sqliteDSN(dsn)).(*sqlite.Dialector)}
+}
+
+func (d sqliteDialector) DataTypeOf(field *schema.Field) string {
+	switch strings.ToLower(string(field.DataType)) {
+	case "uuid", "jsonb":
+		return "text"
+	}
+	return d.Dialector.DataTypeOf(field)
+}
+
+// Migrator uses the SQLite migrator with the column types of this dialector
+func (d sqliteDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return sqlite.Migrator{Migrator: migrator.Migrator{Config: migrator.Config{
+		DB:                          db,
+		Dialector:                   d,
+		CreateIndexAfterCreateTable: true,
+	}}}
+}
+
+// sqliteDSN adds the sqliteParams missing from the DSN, a file path optionally followed by a query
+func sqliteDSN(dsn string) string {
+	path, rawQuery, _ := strings.Cut(dsn, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return dsn
+	}
+
+	for _, param := range sqliteParams {
+		if param.name == "_pragma" {
+			pragma, _, _ := strings.Cut(param.value, "(")
+			if strings.Contains(strings.ToLower(strings.Join(query[param.name], ",")), pragma) {
+				continue
+			}
+		} else if query.Has(param.name) {
+			continue
+		}
+		query.Add(param.name, param.value)
+	}
+
+	return path + "?" + query.Encode()
+}
+
+// sqliteTimeFormats are the layouts SQLite returns times in, when it cannot tell a column
+// holds times, as for the result of MIN or MAX
+var sqliteTimeFormats = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// aggregateTime scans a computed time column, which PostgreSQL returns as a time and
+// SQLite as text
+type aggregateTime struct {
+	time.Time
+}
+
+func (t *aggregateTime) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		t.Time = time.Time{}
+		return nil
+	case time.Time:
+		t.Time = v
+		return nil
+	case []byte:
+		return t.parse(string(v))
+	case string:
+		return t.parse(v)
+	}
+	return fmt.Errorf("cannot scan %T into a time", value)
+}
+
+func (t aggregateTime) Value() (driver.Value, error) {
+	return t.Time, nil
+}
+
+func (t *aggregateTime) parse(value string) error {
+	for _, layout := range sqliteTimeFormats {
+		if parsed, err := time.Parse(layout, strings.TrimSuffix(value, "Z")); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot parse %q as a time", value)
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository"
+	"gorm.io/gorm"
+)
+
+// errSerialization is the error CockroachDB returns when a transaction has to be retried
+var errSerialization = errors.New("ERROR: restart transaction: TransactionRetryWithProtoRefreshError (SQLSTATE 40001)")
+
+func newExecutor(t *testing.T, policy repository.RetryPolicy) *repository.TxExecutor {
+	cfg := &config.Config{}
+	cfg.Database.Type = config.DatabaseTypeSQLite
+	cfg.Database.URL = filepath.Join(t.TempDir(), "retry.db")
+
+	db, err := repository.NewDatabase(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return repository.NewTxExecutor(db.DB, policy)
+}
+
+func TestTxExecutor(t *testing.T) {
+	ctx := context.Background()
+	policy := repository.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond, Deadline: time.Second}
+
+	t.Run("should retry a serialization failure until it succeeds", func(t *testing.T) {
+		executor := newExecutor(t, policy)
+
+		attempts := 0
+		err := executor.Execute(ctx, "loan.save", func(tx *gorm.DB) error {
+			attempts++
+			if attempts < 3 {
+				return errSerialization
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t, []repository.OperationRetries{{Operation: "loan.save", Retries: 2}}, executor.Retries())
+	})
+
+	t.Run("should give up after the maximum attempts", func(t *testing.T) {
+		executor := newExecutor(t, policy)
+
+		attempts := 0
+		err := executor.Execute(ctx, "wallet.apply", func(tx *gorm.DB) error {
+			attempts++
+			return errSerialization
+		})
+		assert.ErrorIs(t, err, errSerialization)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("should not retry other errors", func(t *testing.T) {
+		executor := newExecutor(t, policy)
+		errInvalid := errors.New("invalid amount")
+
+		attempts := 0
+		err := executor.Execute(ctx, "wallet.apply", func(tx *gorm.DB) error {
+			attempts++
+			return errInvalid
+		})
+		assert.ErrorIs(t, err, errInvalid)
+		assert.Equal(t, 1, attempts)
+		assert.Empty(t, executor.Retries())
+	})
+
+	t.Run("should stop waiting when the context is cancelled", func(t *testing.T) {
+		executor := newExecutor(t, repository.RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Minute, MaxBackoff: time.Minute, Deadline: time.Hour})
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		started := time.Now()
+		err := executor.Execute(ctx, "loan.save", func(tx *gorm.DB) error {
+			return errSerialization
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(started), 5*time.Second)
+	})
+
+	t.Run("should give up when the next retry would pass the deadline", func(t *testing.T) {
+		executor := newExecutor(t, repository.RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Second, MaxBackoff: time.Second, Deadline: time.Millisecond})
+
+		attempts := 0
+		err := executor.Execute(ctx, "loan.save", func(tx *gorm.DB) error {
+			attempts++
+			return errSerialization
+		})
+		assert.ErrorIs(t, err, errSerialization)
+		assert.LessOrEqual(t, attempts, 2)
+	})
+}
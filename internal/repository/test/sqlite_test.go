@@ -0,0 +1,331 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/document"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/interest"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository"
+	"github.com/theodorusyoga/loan-service-state-machine/migrations"
+	fxpkg "github.com/theodorusyoga/loan-service-state-machine/pkg/fx"
+	"go.uber.org/fx"
+)
+
+func TestSQLiteBackend(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := &config.Config{}
+	cfg.Database.Type = config.DatabaseTypeSQLite
+	cfg.Database.URL = filepath.Join(t.TempDir(), "loans.db")
+
+	db, err := repository.NewDatabase(cfg)
+	require.NoError(t, err)
+	migrator, err := migrations.NewMigrator(db.DB, "sqlite")
+	require.NoError(t, err)
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	var (
+		database  *repository.Database
+		loans     *loan.LoanService
+		borrowers *borrower.BorrowerService
+		lenders   *lender.LenderService
+		employees *employee.EmployeeService
+		products  *product.ProductService
+		wallets   *wallet.WalletService
+		portfolio *loanlender.LoanLenderService
+		tx        loan.Transactor
+
+		loanRepo     loan.Repository
+		documentRepo document.Repository
+		eventStore   loan.EventStore
+		borrowerRepo borrower.Repository
+		lenderRepo   lender.Repository
+		employeeRepo employee.Repository
+	)
+	app := fx.New(
+		fx.NopLogger,
+		fx.Supply(cfg),
+		fxpkg.InfrastructureModule,
+		fxpkg.DomainModule,
+		fx.Provide(fxpkg.ProvideValidator),
+		fx.Populate(&database, &loans, &borrowers, &lenders, &employees, &products, &wallets, &portfolio, &tx, &loanRepo, &documentRepo, &eventStore, &borrowerRepo, &lenderRepo, &employeeRepo),
+	)
+	require.NoError(t, app.Err())
+	defer database.Close()
+
+	b, err := borrowers.CreateBorrower(ctx, "Jane Doe", "jane@example.com", "0812000001", "3171000000000001", nil)
+	require.NoError(t, err)
+	officer, err := employees.CreateEmployee(ctx, "John Doe", "john@example.com", "0812000002", "3171000000000002")
+	require.NoError(t, err)
+	investor, err := lenders.CreateLender(ctx, "Ann Smith", "ann@example.com", "0812000003", "3171000000000003")
+	require.NoError(t, err)
+	_, err = wallets.Deposit(ctx, investor.ID, 1000, "Top up")
+	require.NoError(t, err)
+	p, err := products.CreateProduct(ctx, product.Details{
+		Name: "Micro loan", MinAmount: 500, MaxAmount: 5000, MinRate: 8, MaxRate: 12, MinROI: 6, MaxROI: 10,
+		TenorMonths: []int{6, 12}, InterestMethod: interest.MethodFlat, RequiredDocuments: []string{"id_card"},
+	})
+	require.NoError(t, err)
+
+	var disbursedID string
+
+	t.Run("should refuse a taken email", func(t *testing.T) {
+		_, err := borrowers.CreateBorrower(ctx, "Jim Doe", "jane@example.com", "0812000004", "3171000000000004", nil)
+		assert.ErrorIs(t, err, borrower.ErrEmailTaken)
+	})
+
+	t.Run("should refuse a taken ID number", func(t *testing.T) {
+		_, err := borrowers.CreateBorrower(ctx, "Jim Doe", "jim@example.com", "0812000004", "3171000000000001", nil)
+		assert.ErrorIs(t, err, borrower.ErrIDNumberTaken)
+	})
+
+	// Stored straight through the repositories, skipping the registration check, so only the
+	// unique indexes stand in the way
+	t.Run("should refuse an email differing only in case in the database", func(t *testing.T) {
+		err := borrowerRepo.Create(ctx, borrower.NewBorrower("Jim Doe", "JANE@example.com", "0812000004", "3171000000000004"))
+		assert.ErrorIs(t, err, borrower.ErrEmailTaken)
+		err = lenderRepo.Create(ctx, lender.NewLender("Al Smith", "Ann@Example.com", "0812000004", "3171000000000004"))
+		assert.ErrorIs(t, err, lender.ErrEmailTaken)
+		err = employeeRepo.Create(ctx, employee.NewEmployee("Joe Doe", "JOHN@example.com", "0812000004", "3171000000000004"))
+		assert.ErrorIs(t, err, employee.ErrEmailTaken)
+	})
+
+	t.Run("should refuse a taken ID number in the database", func(t *testing.T) {
+		err := borrowerRepo.Create(ctx, borrower.NewBorrower("Jim Doe", "jim@example.com", "0812000004", "3171000000000001"))
+		assert.ErrorIs(t, err, borrower.ErrIDNumberTaken)
+		err = lenderRepo.Create(ctx, lender.NewLender("Al Smith", "al@example.com", "0812000004", "3171000000000003"))
+		assert.ErrorIs(t, err, lender.ErrIDNumberTaken)
+		err = employeeRepo.Create(ctx, employee.NewEmployee("Joe Doe", "joe@example.com", "0812000004", "3171000000000002"))
+		assert.ErrorIs(t, err, employee.ErrIDNumberTaken)
+	})
+
+	t.Run("should read a product back", func(t *testing.T) {
+		stored, err := products.GetByID(ctx, p.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []int{6, 12}, stored.TenorMonths)
+		assert.Equal(t, []string{"id_card"}, stored.RequiredDocuments)
+		assert.Equal(t, interest.MethodFlat, stored.InterestMethod)
+	})
+
+	t.Run("should take a loan from proposal to disbursement", func(t *testing.T) {
+		created, err := loans.CreateLoan(ctx, b.ID, p.ID, 1000, 10, 8, interest.Terms{TenorMonths: 12})
+		require.NoError(t, err)
+
+		l, err := loans.GetByID(ctx, created.ID)
+		require.NoError(t, err)
+		require.NoError(t, loans.ApproveLoan(ctx, l, officer.ID, "survey.jpg"))
+
+		for _, amount := range []float64{400, 600} {
+			l, err = loans.GetByID(ctx, created.ID)
+			require.NoError(t, err)
+			_, err = loans.InvestLoan(ctx, l, investor, amount)
+			require.NoError(t, err)
+		}
+
+		l, err = loans.GetByID(ctx, created.ID)
+		require.NoError(t, err)
+		disbursed, err := loans.DisburseLoan(ctx, l, officer.ID, "signed.pdf")
+		require.NoError(t, err)
+		require.Len(t, disbursed.Investors, 2)
+		disbursedID = created.ID
+
+		investments, err := portfolio.GetByLoan(ctx, created.ID)
+		require.NoError(t, err)
+		require.Len(t, investments, 2)
+		assert.Equal(t, 400.0, investments[0].Amount)
+		assert.Equal(t, 600.0, investments[1].Amount)
+
+		l, err = loans.GetByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, loan.StatusDisbursed, l.Status)
+		require.NotNil(t, l.ProductID)
+		assert.Equal(t, p.ID, *l.ProductID)
+
+		statuses := []loan.Status{}
+		for _, transition := range l.StatusTransitions {
+			statuses = append(statuses, transition.To)
+		}
+		assert.Equal(t, []loan.Status{loan.StatusProposed, loan.StatusApproved, loan.StatusInvested, loan.StatusDisbursed}, statuses)
+
+		// The first and last investment times are computed by SQLite, which returns them as text
+		result, err := portfolio.GetPortfolio(ctx, loanlender.PositionFilter{LenderID: investor.ID})
+		require.NoError(t, err)
+		assert.Equal(t, 1000.0, result.TotalInvested)
+		assert.Equal(t, 80.0, result.ExpectedReturn)
+		positions := result.Positions.Data.([]*loanlender.Position)
+		require.Len(t, positions, 1)
+		assert.Equal(t, 80.0, positions[0].ExpectedReturn)
+		assert.False(t, positions[0].FirstInvestedAt.IsZero())
+		assert.False(t, positions[0].LastInvestedAt.Before(positions[0].FirstInvestedAt))
+	})
+	t.Run("should read the same loan from the loans table and from its events", func(t *testing.T) {
+		require.NotEmpty(t, disbursedID)
+		projected, err := loanRepo.Get(ctx, disbursedID)
+		require.NoError(t, err)
+		rebuilt, err := loan.NewEventSourcedRepository(loanRepo, documentRepo, eventStore, 0).Get(ctx, disbursedID)
+		require.NoError(t, err)
+
+		assert.Equal(t, projected.Status, rebuilt.Status)
+		assert.Equal(t, projected.ApprovedBy, rebuilt.ApprovedBy)
+		assert.Equal(t, projected.DisbursedBy, rebuilt.DisbursedBy)
+		assert.Equal(t, projected.SurveyDocumentID, rebuilt.SurveyDocumentID)
+		assert.Equal(t, projected.AgreementDocumentID, rebuilt.AgreementDocumentID)
+		require.NotNil(t, rebuilt.SurveyDocument)
+		require.NotNil(t, rebuilt.AgreementDocument)
+		assert.Equal(t, projected.SurveyDocument, rebuilt.SurveyDocument)
+		assert.Equal(t, projected.AgreementDocument, rebuilt.AgreementDocument)
+		assert.Equal(t, "signed.pdf", rebuilt.AgreementDocument.FileName)
+	})
+
+	// The events of a loan created before the event store start with its first transition
+	t.Run("should rebuild a transition of a loan created before the event store", func(t *testing.T) {
+		created, err := loans.CreateLoan(ctx, b.ID, p.ID, 1000, 10, 8, interest.Terms{TenorMonths: 12})
+		require.NoError(t, err)
+		require.NoError(t, database.DB.WithContext(ctx).Exec("DELETE FROM loan_events WHERE loan_id = ?", created.ID).Error)
+		eventSourced := loan.NewEventSourcedRepository(loanRepo, documentRepo, eventStore, 0)
+
+		l, err := eventSourced.Get(ctx, created.ID)
+		require.NoError(t, err)
+		require.NoError(t, loans.ApproveLoan(ctx, l, officer.ID, "survey.jpg"))
+
+		rebuilt, err := eventSourced.Get(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, loan.StatusApproved, rebuilt.Status)
+		assert.Equal(t, officer.ID, *rebuilt.ApprovedBy)
+		assert.Equal(t, 1000.0, rebuilt.Amount)
+		statuses := []loan.Status{}
+		for _, transition := range rebuilt.StatusTransitions {
+			statuses = append(statuses, transition.To)
+		}
+		assert.Equal(t, []loan.Status{loan.StatusProposed, loan.StatusApproved}, statuses)
+
+		replayed, err := loan.NewReplayer(eventStore, nil).Replay(ctx, created.ID, true)
+		require.NoError(t, err)
+		assert.Equal(t, loan.StatusApproved, replayed.Status)
+	})
+
+	t.Run("should report the investments in a cancelled loan as released", func(t *testing.T) {
+		saver, err := lenders.CreateLender(ctx, "Tom Smith", "tom@example.com", "0812000010", "3171000000000010")
+		require.NoError(t, err)
+		_, err = wallets.Deposit(ctx, saver.ID, 1000, "Top up")
+		require.NoError(t, err)
+
+		created, err := loans.CreateLoan(ctx, b.ID, p.ID, 1000, 10, 8, interest.Terms{TenorMonths: 12})
+		require.NoError(t, err)
+		l, err := loans.GetByID(ctx, created.ID)
+		require.NoError(t, err)
+		require.NoError(t, loans.ApproveLoan(ctx, l, officer.ID, "survey.jpg"))
+		l, err = loans.GetByID(ctx, created.ID)
+		require.NoError(t, err)
+		_, err = loans.InvestLoan(ctx, l, saver, 500)
+		require.NoError(t, err)
+		l, err = loans.GetByID(ctx, created.ID)
+		require.NoError(t, err)
+		require.NoError(t, loans.CancelLoan(ctx, l, officer.ID, "Borrower withdrew"))
+
+		result, err := portfolio.GetPortfolio(ctx, loanlender.PositionFilter{LenderID: saver.ID})
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, result.TotalInvested)
+		assert.Equal(t, 0.0, result.ExpectedReturn)
+		assert.Equal(t, 500.0, result.Released)
+		assert.Equal(t, []loanlender.PortfolioStatus{{Status: "cancelled", Loans: 1, Invested: 500}}, result.ByStatus)
+		positions := result.Positions.Data.([]*loanlender.Position)
+		require.Len(t, positions, 1)
+		assert.Equal(t, 0.0, positions[0].ExpectedReturn)
+	})
+
+	t.Run("should create a wallet on the first deposit of an existing lender", func(t *testing.T) {
+		_, err := wallets.Deposit(ctx, "00000000-0000-0000-0000-000000000000", 100, "Top up")
+		assert.ErrorIs(t, err, lender.ErrLenderNotFound)
+		_, err = wallets.GetByLenderID(ctx, "00000000-0000-0000-0000-000000000000")
+		assert.ErrorIs(t, err, wallet.ErrWalletNotFound)
+
+		saver, err := lenders.CreateLender(ctx, "Kim Smith", "kim@example.com", "0812000011", "3171000000000011")
+		require.NoError(t, err)
+		_, err = wallets.GetByLenderID(ctx, saver.ID)
+		assert.ErrorIs(t, err, wallet.ErrWalletNotFound)
+
+		for range 2 {
+			_, err = wallets.Deposit(ctx, saver.ID, 100, "Top up")
+			require.NoError(t, err)
+		}
+		w, err := wallets.GetByLenderID(ctx, saver.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 200.0, w.Balance)
+	})
+
+	t.Run("should roll back the changes made in a failed transaction", func(t *testing.T) {
+		saver, err := lenders.CreateLender(ctx, "Sue Smith", "sue@example.com", "0812000008", "3171000000000008")
+		require.NoError(t, err)
+		_, err = wallets.Deposit(ctx, saver.ID, 1000, "Top up")
+		require.NoError(t, err)
+
+		failure := errors.New("loan could not be saved")
+		err = tx.Transaction(ctx, func(ctx context.Context) error {
+			if _, err := wallets.Withdraw(ctx, saver.ID, 400, "Cash out"); err != nil {
+				return err
+			}
+			// The transaction reads its own writes
+			w, err := wallets.GetByLenderID(ctx, saver.ID)
+			require.NoError(t, err)
+			assert.Equal(t, 600.0, w.Balance)
+			return failure
+		})
+		assert.ErrorIs(t, err, failure)
+
+		w, err := wallets.GetByLenderID(ctx, saver.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1000.0, w.Balance)
+		history, err := wallets.ListTransactions(ctx, wallet.TransactionFilter{LenderID: &saver.ID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), history.Pagination.TotalItems)
+	})
+
+	t.Run("should refuse concurrent withdrawals spending the same funds", func(t *testing.T) {
+		saver, err := lenders.CreateLender(ctx, "Sam Smith", "sam@example.com", "0812000009", "3171000000000009")
+		require.NoError(t, err)
+		_, err = wallets.Deposit(ctx, saver.ID, 1000, "Top up")
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		errs := make([]error, 10)
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = wallets.Withdraw(ctx, saver.ID, 300, "Cash out")
+			}()
+		}
+		wg.Wait()
+
+		withdrawn := 0
+		for _, err := range errs {
+			if err == nil {
+				withdrawn++
+			} else {
+				assert.ErrorIs(t, err, wallet.ErrInsufficientBalance)
+			}
+		}
+		assert.Equal(t, 3, withdrawn)
+
+		w, err := wallets.GetByLenderID(ctx, saver.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 100.0, w.Balance)
+	})
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WalletRepository struct {
+	db       *gorm.DB
+	executor *TxExecutor
+}
+
+func NewWalletRepository(db *gorm.DB, executor *TxExecutor) *WalletRepository {
+	return &WalletRepository{
+		db:       db,
+		executor: executor,
+	}
+}
+
+func (r *WalletRepository) GetByLenderID(ctx context.Context, lenderID string) (*wallet.Wallet, error) {
+	var walletModel model.Wallet
+	if err := r.executor.DB(ctx).Where("lender_id = ?", lenderID).First(&walletModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, wallet.ErrWalletNotFound
+		}
+		return nil, err
+	}
+
+	return walletModel.WalletToDomain(), nil
+}
+
+func (r *WalletRepository) Create(ctx context.Context, walletEntity *wallet.Wallet) error {
+	return r.executor.Execute(ctx, "wallet.create", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).
+			Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "lender_id"}}, DoNothing: true}).
+			Create(model.WalletFromEntity(walletEntity)).Error
+	})
+}
+
+func (r *WalletRepository) Apply(ctx context.Context, walletEntity *wallet.Wallet, transaction *wallet.Transaction) error {
+	balance, reserved := transaction.Changes()
+
+	// Use CockroachDB transaction retry logic
+	return r.executor.Execute(ctx, "wallet.apply", func(tx *gorm.DB) error {
+		// The funds are moved by one conditional update of the stored wallet, so that concurrent
+		// reservations or withdrawals cannot both spend them
+		result := tx.WithContext(ctx).Model(&model.Wallet{}).
+			Where("id = ? AND reserved + ? >= 0 AND balance + ? - (reserved + ?) >= 0", walletEntity.ID, reserved, balance, reserved).
+			Updates(map[string]interface{}{
+				"balance":    gorm.Expr("balance + ?", balance),
+				"reserved":   gorm.Expr("reserved + ?", reserved),
+				"updated_at": transaction.CreatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			if reserved < 0 {
+				return wallet.ErrInsufficientReserve
+			}
+			return wallet.ErrInsufficientBalance
+		}
+
+		var walletModel model.Wallet
+		if err := tx.WithContext(ctx).Where("id = ?", walletEntity.ID).First(&walletModel).Error; err != nil {
+			return err
+		}
+		*walletEntity = *walletModel.WalletToDomain()
+		transaction.BalanceAfter = walletModel.Balance
+		transaction.ReservedAfter = walletModel.Reserved
+
+		return tx.WithContext(ctx).Create(model.WalletTransactionFromEntity(transaction)).Error
+	})
+}
+
+func (r *WalletRepository) CountTransactions(ctx context.Context, filter wallet.TransactionFilter) (int64, error) {
+	var count int64
+	query := r.executor.DB(ctx).Model(&model.WalletTransaction{})
+
+	query = r.applyFilter(query, filter)
+
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *WalletRepository) ListTransactions(ctx context.Context, filter wallet.TransactionFilter) ([]*wallet.Transaction, error) {
+	var transactionModels []*model.WalletTransaction
+
+	query := r.executor.DB(ctx)
+	query = r.applyFilter(query, filter)
+
+	// Apply pagination
+	filter.WithDefaults()
+	query = query.Order("created_at DESC").Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
+
+	if err := query.Find(&transactionModels).Error; err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*wallet.Transaction, len(transactionModels))
+	for i, v := range transactionModels {
+		transactions[i] = v.WalletTransactionToDomain()
+	}
+
+	return transactions, nil
+}
+
+func (r *WalletRepository) applyFilter(query *gorm.DB, filter wallet.TransactionFilter) *gorm.DB {
+	if filter.LenderID != nil && *filter.LenderID != "" {
+		query = query.Where("lender_id = ?", *filter.LenderID)
+	}
+	if filter.LoanID != nil && *filter.LoanID != "" {
+		query = query.Where("loan_id = ?", *filter.LoanID)
+	}
+	if filter.Type != nil && *filter.Type != "" {
+		query = query.Where("type = ?", string(*filter.Type))
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at <= ?", *filter.To)
+	}
+
+	return query
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/webhook"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookRepository struct {
+	db       *gorm.DB
+	executor *TxExecutor
+}
+
+func NewWebhookRepository(db *gorm.DB, executor *TxExecutor) *WebhookRepository {
+	return &WebhookRepository{
+		db:       db,
+		executor: executor,
+	}
+}
+
+func (r *WebhookRepository) GetSubscription(ctx context.Context, id string) (*webhook.Subscription, error) {
+	var subscriptionModel model.WebhookSubscription
+	if err := r.executor.DB(ctx).Where("id = ?", id).First(&subscriptionModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, webhook.ErrSubscriptionNotFound
+		}
+		return nil, err
+	}
+
+	return subscriptionModel.WebhookSubscriptionToDomain(), nil
+}
+
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, subscription *webhook.Subscription) error {
+	subscriptionModel := model.WebhookSubscriptionFromEntity(subscription)
+
+	// Use CockroachDB transaction retry logic
+	return r.executor.Execute(ctx, "webhook.create_subscription", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Create(subscriptionModel).Error
+	})
+}
+
+func (r *WebhookRepository) SaveSubscription(ctx context.Context, subscription *webhook.Subscription) error {
+	subscriptionModel := model.WebhookSubscriptionFromEntity(subscription)
+
+	// Use CockroachDB transaction retry logic
+	return r.executor.Execute(ctx, "webhook.save_subscription", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Save(subscriptionModel).Error
+	})
+}
+
+func (r *WebhookRepository) CountSubscriptions(ctx context.Context, filter webhook.SubscriptionFilter) (int64, error) {
+	var count int64
+	query := r.executor.DB(ctx).Model(&model.WebhookSubscription{})
+
+	query = r.applySubscriptionFilter(query, filter)
+
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *WebhookRepository) ListSubscriptions(ctx context.Context, filter webhook.SubscriptionFilter) ([]*webhook.Subscription, error) {
+	var subscriptionModels []*model.WebhookSubscription
+
+	query := r.executor.DB(ctx)
+	query = r.applySubscriptionFilter(query, filter)
+
+	// Apply pagination
+	filter.WithDefaults()
+	query = query.Order("created_at").Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
+
+	if err := query.Find(&subscriptionModels).Error; err != nil {
+		return nil, err
+	}
+
+	subscriptions := make([]*webhook.Subscription, 0, len(subscriptionModels))
+	for _, subscriptionModel := range subscriptionModels {
+		subscriptions = append(subscriptions, subscriptionModel.WebhookSubscriptionToDomain())
+	}
+
+	return subscriptions, nil
+}
+
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error) {
+	var deliveryModel model.WebhookDelivery
+	if err := r.executor.DB(ctx).Where("id = ?", id).First(&deliveryModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, webhook.ErrDeliveryNotFound
+		}
+		return nil, err
+	}
+
+	return deliveryModel.WebhookDeliveryToDomain(), nil
+}
+
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	deliveryModel := model.WebhookDeliveryFromEntity(delivery)
+
+	// Use CockroachDB transaction retry logic
+	return r.executor.Execute(ctx, "webhook.create_delivery", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).
+			Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "dedup_key"}}, DoNothing: true}).
+			Create(deliveryModel).Error
+	})
+}
+
+func (r *WebhookRepository) SaveDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	deliveryModel := model.WebhookDeliveryFromEntity(delivery)
+
+	// Use CockroachDB transaction retry logic
+	return r.executor.Execute(ctx, "webhook.save_delivery", func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Save(deliveryModel).Error
+	})
+}
+
+func (r *WebhookRepository) CountDeliveries(ctx context.Context, filter webhook.DeliveryFilter) (int64, error) {
+	var count int64
+	query := r.executor.DB(ctx).Model(&model.WebhookDelivery{})
+
+	query = r.applyDeliveryFilter(query, filter)
+
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, filter webhook.DeliveryFilter) ([]*webhook.Delivery, error) {
+	var deliveryModels []*model.WebhookDelivery
+
+	query := r.executor.DB(ctx)
+	query = r.applyDeliveryFilter(query, filter)
+
+	// Apply pagination
+	filter.WithDefaults()
+	query = query.Order("created_at DESC").Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
+
+	if err := query.Find(&deliveryModels).Error; err != nil {
+		return nil, err
+	}
+
+	return deliveriesToDomain(deliveryModels), nil
+}
+
+func (r *WebhookRepository) FetchDueDeliveries(ctx context.Context, limit int) ([]*webhook.Delivery, error) {
+	var deliveryModels []*model.WebhookDelivery
+	err := r.executor.DB(ctx).
+		Where("status = ? AND next_attempt_at <= ?", webhook.DeliveryStatusPending, time.Now()).
+		Order("next_attempt_at").
+		Limit(limit).
+		Find(&deliveryModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveriesToDomain(deliveryModels), nil
+}
+
+func (r *WebhookRepository) applySubscriptionFilter(query *gorm.DB, filter webhook.SubscriptionFilter) *gorm.DB {
+	if filter.Active != nil {
+		query = query.Where("active = ?", *filter.Active)
+	}
+
+	return query
+}
+
+func (r *WebhookRepository) applyDeliveryFilter(query *gorm.DB, filter webhook.DeliveryFilter) *gorm.DB {
+	if filter.SubscriptionID != nil && *filter.SubscriptionID != "" {
+		query = query.Where("subscription_id = ?", *filter.SubscriptionID)
+	}
+	if filter.Status != nil && *filter.Status != "" {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if filter.EventType != nil && *filter.EventType != "" {
+		query = query.Where("event_type = ?", *filter.EventType)
+	}
+
+	return query
+}
+
+func deliveriesToDomain(deliveryModels []*model.WebhookDelivery) []*webhook.Delivery {
+	deliveries := make([]*webhook.Delivery, 0, len(deliveryModels))
+	for _, deliveryModel := range deliveryModels {
+		deliveries = append(deliveries, deliveryModel.WebhookDeliveryToDomain())
+	}
+	return deliveries
+}
@@ -0,0 +1,44 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
+)
+
+// MockAuditRepository is a mock implementation of audit.Repository
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+// Ensure MockAuditRepository implements audit.Repository interface
+var _ audit.Repository = (*MockAuditRepository)(nil)
+
+// Append records an audit entry
+func (m *MockAuditRepository) Append(ctx context.Context, entry *audit.Entry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+// List retrieves audit entries based on filter criteria
+func (m *MockAuditRepository) List(ctx context.Context, filter audit.AuditFilter) ([]*audit.Entry, error) {
+	args := m.Called(ctx, filter)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*audit.Entry), args.Error(1)
+}
+
+// Count returns the number of audit entries matching the filter criteria
+func (m *MockAuditRepository) Count(ctx context.Context, filter audit.AuditFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// NewMockAuditRepository creates a new instance of MockAuditRepository
+func NewMockAuditRepository() *MockAuditRepository {
+	return &MockAuditRepository{}
+}
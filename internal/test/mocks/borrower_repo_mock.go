@@ -0,0 +1,67 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
+)
+
+// MockBorrowerRepository is a mock implementation of borrower.Repository
+type MockBorrowerRepository struct {
+	mock.Mock
+}
+
+// Ensure MockBorrowerRepository implements borrower.Repository interface
+var _ borrower.Repository = (*MockBorrowerRepository)(nil)
+
+// Get retrieves a borrower by ID
+func (m *MockBorrowerRepository) Get(ctx context.Context, id string) (*borrower.Borrower, error) {
+	args := m.Called(ctx, id)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*borrower.Borrower), args.Error(1)
+}
+
+// Save updates an existing borrower
+func (m *MockBorrowerRepository) Save(ctx context.Context, b *borrower.Borrower) error {
+	args := m.Called(ctx, b)
+	return args.Error(0)
+}
+
+// Create inserts a new borrower
+func (m *MockBorrowerRepository) Create(ctx context.Context, b *borrower.Borrower) error {
+	args := m.Called(ctx, b)
+	return args.Error(0)
+}
+
+// CreateBatch inserts several borrowers at once
+func (m *MockBorrowerRepository) CreateBatch(ctx context.Context, borrowers []*borrower.Borrower) error {
+	args := m.Called(ctx, borrowers)
+	return args.Error(0)
+}
+
+// List retrieves borrowers based on filter criteria
+func (m *MockBorrowerRepository) List(ctx context.Context, filter borrower.BorrowerFilter) ([]*borrower.Borrower, error) {
+	args := m.Called(ctx, filter)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*borrower.Borrower), args.Error(1)
+}
+
+// Count returns the number of borrowers matching the filter criteria
+func (m *MockBorrowerRepository) Count(ctx context.Context, filter borrower.BorrowerFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// NewMockBorrowerRepository creates a new instance of MockBorrowerRepository
+func NewMockBorrowerRepository() *MockBorrowerRepository {
+	return &MockBorrowerRepository{}
+}
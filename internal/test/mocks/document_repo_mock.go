@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/document"
+)
+
+// MockDocumentRepository is a mock implementation of document.Repository
+type MockDocumentRepository struct {
+	mock.Mock
+}
+
+// Ensure MockDocumentRepository implements document.Repository interface
+var _ document.Repository = (*MockDocumentRepository)(nil)
+
+// NewMockDocumentRepository creates a new instance of MockDocumentRepository
+func NewMockDocumentRepository() *MockDocumentRepository {
+	return &MockDocumentRepository{}
+}
+
+// Get retrieves a document by ID
+func (m *MockDocumentRepository) Get(ctx context.Context, id string) (*document.Document, error) {
+	args := m.Called(ctx, id)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*document.Document), args.Error(1)
+}
+
+// Save updates an existing document
+func (m *MockDocumentRepository) Save(ctx context.Context, d *document.Document) error {
+	args := m.Called(ctx, d)
+	return args.Error(0)
+}
+
+// Create inserts a new document and returns its ID
+func (m *MockDocumentRepository) Create(ctx context.Context, d *document.Document) (string, error) {
+	args := m.Called(ctx, d)
+	return args.String(0), args.Error(1)
+}
+
+// List retrieves documents based on filter criteria
+func (m *MockDocumentRepository) List(ctx context.Context, filter document.DocumentFilter) ([]*document.Document, error) {
+	args := m.Called(ctx, filter)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*document.Document), args.Error(1)
+}
+
+// Count returns the number of documents matching the filter
+func (m *MockDocumentRepository) Count(ctx context.Context, filter document.DocumentFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
@@ -39,6 +39,12 @@ func (m *MockEmployeeRepository) Create(ctx context.Context, emp *employee.Emplo
 	return args.Error(0)
 }
 
+// CreateBatch inserts several employees at once
+func (m *MockEmployeeRepository) CreateBatch(ctx context.Context, employees []*employee.Employee) error {
+	args := m.Called(ctx, employees)
+	return args.Error(0)
+}
+
 // List retrieves employees based on filter criteria
 func (m *MockEmployeeRepository) List(ctx context.Context, filter employee.EmployeeFilter) ([]*employee.Employee, error) {
 	args := m.Called(ctx, filter)
@@ -0,0 +1,60 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+)
+
+// MockEventStore is a mock implementation of loan.EventStore
+type MockEventStore struct {
+	mock.Mock
+}
+
+// Ensure MockEventStore implements loan.EventStore interface
+var _ loan.EventStore = (*MockEventStore)(nil)
+
+// Load retrieves the events of a loan after a version
+func (m *MockEventStore) Load(ctx context.Context, loanID string, afterVersion int) ([]loan.DomainEvent, error) {
+	args := m.Called(ctx, loanID, afterVersion)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]loan.DomainEvent), args.Error(1)
+}
+
+// LatestSnapshot retrieves the most recent snapshot of a loan
+func (m *MockEventStore) LatestSnapshot(ctx context.Context, loanID string) (*loan.Snapshot, error) {
+	args := m.Called(ctx, loanID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*loan.Snapshot), args.Error(1)
+}
+
+// SaveSnapshot stores a snapshot of a loan
+func (m *MockEventStore) SaveSnapshot(ctx context.Context, snapshot *loan.Snapshot) error {
+	args := m.Called(ctx, snapshot)
+	return args.Error(0)
+}
+
+// StreamIDs retrieves the IDs of the loans with stored events
+func (m *MockEventStore) StreamIDs(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// NewMockEventStore creates a new instance of MockEventStore
+func NewMockEventStore() *MockEventStore {
+	return &MockEventStore{}
+}
@@ -0,0 +1,67 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
+)
+
+// MockLenderRepository is a mock implementation of lender.Repository
+type MockLenderRepository struct {
+	mock.Mock
+}
+
+// Ensure MockLenderRepository implements lender.Repository interface
+var _ lender.Repository = (*MockLenderRepository)(nil)
+
+// Get retrieves a lender by ID
+func (m *MockLenderRepository) Get(ctx context.Context, id string) (*lender.Lender, error) {
+	args := m.Called(ctx, id)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*lender.Lender), args.Error(1)
+}
+
+// Save updates an existing lender
+func (m *MockLenderRepository) Save(ctx context.Context, l *lender.Lender) error {
+	args := m.Called(ctx, l)
+	return args.Error(0)
+}
+
+// Create inserts a new lender
+func (m *MockLenderRepository) Create(ctx context.Context, l *lender.Lender) error {
+	args := m.Called(ctx, l)
+	return args.Error(0)
+}
+
+// CreateBatch inserts several lenders at once
+func (m *MockLenderRepository) CreateBatch(ctx context.Context, lenders []*lender.Lender) error {
+	args := m.Called(ctx, lenders)
+	return args.Error(0)
+}
+
+// List retrieves lenders based on filter criteria
+func (m *MockLenderRepository) List(ctx context.Context, filter lender.LenderFilter) ([]*lender.Lender, error) {
+	args := m.Called(ctx, filter)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*lender.Lender), args.Error(1)
+}
+
+// Count returns the number of lenders matching the filter criteria
+func (m *MockLenderRepository) Count(ctx context.Context, filter lender.LenderFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// NewMockLenderRepository creates a new instance of MockLenderRepository
+func NewMockLenderRepository() *MockLenderRepository {
+	return &MockLenderRepository{}
+}
@@ -0,0 +1,111 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
+)
+
+// MockLoanLenderRepository is a mock implementation of loanlender.Repository
+type MockLoanLenderRepository struct {
+	mock.Mock
+}
+
+// Ensure MockLoanLenderRepository implements loanlender.Repository interface
+var _ loanlender.Repository = (*MockLoanLenderRepository)(nil)
+
+// Get retrieves a loan-lender relationship by ID
+func (m *MockLoanLenderRepository) Get(ctx context.Context, id string) (*loanlender.LoanLender, error) {
+	args := m.Called(ctx, id)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*loanlender.LoanLender), args.Error(1)
+}
+
+// GetByLoanID retrieves all investments of a loan
+func (m *MockLoanLenderRepository) GetByLoanID(ctx context.Context, loanID string) ([]*loanlender.LoanLender, error) {
+	args := m.Called(ctx, loanID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*loanlender.LoanLender), args.Error(1)
+}
+
+// GetByLenderID retrieves all investments of a lender
+func (m *MockLoanLenderRepository) GetByLenderID(ctx context.Context, lenderID string) ([]*loanlender.LoanLender, error) {
+	args := m.Called(ctx, lenderID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*loanlender.LoanLender), args.Error(1)
+}
+
+// Save updates an existing investment
+func (m *MockLoanLenderRepository) Save(ctx context.Context, ll *loanlender.LoanLender) error {
+	args := m.Called(ctx, ll)
+	return args.Error(0)
+}
+
+// Create inserts a new investment
+func (m *MockLoanLenderRepository) Create(ctx context.Context, ll *loanlender.LoanLender) error {
+	args := m.Called(ctx, ll)
+	return args.Error(0)
+}
+
+// List retrieves investments based on filter criteria
+func (m *MockLoanLenderRepository) List(ctx context.Context, filter loanlender.LoanLenderFilter) ([]*loanlender.LoanLender, error) {
+	args := m.Called(ctx, filter)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*loanlender.LoanLender), args.Error(1)
+}
+
+// Count returns the number of investments matching the filter criteria
+func (m *MockLoanLenderRepository) Count(ctx context.Context, filter loanlender.LoanLenderFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// SummarizeByLenderID aggregates a lender's investments by loan status
+func (m *MockLoanLenderRepository) SummarizeByLenderID(ctx context.Context, lenderID string) ([]loanlender.PortfolioStatus, error) {
+	args := m.Called(ctx, lenderID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]loanlender.PortfolioStatus), args.Error(1)
+}
+
+// ListPositions aggregates a lender's investments per loan
+func (m *MockLoanLenderRepository) ListPositions(ctx context.Context, filter loanlender.PositionFilter) ([]*loanlender.Position, error) {
+	args := m.Called(ctx, filter)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*loanlender.Position), args.Error(1)
+}
+
+// CountPositions returns the number of loans a lender invested in
+func (m *MockLoanLenderRepository) CountPositions(ctx context.Context, filter loanlender.PositionFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// NewMockLoanLenderRepository creates a new instance of MockLoanLenderRepository
+func NewMockLoanLenderRepository() *MockLoanLenderRepository {
+	return &MockLoanLenderRepository{}
+}
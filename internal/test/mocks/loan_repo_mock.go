@@ -55,6 +55,34 @@ func (m *MockLoanRepository) Count(ctx context.Context, filter loan.LoanFilter)
 	return args.Get(0).(int64), args.Error(1)
 }
 
+// SumByStatus returns the borrower's loan totals grouped by status
+func (m *MockLoanRepository) SumByStatus(ctx context.Context, borrowerID string) ([]loan.StatusAmount, error) {
+	args := m.Called(ctx, borrowerID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]loan.StatusAmount), args.Error(1)
+}
+
+// ListTransitions retrieves status transitions based on filter criteria
+func (m *MockLoanRepository) ListTransitions(ctx context.Context, filter loan.TransitionFilter) ([]loan.StatusTransition, error) {
+	args := m.Called(ctx, filter)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]loan.StatusTransition), args.Error(1)
+}
+
+// CountTransitions returns the number of status transitions matching the filter criteria
+func (m *MockLoanRepository) CountTransitions(ctx context.Context, filter loan.TransitionFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 // NewMockLoanRepository creates a new instance of MockLoanRepository
 func NewMockLoanRepository() *MockLoanRepository {
 	return &MockLoanRepository{}
@@ -0,0 +1,50 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/notification"
+)
+
+// MockNotificationRepository is a mock implementation of notification.Repository
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+// Ensure MockNotificationRepository implements notification.Repository interface
+var _ notification.Repository = (*MockNotificationRepository)(nil)
+
+// Create records a notification
+func (m *MockNotificationRepository) Create(ctx context.Context, n *notification.Notification) error {
+	args := m.Called(ctx, n)
+	return args.Error(0)
+}
+
+// HasBeenSent reports whether the recipient was already notified of the message
+func (m *MockNotificationRepository) HasBeenSent(ctx context.Context, messageID, recipientID string) (bool, error) {
+	args := m.Called(ctx, messageID, recipientID)
+	return args.Bool(0), args.Error(1)
+}
+
+// List retrieves notifications based on filter criteria
+func (m *MockNotificationRepository) List(ctx context.Context, filter notification.NotificationFilter) ([]*notification.Notification, error) {
+	args := m.Called(ctx, filter)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*notification.Notification), args.Error(1)
+}
+
+// Count returns the number of notifications matching the filter criteria
+func (m *MockNotificationRepository) Count(ctx context.Context, filter notification.NotificationFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// NewMockNotificationRepository creates a new instance of MockNotificationRepository
+func NewMockNotificationRepository() *MockNotificationRepository {
+	return &MockNotificationRepository{}
+}
@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/outbox"
+)
+
+// MockOutboxRepository is a mock implementation of outbox.Repository
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+// Ensure MockOutboxRepository implements outbox.Repository interface
+var _ outbox.Repository = (*MockOutboxRepository)(nil)
+
+// FetchPending retrieves the messages due for delivery
+func (m *MockOutboxRepository) FetchPending(ctx context.Context, limit int) ([]*outbox.Message, error) {
+	args := m.Called(ctx, limit)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*outbox.Message), args.Error(1)
+}
+
+// Save updates the delivery state of a message
+func (m *MockOutboxRepository) Save(ctx context.Context, message *outbox.Message) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
+
+// NewMockOutboxRepository creates a new instance of MockOutboxRepository
+func NewMockOutboxRepository() *MockOutboxRepository {
+	return &MockOutboxRepository{}
+}
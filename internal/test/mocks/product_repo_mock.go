@@ -0,0 +1,67 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
+)
+
+// MockProductRepository is a mock implementation of product.Repository
+type MockProductRepository struct {
+	mock.Mock
+}
+
+// Ensure MockProductRepository implements product.Repository interface
+var _ product.Repository = (*MockProductRepository)(nil)
+
+// NewMockProductRepository creates a new instance of MockProductRepository
+func NewMockProductRepository() *MockProductRepository {
+	return &MockProductRepository{}
+}
+
+// Get retrieves a product by ID
+func (m *MockProductRepository) Get(ctx context.Context, id string) (*product.LoanProduct, error) {
+	args := m.Called(ctx, id)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*product.LoanProduct), args.Error(1)
+}
+
+// Create inserts a new product
+func (m *MockProductRepository) Create(ctx context.Context, p *product.LoanProduct) error {
+	args := m.Called(ctx, p)
+	return args.Error(0)
+}
+
+// Save updates an existing product
+func (m *MockProductRepository) Save(ctx context.Context, p *product.LoanProduct) error {
+	args := m.Called(ctx, p)
+	return args.Error(0)
+}
+
+// Delete removes a product by ID
+func (m *MockProductRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// List retrieves products based on filter criteria
+func (m *MockProductRepository) List(ctx context.Context, filter product.ProductFilter) ([]*product.LoanProduct, error) {
+	args := m.Called(ctx, filter)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*product.LoanProduct), args.Error(1)
+}
+
+// Count counts the products matching the filter
+func (m *MockProductRepository) Count(ctx context.Context, filter product.ProductFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
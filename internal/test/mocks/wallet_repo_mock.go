@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
+)
+
+// MockWalletRepository is a mock implementation of wallet.Repository
+type MockWalletRepository struct {
+	mock.Mock
+}
+
+// Ensure MockWalletRepository implements wallet.Repository interface
+var _ wallet.Repository = (*MockWalletRepository)(nil)
+
+// GetByLenderID retrieves the wallet of a lender
+func (m *MockWalletRepository) GetByLenderID(ctx context.Context, lenderID string) (*wallet.Wallet, error) {
+	args := m.Called(ctx, lenderID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*wallet.Wallet), args.Error(1)
+}
+
+// Create stores an empty wallet
+func (m *MockWalletRepository) Create(ctx context.Context, w *wallet.Wallet) error {
+	args := m.Called(ctx, w)
+	return args.Error(0)
+}
+
+// Apply stores the wallet together with a transaction
+func (m *MockWalletRepository) Apply(ctx context.Context, w *wallet.Wallet, transaction *wallet.Transaction) error {
+	args := m.Called(ctx, w, transaction)
+	return args.Error(0)
+}
+
+// ListTransactions retrieves wallet transactions based on filter criteria
+func (m *MockWalletRepository) ListTransactions(ctx context.Context, filter wallet.TransactionFilter) ([]*wallet.Transaction, error) {
+	args := m.Called(ctx, filter)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*wallet.Transaction), args.Error(1)
+}
+
+// CountTransactions returns the number of wallet transactions matching the filter criteria
+func (m *MockWalletRepository) CountTransactions(ctx context.Context, filter wallet.TransactionFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// NewMockWalletRepository creates a new instance of MockWalletRepository
+func NewMockWalletRepository() *MockWalletRepository {
+	return &MockWalletRepository{}
+}
@@ -0,0 +1,112 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/webhook"
+)
+
+// MockWebhookRepository is a mock implementation of webhook.Repository
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+// Ensure MockWebhookRepository implements webhook.Repository interface
+var _ webhook.Repository = (*MockWebhookRepository)(nil)
+
+// GetSubscription retrieves a subscription by ID
+func (m *MockWebhookRepository) GetSubscription(ctx context.Context, id string) (*webhook.Subscription, error) {
+	args := m.Called(ctx, id)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*webhook.Subscription), args.Error(1)
+}
+
+// CreateSubscription inserts a new subscription
+func (m *MockWebhookRepository) CreateSubscription(ctx context.Context, subscription *webhook.Subscription) error {
+	args := m.Called(ctx, subscription)
+	return args.Error(0)
+}
+
+// SaveSubscription updates an existing subscription
+func (m *MockWebhookRepository) SaveSubscription(ctx context.Context, subscription *webhook.Subscription) error {
+	args := m.Called(ctx, subscription)
+	return args.Error(0)
+}
+
+// ListSubscriptions retrieves subscriptions based on filter criteria
+func (m *MockWebhookRepository) ListSubscriptions(ctx context.Context, filter webhook.SubscriptionFilter) ([]*webhook.Subscription, error) {
+	args := m.Called(ctx, filter)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*webhook.Subscription), args.Error(1)
+}
+
+// CountSubscriptions returns the number of subscriptions matching the filter criteria
+func (m *MockWebhookRepository) CountSubscriptions(ctx context.Context, filter webhook.SubscriptionFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// GetDelivery retrieves a delivery by ID
+func (m *MockWebhookRepository) GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error) {
+	args := m.Called(ctx, id)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*webhook.Delivery), args.Error(1)
+}
+
+// CreateDelivery inserts a new delivery
+func (m *MockWebhookRepository) CreateDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+// SaveDelivery updates an existing delivery
+func (m *MockWebhookRepository) SaveDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+// ListDeliveries retrieves deliveries based on filter criteria
+func (m *MockWebhookRepository) ListDeliveries(ctx context.Context, filter webhook.DeliveryFilter) ([]*webhook.Delivery, error) {
+	args := m.Called(ctx, filter)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*webhook.Delivery), args.Error(1)
+}
+
+// CountDeliveries returns the number of deliveries matching the filter criteria
+func (m *MockWebhookRepository) CountDeliveries(ctx context.Context, filter webhook.DeliveryFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// FetchDueDeliveries retrieves pending deliveries due for an attempt
+func (m *MockWebhookRepository) FetchDueDeliveries(ctx context.Context, limit int) ([]*webhook.Delivery, error) {
+	args := m.Called(ctx, limit)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*webhook.Delivery), args.Error(1)
+}
+
+// NewMockWebhookRepository creates a new instance of MockWebhookRepository
+func NewMockWebhookRepository() *MockWebhookRepository {
+	return &MockWebhookRepository{}
+}
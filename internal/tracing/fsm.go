@@ -0,0 +1,49 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/looplab/fsm"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedRegistrar runs every callback of the loan state machine in its own span, a child of
+// the span of the event
+type tracedRegistrar struct {
+	registrar loan.CallbackRegistrar
+}
+
+// InstrumentCallbacks traces the callbacks of the loan state machine, and the repository calls
+// they make with the context they are given
+func InstrumentCallbacks(registrar loan.CallbackRegistrar) loan.CallbackRegistrar {
+	return &tracedRegistrar{registrar: registrar}
+}
+
+func (r *tracedRegistrar) GetCallbacks() fsm.Callbacks {
+	callbacks := fsm.Callbacks{}
+	for name, callback := range r.registrar.GetCallbacks() {
+		callbacks[name] = wrap(name, callback)
+	}
+	return callbacks
+}
+
+func wrap(name string, callback fsm.Callback) fsm.Callback {
+	return func(ctx context.Context, e *fsm.Event) {
+		ctx, span := tracer.Start(ctx, "fsm."+name, trace.WithAttributes(
+			attribute.String("fsm.event", e.Event),
+			attribute.String("fsm.src", e.Src),
+			attribute.String("fsm.dst", e.Dst),
+		))
+		defer span.End()
+
+		cancelled := e.Err != nil
+		callback(ctx, e)
+
+		if !cancelled && e.Err != nil {
+			span.SetStatus(codes.Error, e.Err.Error())
+		}
+	}
+}
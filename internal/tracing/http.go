@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/requestid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware starts a span for every HTTP request, continuing the trace of the caller, and
+// passes it to the handler in the request context
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			request := c.Request()
+			ctx := otel.GetTextMapPropagator().Extract(request.Context(), propagation.HeaderCarrier(request.Header))
+
+			route := c.Path()
+			ctx, span := tracer.Start(ctx, request.Method+" "+route,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(request.Method),
+					semconv.HTTPRoute(route),
+					semconv.URLPath(request.URL.Path),
+					attribute.String("request.id", requestid.FromContext(ctx)),
+				),
+			)
+			defer span.End()
+			c.SetRequest(request.WithContext(ctx))
+
+			err := next(c)
+			if err != nil {
+				// Let Echo write the error response, so its status code is recorded
+				c.Error(err)
+				span.RecordError(err)
+			}
+
+			status := c.Response().Status
+			span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/looplab/fsm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/model"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// exporter keeps the spans of every test, the global tracer provider can only be set once
+var exporter = tracetest.NewInMemoryExporter()
+
+func TestMain(m *testing.M) {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	os.Exit(m.Run())
+}
+
+func spanNamed(t *testing.T, name string) tracetest.SpanStub {
+	for _, span := range exporter.GetSpans() {
+		if span.Name == name {
+			return span
+		}
+	}
+	require.Failf(t, "span not found", "no span named %s", name)
+	return tracetest.SpanStub{}
+}
+
+// approveRegistrar cancels the approval when the loan has no approver
+type approveRegistrar struct{}
+
+func (approveRegistrar) GetCallbacks() fsm.Callbacks {
+	return fsm.Callbacks{
+		"before_" + loan.EventApprove: func(ctx context.Context, e *fsm.Event) {
+			if e.Args[0].(string) == "" {
+				e.Cancel(errors.New("employee not found"))
+			}
+		},
+	}
+}
+
+func TestInstrumentCallbacks(t *testing.T) {
+	t.Run("should run the callbacks in a child span of the event", func(t *testing.T) {
+		exporter.Reset()
+		registrar := tracing.InstrumentCallbacks(approveRegistrar{})
+		events := fsm.Events{{Name: loan.EventApprove, Src: []string{string(loan.StatusProposed)}, Dst: string(loan.StatusApproved)}}
+
+		ctx, parent := otel.Tracer("test").Start(context.Background(), "loan.approve")
+		err := fsm.NewFSM(string(loan.StatusProposed), events, registrar.GetCallbacks()).Event(ctx, loan.EventApprove, "")
+		parent.End()
+		assert.Error(t, err)
+
+		callback := spanNamed(t, "fsm.before_approve")
+		assert.Equal(t, parent.SpanContext().SpanID(), callback.Parent.SpanID())
+		assert.Equal(t, codes.Error, callback.Status.Code)
+		assert.Equal(t, "employee not found", callback.Status.Description)
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("should continue the trace of the caller", func(t *testing.T) {
+		exporter.Reset()
+		e := echo.New()
+		e.Use(tracing.Middleware())
+		e.GET("/loans/:id", func(c echo.Context) error {
+			return echo.NewHTTPError(http.StatusInternalServerError, "database unavailable")
+		})
+
+		request := httptest.NewRequest(http.MethodGet, "/loans/loan-1", nil)
+		request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		span := spanNamed(t, "GET /loans/:id")
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext.TraceID().String())
+		assert.Equal(t, "00f067aa0ba902b7", span.Parent.SpanID().String())
+		assert.Equal(t, codes.Error, span.Status.Code)
+	})
+}
+
+func TestInstrumentDatabase(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Database.Type = config.DatabaseTypeSQLite
+	cfg.Database.URL = filepath.Join(t.TempDir(), "tracing.db")
+
+	db, err := repository.NewDatabase(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	require.NoError(t, db.DB.Exec("CREATE TABLE loans (id TEXT PRIMARY KEY, amount REAL)").Error)
+	require.NoError(t, tracing.InstrumentDatabase(db.DB))
+
+	t.Run("should trace the statements within a trace", func(t *testing.T) {
+		exporter.Reset()
+
+		ctx, parent := otel.Tracer("test").Start(context.Background(), "request")
+		var loans []model.Loan
+		require.NoError(t, db.DB.WithContext(ctx).Table("loans").Select("id").Find(&loans).Error)
+		parent.End()
+
+		span := spanNamed(t, "gorm.query")
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID())
+		assert.Contains(t, span.Attributes, attribute.String("db.collection.name", "loans"))
+	})
+
+	t.Run("should not start a trace for a statement outside of one", func(t *testing.T) {
+		exporter.Reset()
+
+		var count int64
+		require.NoError(t, db.DB.WithContext(context.Background()).Table("loans").Count(&count).Error)
+
+		assert.Empty(t, exporter.GetSpans())
+	})
+}
@@ -0,0 +1,72 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+const (
+	ServiceName = "loan-service"
+
+	defaultOTLPEndpoint = "localhost:4317"
+
+	instrumentationName = "github.com/theodorusyoga/loan-service-state-machine/internal/tracing"
+)
+
+var tracer = otel.Tracer(instrumentationName)
+
+// Setup installs the tracer provider sending the spans to the configured exporter, and returns
+// the function flushing the pending spans and stopping it. The W3C trace context is read from
+// the incoming requests whatever the exporter, so the service does not break a trace.
+// No span is recorded with the none exporter.
+func Setup(ctx context.Context, cfg *config.Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil || exporter == nil {
+		return func(context.Context) error { return nil }, err
+	}
+
+	res, err := resource.New(ctx, resource.WithAttributes(semconv.ServiceName(ServiceName)), resource.WithTelemetrySDK())
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, cfg *config.Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Tracing.Exporter {
+	case "", config.TracingExporterNone:
+		return nil, nil
+	case config.TracingExporterStdout:
+		return stdouttrace.New()
+	case config.TracingExporterOTLP:
+		endpoint := cfg.Tracing.Endpoint
+		if endpoint == "" {
+			endpoint = defaultOTLPEndpoint
+		}
+		options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+		if cfg.Tracing.Insecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, options...)
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.Tracing.Exporter)
+	}
+}
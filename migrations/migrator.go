@@ -0,0 +1,181 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ErrSchemaOutdated is returned when the database misses migrations of this release
+var ErrSchemaOutdated = errors.New("database schema is not up to date")
+
+// Migration is a numbered change of the database schema, applied in order of version
+type Migration struct {
+	Version int
+	Name    string
+	up      func(tx *gorm.DB) error
+	down    func(tx *gorm.DB) error
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// MigrationStatus tells whether a migration is applied, AppliedAt is nil when it is pending
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// schemaMigration is a row of the table recording the applied migrations
+type schemaMigration struct {
+	Version   int `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+const createSchemaMigrations = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied_at TIMESTAMP NOT NULL
+)`
+
+// Migrator applies and reverts the migrations of a dialect, recording them in the
+// schema_migrations table. Each migration runs in a transaction with its record.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *gorm.DB, dialect string) (*Migrator, error) {
+	migrations, err := loadMigrations(dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// Up applies the pending migrations and returns them
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	if err := m.db.WithContext(ctx).Exec(createSchemaMigrations).Error; err != nil {
+		return nil, err
+	}
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, status := range statuses {
+		if status.AppliedAt != nil {
+			continue
+		}
+
+		zap.L().Info("Applying migration", zap.Stringer("migration", status.Migration))
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := status.up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{
+				Version:   status.Version,
+				Name:      status.Name,
+				AppliedAt: time.Now().UTC(),
+			}).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("migration %s failed: %w", status.Migration, err)
+		}
+		applied = append(applied, status.Migration)
+	}
+
+	return applied, nil
+}
+
+// Down reverts the last steps applied migrations, latest first, and returns them
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	for i := len(statuses) - 1; i >= 0 && len(reverted) < steps; i-- {
+		status := statuses[i]
+		if status.AppliedAt == nil {
+			continue
+		}
+
+		zap.L().Info("Reverting migration", zap.Stringer("migration", status.Migration))
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := status.down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&schemaMigration{Version: status.Version}).Error
+		})
+		if err != nil {
+			return reverted, fmt.Errorf("reverting migration %s failed: %w", status.Migration, err)
+		}
+		reverted = append(reverted, status.Migration)
+	}
+
+	return reverted, nil
+}
+
+// Status lists every migration of this release in order, with the time it was applied.
+// All are pending on a database never migrated, without a schema_migrations table.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var rows []schemaMigration
+	if m.db.Migrator().HasTable(&schemaMigration{}) {
+		if err := m.db.WithContext(ctx).Order("version").Find(&rows).Error; err != nil {
+			return nil, err
+		}
+	}
+	appliedAt := make(map[int]time.Time, len(rows))
+	for _, row := range rows {
+		appliedAt[row.Version] = row.AppliedAt
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := MigrationStatus{Migration: migration}
+		if at, ok := appliedAt[migration.Version]; ok {
+			status.AppliedAt = &at
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// Check returns ErrSchemaOutdated, naming the pending migrations, unless all are applied
+func (m *Migrator) Check(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pending []string
+	for _, status := range statuses {
+		if status.AppliedAt == nil {
+			pending = append(pending, status.Migration.String())
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w, pending migrations: %v", ErrSchemaOutdated, pending)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"gorm.io/gorm"
+)
+
+// The SQL migrations of every database type, in sql/<dialect>/<version>_<name>.<up|down>.sql
+//
+//go:embed sql
+var sqlFiles embed.FS
+
+// Dialects are the directories of the SQL migrations, one per database type
+var Dialects = []string{"cockroach", "sqlite"}
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Dialect returns the directory of the migrations for a database type
+func Dialect(databaseType config.DatabaseType) (string, error) {
+	switch databaseType {
+	case config.DatabaseTypePostgres, "":
+		return "cockroach", nil
+	case config.DatabaseTypeSQLite:
+		return "sqlite", nil
+	default:
+		return "", fmt.Errorf("database type %q has no migrations", databaseType)
+	}
+}
+
+// loadMigrations reads the SQL migrations of a dialect and adds the Go ones, ordered by version
+func loadMigrations(dialect string) ([]Migration, error) {
+	entries, err := fs.ReadDir(sqlFiles, path.Join("sql", dialect))
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for %s: %w", dialect, err)
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected migration file %s", entry.Name())
+		}
+
+		version, _ := strconv.Atoi(match[1])
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d is named both %s and %s", version, m.Name, match[2])
+		}
+
+		content, err := fs.ReadFile(sqlFiles, path.Join("sql", dialect, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if match[3] == "up" {
+			m.up = execSQL(string(content))
+		} else {
+			m.down = execSQL(string(content))
+		}
+	}
+
+	for _, m := range goMigrations {
+		if _, ok := byVersion[m.Version]; ok {
+			return nil, fmt.Errorf("migration %d is defined both in SQL and in Go", m.Version)
+		}
+		byVersion[m.Version] = &m
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == nil || m.down == nil {
+			return nil, fmt.Errorf("migration %s needs both an up and a down file", m)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// execSQL runs the statements of a migration file one by one, they end with a semicolon
+// at the end of a line
+func execSQL(content string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, statement := range splitStatements(content) {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func splitStatements(content string) []string {
+	var statements []string
+	var current strings.Builder
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSpace(current.String()))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+	return statements
+}
+
+// Create adds empty up and down files for a new migration to every dialect directory
+// under dir, numbered after the latest migration, and returns their paths
+func Create(dir, name string) ([]string, error) {
+	name = strings.Trim(regexp.MustCompile(`[^a-z0-9]+`).ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return nil, fmt.Errorf("the migration needs a name")
+	}
+
+	// The next version follows every dialect and the Go migrations, so a version means
+	// the same change everywhere
+	latest := 0
+	for _, m := range goMigrations {
+		latest = max(latest, m.Version)
+	}
+	for _, dialect := range Dialects {
+		entries, err := os.ReadDir(filepath.Join(dir, dialect))
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if match := fileNamePattern.FindStringSubmatch(entry.Name()); match != nil {
+				version, _ := strconv.Atoi(match[1])
+				latest = max(latest, version)
+			}
+		}
+	}
+
+	var created []string
+	for _, dialect := range Dialects {
+		for _, direction := range []string{"up", "down"} {
+			file := filepath.Join(dir, dialect, fmt.Sprintf("%04d_%s.%s.sql", latest+1, name, direction))
+			content := fmt.Sprintf("-- %s: %s\n", strings.ReplaceAll(name, "_", " "), direction)
+			if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+				return created, err
+			}
+			created = append(created, file)
+		}
+	}
+
+	return created, nil
+}
@@ -0,0 +1,91 @@
+-- The schema created by the GORM AutoMigrate of the releases before the versioned migrations.
+-- Every statement is skipped when its table or index exists, so those databases adopt the
+-- migrations as is, and the following migrations add the changes made since.
+
+CREATE TABLE IF NOT EXISTS borrowers (
+    id UUID PRIMARY KEY,
+    full_name VARCHAR(100) NOT NULL,
+    email VARCHAR(100) NOT NULL,
+    phone_number VARCHAR(20) NOT NULL,
+    id_number VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_borrowers_email ON borrowers (email);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_borrowers_id_number ON borrowers (id_number);
+
+CREATE TABLE IF NOT EXISTS employees (
+    id UUID PRIMARY KEY,
+    full_name VARCHAR(100) NOT NULL,
+    email VARCHAR(100) NOT NULL,
+    phone_number VARCHAR(20) NOT NULL,
+    id_number VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_employees_email ON employees (email);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_employees_id_number ON employees (id_number);
+
+CREATE TABLE IF NOT EXISTS lenders (
+    id UUID PRIMARY KEY,
+    full_name VARCHAR(100) NOT NULL,
+    email VARCHAR(100) NOT NULL,
+    phone_number VARCHAR(20) NOT NULL,
+    id_number VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_lenders_email ON lenders (email);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_lenders_id_number ON lenders (id_number);
+
+CREATE TABLE IF NOT EXISTS documents (
+    id UUID PRIMARY KEY,
+    file_name VARCHAR(100),
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS loans (
+    id UUID PRIMARY KEY,
+    borrower_id UUID NOT NULL,
+    amount DECIMAL(20,2) NOT NULL,
+    rate DECIMAL(5,2) NOT NULL,
+    roi DECIMAL(5,2) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    survey_document_id UUID,
+    approval_date TIMESTAMPTZ,
+    approved_by UUID,
+    investment_date TIMESTAMPTZ,
+    disbursement_date TIMESTAMPTZ,
+    disbursed_by UUID,
+    agreement_document_id UUID,
+    status_transitions JSONB,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    CONSTRAINT fk_borrowers_loans FOREIGN KEY (borrower_id) REFERENCES borrowers (id),
+    CONSTRAINT fk_documents_survey_loans FOREIGN KEY (survey_document_id) REFERENCES documents (id),
+    CONSTRAINT fk_documents_agreement_loans FOREIGN KEY (agreement_document_id) REFERENCES documents (id),
+    CONSTRAINT fk_employees_approved_loans FOREIGN KEY (approved_by) REFERENCES employees (id),
+    CONSTRAINT fk_employees_disbursed_loans FOREIGN KEY (disbursed_by) REFERENCES employees (id)
+);
+CREATE INDEX IF NOT EXISTS idx_loan_borrower_id ON loans (borrower_id);
+CREATE INDEX IF NOT EXISTS idx_loan_status ON loans (status);
+CREATE INDEX IF NOT EXISTS idx_survey_loan_document_id ON loans (survey_document_id);
+CREATE INDEX IF NOT EXISTS idx_agreement_loan_document_id ON loans (agreement_document_id);
+CREATE INDEX IF NOT EXISTS idx_loans_approved_by ON loans (approved_by);
+CREATE INDEX IF NOT EXISTS idx_loans_disbursed_by ON loans (disbursed_by);
+CREATE INDEX IF NOT EXISTS idx_loans_created_at ON loans (created_at);
+
+CREATE TABLE IF NOT EXISTS loan_lenders (
+    id UUID PRIMARY KEY,
+    loan_id UUID NOT NULL,
+    lender_id UUID NOT NULL,
+    amount DECIMAL(20,2) NOT NULL,
+    invested_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    CONSTRAINT fk_loans_loan_lenders FOREIGN KEY (loan_id) REFERENCES loans (id),
+    CONSTRAINT fk_lenders_loan_lenders FOREIGN KEY (lender_id) REFERENCES lenders (id)
+);
+CREATE INDEX IF NOT EXISTS idx_loan_lender_loan_id ON loan_lenders (loan_id);
+CREATE INDEX IF NOT EXISTS idx_loan_lender_lender_id ON loan_lenders (lender_id);
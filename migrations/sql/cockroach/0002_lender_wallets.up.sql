@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS wallets (
+    id UUID PRIMARY KEY,
+    lender_id UUID NOT NULL,
+    balance DECIMAL(20,2) NOT NULL DEFAULT 0,
+    reserved DECIMAL(20,2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    CONSTRAINT fk_wallets_lender FOREIGN KEY (lender_id) REFERENCES lenders (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_wallets_lender_id ON wallets (lender_id);
+
+CREATE TABLE IF NOT EXISTS wallet_transactions (
+    id UUID PRIMARY KEY,
+    wallet_id UUID NOT NULL,
+    lender_id UUID NOT NULL,
+    loan_id UUID,
+    type VARCHAR(20) NOT NULL,
+    amount DECIMAL(20,2) NOT NULL,
+    balance_after DECIMAL(20,2) NOT NULL,
+    reserved_after DECIMAL(20,2) NOT NULL,
+    description VARCHAR(255),
+    created_at TIMESTAMPTZ,
+    CONSTRAINT fk_wallets_transactions FOREIGN KEY (wallet_id) REFERENCES wallets (id)
+);
+CREATE INDEX IF NOT EXISTS idx_wallet_transaction_wallet_id ON wallet_transactions (wallet_id);
+CREATE INDEX IF NOT EXISTS idx_wallet_transaction_lender_id ON wallet_transactions (lender_id);
+CREATE INDEX IF NOT EXISTS idx_wallet_transaction_loan_id ON wallet_transactions (loan_id);
+CREATE INDEX IF NOT EXISTS idx_wallet_transaction_type ON wallet_transactions (type);
+CREATE INDEX IF NOT EXISTS idx_wallet_transactions_created_at ON wallet_transactions (created_at);
@@ -0,0 +1 @@
+ALTER TABLE borrowers DROP COLUMN credit_limit;
@@ -0,0 +1,2 @@
+-- Existing borrowers have no limit
+ALTER TABLE borrowers ADD COLUMN credit_limit DECIMAL(20,2);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS outbox_messages (
+    id UUID PRIMARY KEY,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts BIGINT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_outbox_message_aggregate_id ON outbox_messages (aggregate_id);
+CREATE INDEX IF NOT EXISTS idx_outbox_message_event_type ON outbox_messages (event_type);
+CREATE INDEX IF NOT EXISTS idx_outbox_message_pending ON outbox_messages (status, next_attempt_at);
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY,
+    message_id UUID NOT NULL,
+    loan_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    recipient_type VARCHAR(20) NOT NULL,
+    recipient_id UUID NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    error TEXT,
+    created_at TIMESTAMPTZ,
+    CONSTRAINT fk_notifications_loan FOREIGN KEY (loan_id) REFERENCES loans (id)
+);
+CREATE INDEX IF NOT EXISTS idx_notification_message_recipient ON notifications (message_id, recipient_id);
+CREATE INDEX IF NOT EXISTS idx_notification_loan_id ON notifications (loan_id);
+CREATE INDEX IF NOT EXISTS idx_notification_event_type ON notifications (event_type);
+CREATE INDEX IF NOT EXISTS idx_notification_recipient_id ON notifications (recipient_id);
+CREATE INDEX IF NOT EXISTS idx_notification_status ON notifications (status);
+CREATE INDEX IF NOT EXISTS idx_notifications_created_at ON notifications (created_at);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS audit_entries (
+    id UUID PRIMARY KEY,
+    loan_id UUID NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    outcome VARCHAR(20) NOT NULL,
+    error TEXT,
+    request_id VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_audit_entry_loan_id ON audit_entries (loan_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entry_action ON audit_entries (action);
+CREATE INDEX IF NOT EXISTS idx_audit_entry_actor ON audit_entries (actor);
+CREATE INDEX IF NOT EXISTS idx_audit_entry_outcome ON audit_entries (outcome);
+CREATE INDEX IF NOT EXISTS idx_audit_entry_request_id ON audit_entries (request_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_created_at ON audit_entries (created_at);
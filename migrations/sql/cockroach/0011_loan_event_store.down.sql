@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS loan_snapshots;
+DROP TABLE IF EXISTS loan_events;
@@ -0,0 +1,5 @@
+ALTER TABLE loans DROP COLUMN total_repayment;
+ALTER TABLE loans DROP COLUMN total_interest;
+ALTER TABLE loans DROP COLUMN day_count;
+ALTER TABLE loans DROP COLUMN interest_method;
+ALTER TABLE loans DROP COLUMN tenor_months;
@@ -0,0 +1,2 @@
+-- Separate from adding the columns, CockroachDB cannot write to a column in the transaction adding it
+UPDATE loans SET total_interest = ROUND(amount * rate / 100, 2), total_repayment = amount + ROUND(amount * rate / 100, 2);
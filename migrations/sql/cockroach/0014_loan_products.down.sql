@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS loans@idx_loans_product_id;
+ALTER TABLE loans DROP COLUMN product_id;
+DROP TABLE IF EXISTS loan_products;
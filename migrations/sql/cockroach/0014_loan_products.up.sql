@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS loan_products (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    min_amount DECIMAL(20,2) NOT NULL,
+    max_amount DECIMAL(20,2) NOT NULL,
+    min_rate DECIMAL(5,2) NOT NULL,
+    max_rate DECIMAL(5,2) NOT NULL,
+    min_roi DECIMAL(5,2) NOT NULL,
+    max_roi DECIMAL(5,2) NOT NULL,
+    tenor_months JSONB NOT NULL,
+    interest_method VARCHAR(20) NOT NULL,
+    required_documents JSONB NOT NULL,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_loan_products_created_at ON loan_products (created_at);
+
+-- Existing loans were proposed before the catalog and keep no product
+ALTER TABLE loans ADD COLUMN product_id UUID;
+CREATE INDEX IF NOT EXISTS idx_loans_product_id ON loans (product_id);
@@ -0,0 +1,8 @@
+-- Emails are unique whatever their case, matching the check made on registration
+-- CockroachDB refuses to drop a unique index without CASCADE, no foreign key references the emails.
+DROP INDEX IF EXISTS borrowers@uni_borrowers_email CASCADE;
+CREATE UNIQUE INDEX IF NOT EXISTS uni_borrowers_lower_email ON borrowers (lower(email));
+DROP INDEX IF EXISTS employees@uni_employees_email CASCADE;
+CREATE UNIQUE INDEX IF NOT EXISTS uni_employees_lower_email ON employees (lower(email));
+DROP INDEX IF EXISTS lenders@uni_lenders_email CASCADE;
+CREATE UNIQUE INDEX IF NOT EXISTS uni_lenders_lower_email ON lenders (lower(email));
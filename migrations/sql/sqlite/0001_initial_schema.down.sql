@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS loan_lenders;
+DROP TABLE IF EXISTS loans;
+DROP TABLE IF EXISTS documents;
+DROP TABLE IF EXISTS lenders;
+DROP TABLE IF EXISTS employees;
+DROP TABLE IF EXISTS borrowers;
@@ -0,0 +1,91 @@
+-- The schema created by the GORM AutoMigrate of the releases before the versioned migrations.
+-- Every statement is skipped when its table or index exists, so those databases adopt the
+-- migrations as is, and the following migrations add the changes made since.
+
+CREATE TABLE IF NOT EXISTS borrowers (
+    id TEXT PRIMARY KEY,
+    full_name VARCHAR(100) NOT NULL,
+    email VARCHAR(100) NOT NULL,
+    phone_number VARCHAR(20) NOT NULL,
+    id_number VARCHAR(50) NOT NULL,
+    created_at DATETIME,
+    updated_at DATETIME
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_borrowers_email ON borrowers (email);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_borrowers_id_number ON borrowers (id_number);
+
+CREATE TABLE IF NOT EXISTS employees (
+    id TEXT PRIMARY KEY,
+    full_name VARCHAR(100) NOT NULL,
+    email VARCHAR(100) NOT NULL,
+    phone_number VARCHAR(20) NOT NULL,
+    id_number VARCHAR(50) NOT NULL,
+    created_at DATETIME,
+    updated_at DATETIME
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_employees_email ON employees (email);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_employees_id_number ON employees (id_number);
+
+CREATE TABLE IF NOT EXISTS lenders (
+    id TEXT PRIMARY KEY,
+    full_name VARCHAR(100) NOT NULL,
+    email VARCHAR(100) NOT NULL,
+    phone_number VARCHAR(20) NOT NULL,
+    id_number VARCHAR(50) NOT NULL,
+    created_at DATETIME,
+    updated_at DATETIME
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_lenders_email ON lenders (email);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_lenders_id_number ON lenders (id_number);
+
+CREATE TABLE IF NOT EXISTS documents (
+    id TEXT PRIMARY KEY,
+    file_name VARCHAR(100),
+    created_at DATETIME,
+    updated_at DATETIME
+);
+
+CREATE TABLE IF NOT EXISTS loans (
+    id TEXT PRIMARY KEY,
+    borrower_id TEXT NOT NULL,
+    amount DECIMAL(20,2) NOT NULL,
+    rate DECIMAL(5,2) NOT NULL,
+    roi DECIMAL(5,2) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    survey_document_id TEXT,
+    approval_date DATETIME,
+    approved_by TEXT,
+    investment_date DATETIME,
+    disbursement_date DATETIME,
+    disbursed_by TEXT,
+    agreement_document_id TEXT,
+    status_transitions TEXT,
+    created_at DATETIME,
+    updated_at DATETIME,
+    CONSTRAINT fk_borrowers_loans FOREIGN KEY (borrower_id) REFERENCES borrowers (id),
+    CONSTRAINT fk_documents_survey_loans FOREIGN KEY (survey_document_id) REFERENCES documents (id),
+    CONSTRAINT fk_documents_agreement_loans FOREIGN KEY (agreement_document_id) REFERENCES documents (id),
+    CONSTRAINT fk_employees_approved_loans FOREIGN KEY (approved_by) REFERENCES employees (id),
+    CONSTRAINT fk_employees_disbursed_loans FOREIGN KEY (disbursed_by) REFERENCES employees (id)
+);
+CREATE INDEX IF NOT EXISTS idx_loan_borrower_id ON loans (borrower_id);
+CREATE INDEX IF NOT EXISTS idx_loan_status ON loans (status);
+CREATE INDEX IF NOT EXISTS idx_survey_loan_document_id ON loans (survey_document_id);
+CREATE INDEX IF NOT EXISTS idx_agreement_loan_document_id ON loans (agreement_document_id);
+CREATE INDEX IF NOT EXISTS idx_loans_approved_by ON loans (approved_by);
+CREATE INDEX IF NOT EXISTS idx_loans_disbursed_by ON loans (disbursed_by);
+CREATE INDEX IF NOT EXISTS idx_loans_created_at ON loans (created_at);
+
+CREATE TABLE IF NOT EXISTS loan_lenders (
+    id TEXT PRIMARY KEY,
+    loan_id TEXT NOT NULL,
+    lender_id TEXT NOT NULL,
+    amount DECIMAL(20,2) NOT NULL,
+    invested_at DATETIME NOT NULL,
+    created_at DATETIME,
+    updated_at DATETIME,
+    CONSTRAINT fk_loans_loan_lenders FOREIGN KEY (loan_id) REFERENCES loans (id),
+    CONSTRAINT fk_lenders_loan_lenders FOREIGN KEY (lender_id) REFERENCES lenders (id)
+);
+CREATE INDEX IF NOT EXISTS idx_loan_lender_loan_id ON loan_lenders (loan_id);
+CREATE INDEX IF NOT EXISTS idx_loan_lender_lender_id ON loan_lenders (lender_id);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS outbox_messages (
+    id TEXT PRIMARY KEY,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id TEXT NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at DATETIME NOT NULL,
+    occurred_at DATETIME NOT NULL,
+    sent_at DATETIME,
+    created_at DATETIME
+);
+CREATE INDEX IF NOT EXISTS idx_outbox_message_aggregate_id ON outbox_messages (aggregate_id);
+CREATE INDEX IF NOT EXISTS idx_outbox_message_event_type ON outbox_messages (event_type);
+CREATE INDEX IF NOT EXISTS idx_outbox_message_pending ON outbox_messages (status, next_attempt_at);
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id TEXT PRIMARY KEY,
+    url VARCHAR(2048) NOT NULL,
+    event_types TEXT NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT true,
+    created_at DATETIME,
+    updated_at DATETIME
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_subscription_active ON webhook_subscriptions (active);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id TEXT PRIMARY KEY,
+    subscription_id TEXT NOT NULL,
+    message_id TEXT NOT NULL,
+    redelivery_of TEXT,
+    dedup_key VARCHAR(100) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    response_code INTEGER,
+    last_error TEXT,
+    next_attempt_at DATETIME NOT NULL,
+    delivered_at DATETIME,
+    created_at DATETIME,
+    updated_at DATETIME,
+    CONSTRAINT fk_webhook_subscriptions_deliveries FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (id)
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_subscription_id ON webhook_deliveries (subscription_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_message_id ON webhook_deliveries (message_id);
+CREATE UNIQUE INDEX IF NOT EXISTS uni_webhook_deliveries_dedup_key ON webhook_deliveries (dedup_key);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_event_type ON webhook_deliveries (event_type);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_due ON webhook_deliveries (status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_created_at ON webhook_deliveries (created_at);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS loan_status_transitions (
+    id TEXT PRIMARY KEY,
+    loan_id TEXT NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    performed_by VARCHAR(255) NOT NULL,
+    date DATETIME NOT NULL,
+    CONSTRAINT fk_loans_status_transitions FOREIGN KEY (loan_id) REFERENCES loans (id)
+);
+CREATE INDEX IF NOT EXISTS idx_loan_status_transition_loan_id ON loan_status_transitions (loan_id);
+CREATE INDEX IF NOT EXISTS idx_loan_status_transition_to_status ON loan_status_transitions (to_status);
+CREATE INDEX IF NOT EXISTS idx_loan_status_transition_performed_by ON loan_status_transitions (performed_by);
+CREATE INDEX IF NOT EXISTS idx_loan_status_transition_date ON loan_status_transitions (date);
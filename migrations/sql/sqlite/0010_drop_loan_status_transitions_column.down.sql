@@ -0,0 +1,2 @@
+-- The transitions stay in loan_status_transitions
+ALTER TABLE loans ADD COLUMN status_transitions TEXT;
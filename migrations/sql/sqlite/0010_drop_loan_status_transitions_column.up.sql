@@ -0,0 +1,3 @@
+-- Separate from moving the transitions by 0009_move_status_transitions, CockroachDB cannot drop
+-- a column in the transaction writing the rows read from it
+ALTER TABLE loans DROP COLUMN status_transitions;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS loan_events (
+    id TEXT PRIMARY KEY,
+    loan_id TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,
+    occurred_at DATETIME NOT NULL,
+    CONSTRAINT fk_loan_events_loan FOREIGN KEY (loan_id) REFERENCES loans (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_loan_event_stream ON loan_events (loan_id, version);
+
+CREATE TABLE IF NOT EXISTS loan_snapshots (
+    loan_id TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    state TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (loan_id, version),
+    CONSTRAINT fk_loan_snapshots_loan FOREIGN KEY (loan_id) REFERENCES loans (id)
+);
@@ -0,0 +1,6 @@
+-- Existing loans charged their rate once, the same as a flat rate over 12 months on a 30/360 basis
+ALTER TABLE loans ADD COLUMN tenor_months INTEGER NOT NULL DEFAULT 12;
+ALTER TABLE loans ADD COLUMN interest_method VARCHAR(20) NOT NULL DEFAULT 'flat';
+ALTER TABLE loans ADD COLUMN day_count VARCHAR(10) NOT NULL DEFAULT '30/360';
+ALTER TABLE loans ADD COLUMN total_interest DECIMAL(20,2) NOT NULL DEFAULT 0;
+ALTER TABLE loans ADD COLUMN total_repayment DECIMAL(20,2) NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+-- The totals are dropped with their columns by 0012_loan_interest_terms
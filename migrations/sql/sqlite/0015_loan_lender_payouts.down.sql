@@ -0,0 +1,2 @@
+ALTER TABLE loan_lenders DROP COLUMN payout;
+ALTER TABLE loan_lenders DROP COLUMN expected_return;
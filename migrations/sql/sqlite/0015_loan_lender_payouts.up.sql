@@ -0,0 +1,2 @@
+ALTER TABLE loan_lenders ADD COLUMN expected_return DECIMAL(20,2) NOT NULL DEFAULT 0;
+ALTER TABLE loan_lenders ADD COLUMN payout DECIMAL(20,2) NOT NULL DEFAULT 0;
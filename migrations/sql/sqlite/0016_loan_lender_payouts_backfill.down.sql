@@ -0,0 +1 @@
+-- The payouts are dropped with their columns by 0015_loan_lender_payouts
@@ -0,0 +1,8 @@
+-- Separate from adding the columns, CockroachDB cannot write to a column in the transaction adding it.
+-- Existing investments earn their share of the loan interest scaled from the rate to the ROI, exact for flat rate loans.
+UPDATE loan_lenders SET expected_return = COALESCE((
+    SELECT ROUND(loan_lenders.amount * loans.total_interest * loans.roi / (loans.amount * loans.rate), 2)
+    FROM loans
+    WHERE loans.id = loan_lenders.loan_id AND loans.amount > 0 AND loans.rate > 0
+), 0);
+UPDATE loan_lenders SET payout = amount + expected_return;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS uni_borrowers_lower_email;
+CREATE UNIQUE INDEX IF NOT EXISTS uni_borrowers_email ON borrowers (email);
+DROP INDEX IF EXISTS uni_employees_lower_email;
+CREATE UNIQUE INDEX IF NOT EXISTS uni_employees_email ON employees (email);
+DROP INDEX IF EXISTS uni_lenders_lower_email;
+CREATE UNIQUE INDEX IF NOT EXISTS uni_lenders_email ON lenders (email);
@@ -0,0 +1,7 @@
+-- Emails are unique whatever their case, matching the check made on registration
+DROP INDEX IF EXISTS uni_borrowers_email;
+CREATE UNIQUE INDEX IF NOT EXISTS uni_borrowers_lower_email ON borrowers (lower(email));
+DROP INDEX IF EXISTS uni_employees_email;
+CREATE UNIQUE INDEX IF NOT EXISTS uni_employees_lower_email ON employees (lower(email));
+DROP INDEX IF EXISTS uni_lenders_email;
+CREATE UNIQUE INDEX IF NOT EXISTS uni_lenders_lower_email ON lenders (lower(email));
@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/model"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// goMigrations are the migrations that need more than SQL, they run for every dialect
+var goMigrations = []Migration{
+	{Version: 9, Name: "move_status_transitions", up: migrateStatusTransitions, down: noMigration},
+}
+
+// noMigration is the down step of a migration that cannot be reverted, and does not need to be
+func noMigration(tx *gorm.DB) error {
+	return nil
+}
+
+// legacyStatusTransition is a transition as it was stored in the loans.status_transitions JSONB column
+type legacyStatusTransition struct {
+	From        string    `json:"from"`
+	To          string    `json:"to"`
+	Date        time.Time `json:"date"`
+	Description string    `json:"description"`
+	PerformedBy string    `json:"performed_by"`
+}
+
+// migrateStatusTransitions moves the transitions stored as JSON on the loans into the
+// loan_status_transitions table. The JSON column is dropped by the following migration.
+func migrateStatusTransitions(tx *gorm.DB) error {
+	zap.L().Info("Moving loan status transitions to loan_status_transitions")
+
+	var rows []struct {
+		ID                string
+		StatusTransitions []byte
+	}
+	if err := tx.Table("loans").Select("id, status_transitions").
+		Where("status_transitions IS NOT NULL").Scan(&rows).Error; err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		var legacy []legacyStatusTransition
+		if err := json.Unmarshal(row.StatusTransitions, &legacy); err != nil {
+			return err
+		}
+		if len(legacy) == 0 {
+			continue
+		}
+
+		transitions := make([]model.LoanStatusTransition, 0, len(legacy))
+		for _, t := range legacy {
+			transitions = append(transitions, model.LoanStatusTransition{
+				ID:          uuid.New().String(),
+				LoanID:      row.ID,
+				FromStatus:  t.From,
+				ToStatus:    t.To,
+				Description: t.Description,
+				PerformedBy: t.PerformedBy,
+				Date:        t.Date,
+			})
+		}
+		if err := tx.Create(&transitions).Error; err != nil {
+			return err
+		}
+	}
+
+	zap.L().Info("Moved the loan status transitions", zap.Int("loans", len(rows)))
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package migrations
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository"
+	"github.com/theodorusyoga/loan-service-state-machine/migrations"
+	"gorm.io/gorm"
+)
+
+func newSQLiteDatabase(t *testing.T) *gorm.DB {
+	cfg := &config.Config{}
+	cfg.Database.Type = config.DatabaseTypeSQLite
+	cfg.Database.URL = filepath.Join(t.TempDir(), "loans.db")
+
+	db, err := repository.NewDatabase(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return db.DB
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLiteDatabase(t)
+
+	migrator, err := migrations.NewMigrator(db, "sqlite")
+	require.NoError(t, err)
+
+	t.Run("should refuse a database never migrated", func(t *testing.T) {
+		assert.ErrorIs(t, migrator.Check(ctx), migrations.ErrSchemaOutdated)
+		assert.False(t, db.Migrator().HasTable("schema_migrations"))
+	})
+
+	t.Run("should apply every migration in order", func(t *testing.T) {
+		applied, err := migrator.Up(ctx)
+		require.NoError(t, err)
+		require.NotEmpty(t, applied)
+		for i := 1; i < len(applied); i++ {
+			assert.Less(t, applied[i-1].Version, applied[i].Version)
+		}
+		assert.NoError(t, migrator.Check(ctx))
+		assert.True(t, db.Migrator().HasTable("loans"))
+
+		// Nothing is left to apply
+		applied, err = migrator.Up(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, applied)
+	})
+
+	t.Run("should revert the latest migrations", func(t *testing.T) {
+		statuses, err := migrator.Status(ctx)
+		require.NoError(t, err)
+
+		reverted, err := migrator.Down(ctx, len(statuses))
+		require.NoError(t, err)
+		assert.Len(t, reverted, len(statuses))
+		assert.Equal(t, statuses[len(statuses)-1].Version, reverted[0].Version)
+		assert.False(t, db.Migrator().HasTable("loans"))
+		assert.ErrorIs(t, migrator.Check(ctx), migrations.ErrSchemaOutdated)
+
+		statuses, err = migrator.Status(ctx)
+		require.NoError(t, err)
+		for _, status := range statuses {
+			assert.Nil(t, status.AppliedAt)
+		}
+	})
+}
+
+func TestMigrateBaselineSchema(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLiteDatabase(t)
+
+	// Borrowers and loans as created by GORM AutoMigrate before the versioned migrations,
+	// with the status transitions stored as JSON on the loans
+	require.NoError(t, db.Exec(`CREATE TABLE borrowers (id TEXT PRIMARY KEY, full_name VARCHAR(100) NOT NULL, email VARCHAR(100) NOT NULL,
+		phone_number VARCHAR(20) NOT NULL, id_number VARCHAR(50) NOT NULL, created_at DATETIME, updated_at DATETIME)`).Error)
+	require.NoError(t, db.Exec(`CREATE TABLE loans (id TEXT PRIMARY KEY, borrower_id TEXT NOT NULL, amount DECIMAL(20,2) NOT NULL,
+		rate DECIMAL(5,2) NOT NULL, roi DECIMAL(5,2) NOT NULL, status VARCHAR(20) NOT NULL, survey_document_id TEXT, approval_date DATETIME,
+		approved_by TEXT, investment_date DATETIME, disbursement_date DATETIME, disbursed_by TEXT, agreement_document_id TEXT,
+		status_transitions TEXT, created_at DATETIME, updated_at DATETIME)`).Error)
+	require.NoError(t, db.Exec(`INSERT INTO borrowers (id, full_name, email, phone_number, id_number) VALUES ('b1', 'Jane Doe', 'jane@example.com', '0812', '3171')`).Error)
+	require.NoError(t, db.Exec(`INSERT INTO loans (id, borrower_id, amount, rate, roi, status, status_transitions) VALUES ('l1', 'b1', 1000, 10, 8, 'approved', ?)`,
+		`[{"from":"","to":"proposed","date":"2026-01-01T10:00:00Z","description":"Loan proposed","performed_by":"b1"},`+
+			`{"from":"proposed","to":"approved","date":"2026-01-02T10:00:00Z","description":"Loan approved","performed_by":"e1"}]`).Error)
+
+	migrator, err := migrations.NewMigrator(db, "sqlite")
+	require.NoError(t, err)
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.NoError(t, migrator.Check(ctx))
+
+	t.Run("should add the columns and tables created since", func(t *testing.T) {
+		assert.True(t, db.Migrator().HasColumn("borrowers", "credit_limit"))
+		assert.True(t, db.Migrator().HasColumn("loans", "tenor_months"))
+		assert.True(t, db.Migrator().HasColumn("loans", "product_id"))
+		for _, table := range []string{"wallets", "wallet_transactions", "outbox_messages", "webhook_subscriptions", "webhook_deliveries",
+			"notifications", "audit_entries", "loan_status_transitions", "loan_events", "loan_snapshots", "loan_products"} {
+			assert.True(t, db.Migrator().HasTable(table), table)
+		}
+
+		require.NoError(t, db.Exec(`UPDATE borrowers SET credit_limit = 5000 WHERE id = 'b1'`).Error)
+	})
+
+	t.Run("should move the status transitions to their own table", func(t *testing.T) {
+		var toStatuses []string
+		require.NoError(t, db.Table("loan_status_transitions").Where("loan_id = ?", "l1").Order("date").Pluck("to_status", &toStatuses).Error)
+		assert.Equal(t, []string{"proposed", "approved"}, toStatuses)
+		assert.False(t, db.Migrator().HasColumn("loans", "status_transitions"))
+	})
+
+	t.Run("should compute the interest totals of the existing loans", func(t *testing.T) {
+		var totalRepayment float64
+		require.NoError(t, db.Table("loans").Where("id = ?", "l1").Pluck("total_repayment", &totalRepayment).Error)
+		assert.Equal(t, 1100.0, totalRepayment)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+	for _, dialect := range migrations.Dialects {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, dialect), 0o755))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sqlite", "0017_add_index.up.sql"), nil, 0o644))
+
+	files, err := migrations.Create(dir, "Add loan purpose")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		filepath.Join(dir, "cockroach", "0018_add_loan_purpose.up.sql"),
+		filepath.Join(dir, "cockroach", "0018_add_loan_purpose.down.sql"),
+		filepath.Join(dir, "sqlite", "0018_add_loan_purpose.up.sql"),
+		filepath.Join(dir, "sqlite", "0018_add_loan_purpose.down.sql"),
+	}, files)
+	for _, file := range files {
+		assert.FileExists(t, file)
+	}
+}
@@ -2,7 +2,10 @@ package fx
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -10,38 +13,51 @@ import (
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"github.com/theodorusyoga/loan-service-state-machine/config"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/api/handler"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/api/rpc"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/audit"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/borrower"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/document"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/employee"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/importer"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/lender"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan/callbacks"
 	loanlender "github.com/theodorusyoga/loan-service-state-machine/internal/domain/loan_lender"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/notification"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/outbox"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/product"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/wallet"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/domain/webhook"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/health"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/metrics"
 	"github.com/theodorusyoga/loan-service-state-machine/internal/repository"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/repository/memory"
+	"github.com/theodorusyoga/loan-service-state-machine/internal/tracing"
+	"github.com/theodorusyoga/loan-service-state-machine/migrations"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/requestid"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"gorm.io/gorm"
 )
 
-func ProvideValidator() *validator.Validate {
-	v := validator.New()
-
-	// Register a validator for ROI < Rate
-	v.RegisterValidation("roiLessThanRate", func(fl validator.FieldLevel) bool {
-		// Get the struct
-		parent := fl.Parent()
-
-		rateField := parent.FieldByName("Rate")
-		if !rateField.IsValid() {
-			return false
-		}
-		roi := fl.Field().Float()
-		rate := rateField.Float()
+// DefaultShutdownTimeout is the drain time on shutdown when server.shutdown_timeout is unset
+const DefaultShutdownTimeout = 30 * time.Second
 
-		// ROI must be less than Rate
-		return roi < rate
-	})
+// StopTimeout gives the application the configured time to drain the in-flight requests and
+// background jobs, and close the database, on shutdown
+func StopTimeout(cfg *config.Config) fx.Option {
+	timeout := cfg.Server.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	return fx.StopTimeout(timeout)
+}
 
-	return v
+// ProvideValidator validates the requests of the HTTP and gRPC APIs
+func ProvideValidator() *validator.Validate {
+	return validator.New()
 }
 
 var Module = fx.Options(
@@ -58,117 +74,442 @@ var DomainModule = fx.Module("domain", fx.Provide(
 	document.NewDocumentService,
 	lender.NewLenderService,
 	loanlender.NewLoanLenderService,
+	wallet.NewWalletService,
+	webhook.NewWebhookService,
+	product.NewProductService,
+	webhook.NewSender,
+	webhook.NewWorker,
+	AsOutboxHandler(webhook.NewOutboxHandler),
+	notification.NewNotificationService,
+	audit.NewAuditService,
+	importer.NewImportService,
+	ProvideNotifier,
+	ProvideNotificationSettings,
+	AsOutboxHandler(notification.NewOutboxHandler),
 
-	// Callback registrar for FSM
+	// Callback registrar for FSM, recording the events in the metrics and the traces
+	callbacks.New,
+	ProvideCallbackRegistrar,
+
+	// Outbox dispatcher delivering to every handler provided with AsOutboxHandler
 	fx.Annotate(
-		callbacks.New,
-		fx.As(new(loan.CallbackRegistrar)),
+		outbox.NewDispatcher,
+		fx.ParamTags(``, `group:"outbox_handlers"`),
 	),
-))
+),
+	fx.Invoke(registerOutboxDispatcher, registerWebhookWorker))
+
+// ProvideCallbackRegistrar instruments the state machine callbacks, so every event and its
+// outcome is counted, and every callback traced, without the callbacks doing it
+func ProvideCallbackRegistrar(p *callbacks.CallbackProvider, m *metrics.Metrics) loan.CallbackRegistrar {
+	return m.InstrumentCallbacks(tracing.InstrumentCallbacks(p))
+}
+
+// ProvideNotifier selects the notification channel from the configuration
+func ProvideNotifier(cfg *config.Config) notification.Notifier {
+	if cfg.Notification.Driver == config.NotificationDriverSMTP {
+		smtp := cfg.Notification.SMTP
+		return notification.NewSMTPNotifier(smtp.Host, smtp.Port, smtp.Username, smtp.Password, smtp.From)
+	}
+	return notification.NewLogNotifier(cfg.Notification.LogFile)
+}
+
+func ProvideNotificationSettings(cfg *config.Config) notification.Settings {
+	return notification.Settings{
+		AgreementBaseURL: cfg.Notification.AgreementBaseURL,
+	}
+}
+
+// ProvideTransactor runs the state machine events in one database transaction
+func ProvideTransactor(cfg *config.Config, executor *repository.TxExecutor) loan.Transactor {
+	if cfg.Database.Type == config.DatabaseTypeMemory {
+		return memory.NewTransactor()
+	}
+	return executor
+}
+
+// ProvideLoanRepository reads loans from their event stream when event sourcing is enabled
+func ProvideLoanRepository(cfg *config.Config, db *gorm.DB, executor *repository.TxExecutor, memoryStore *memory.Store, store loan.EventStore, documents document.Repository) loan.Repository {
+	var r loan.Repository
+	if cfg.Database.Type == config.DatabaseTypeMemory {
+		r = memory.NewLoanRepository(memoryStore)
+	} else {
+		r = repository.NewLoanRepository(db, executor)
+	}
+
+	if cfg.EventSourcing.Enabled {
+		return loan.NewEventSourcedRepository(r, documents, store, cfg.EventSourcing.SnapshotInterval)
+	}
+	return r
+}
+
+// AsOutboxHandler annotates a constructor so its result receives the outbox messages
+func AsOutboxHandler(f any) any {
+	return fx.Annotate(
+		f,
+		fx.As(new(outbox.Handler)),
+		fx.ResultTags(`group:"outbox_handlers"`),
+	)
+}
+
+func registerWebhookWorker(lc fx.Lifecycle, w *webhook.Worker) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			w.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return w.Stop(ctx)
+		},
+	})
+}
+
+func registerOutboxDispatcher(lc fx.Lifecycle, d *outbox.Dispatcher) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			d.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return d.Stop(ctx)
+		},
+	})
+}
 
 var InfrastructureModule = fx.Module("infrastructure",
 	fx.Provide(
 		// Database
-		repository.NewDatabase,
+		ProvideDatabase,
 
 		func(db *repository.Database) *gorm.DB {
+			if db == nil {
+				return nil
+			}
 			return db.DB
 		},
 
-		// Repositories
-		fx.Annotate(
-			repository.NewLoanRepository,
-			fx.As(new(loan.Repository)),
-		),
-		fx.Annotate(
-			repository.NewBorrowerRepository,
-			fx.As(new(borrower.Repository)),
-		),
-		fx.Annotate(
-			repository.NewEmployeeRepository,
-			fx.As(new(employee.Repository)),
-		),
-		fx.Annotate(
-			repository.NewDocumentRepository,
-			fx.As(new(document.Repository)),
-		),
-		fx.Annotate(
-			repository.NewLenderRepository,
-			fx.As(new(lender.Repository)),
-		),
-		fx.Annotate(
-			repository.NewLoanLenderRepository,
-			fx.As(new(loanlender.Repository)),
-		),
+		ProvideTxExecutor,
+		memory.NewStore,
+		metrics.NewMetrics,
+
+		// Repositories, from the database or in memory depending on database.type
+		ProvideLoanRepository,
+		ProvideTransactor,
+		selectRepository[loan.EventStore](repository.NewEventStoreRepository, memory.NewEventStore),
+		selectRepository[borrower.Repository](repository.NewBorrowerRepository, memory.NewBorrowerRepository),
+		selectRepository[employee.Repository](repository.NewEmployeeRepository, memory.NewEmployeeRepository),
+		selectRepository[document.Repository](repository.NewDocumentRepository, memory.NewDocumentRepository),
+		selectRepository[lender.Repository](repository.NewLenderRepository, memory.NewLenderRepository),
+		selectRepository[loanlender.Repository](repository.NewLoanLenderRepository, memory.NewLoanLenderRepository),
+		selectRepository[wallet.Repository](repository.NewWalletRepository, memory.NewWalletRepository),
+		selectRepository[outbox.Repository](repository.NewOutboxRepository, memory.NewOutboxRepository),
+		selectRepository[webhook.Repository](repository.NewWebhookRepository, memory.NewWebhookRepository),
+		selectRepository[product.Repository](repository.NewProductRepository, memory.NewProductRepository),
+		selectRepository[notification.Repository](repository.NewNotificationRepository, memory.NewNotificationRepository),
+		selectRepository[audit.Repository](repository.NewAuditRepository, memory.NewAuditRepository),
 	),
+	fx.Invoke(registerDatabase, registerTracing, registerMetrics),
 )
 
+// registerTracing sends the spans to the configured exporter, and traces the database statements.
+// Its hook runs after the other ones on shutdown, so the spans of the drained requests are flushed.
+func registerTracing(lc fx.Lifecycle, cfg *config.Config, db *gorm.DB) error {
+	shutdown, err := tracing.Setup(context.Background(), cfg)
+	if err != nil {
+		return err
+	}
+
+	if db != nil {
+		if err := tracing.InstrumentDatabase(db); err != nil {
+			return err
+		}
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: shutdown,
+	})
+	return nil
+}
+
+// registerMetrics times the database statements and exposes the transaction retries and the
+// loan totals, which are read on each scrape
+func registerMetrics(m *metrics.Metrics, db *gorm.DB, executor *repository.TxExecutor, loans loan.Repository) error {
+	if db != nil {
+		if err := m.InstrumentDatabase(db); err != nil {
+			return err
+		}
+		if err := m.Register(metrics.NewRetriesCollector(executor)); err != nil {
+			return err
+		}
+	}
+	return m.Register(metrics.NewLoanCollector(loans))
+}
+
+// registerDatabase closes the database on shutdown. Its hook is the first appended, so it
+// runs after every other hook has stopped using the database.
+func registerDatabase(lc fx.Lifecycle, db *repository.Database) {
+	if db == nil {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			zap.L().Info("Closing database")
+			return db.Close()
+		},
+	})
+}
+
+// ProvideDatabase connects to the configured database. There is no connection, and the
+// database is nil, when the repositories are kept in memory.
+func ProvideDatabase(cfg *config.Config) (*repository.Database, error) {
+	if cfg.Database.Type == config.DatabaseTypeMemory {
+		zap.L().Warn("Keeping data in memory, it is lost on restart")
+		return nil, nil
+	}
+	return repository.NewDatabase(cfg)
+}
+
+// ProvideTxExecutor shares one transaction executor, and its retry counters, between the
+// database repositories. It is nil when the repositories are kept in memory.
+func ProvideTxExecutor(cfg *config.Config, db *gorm.DB) *repository.TxExecutor {
+	if db == nil {
+		return nil
+	}
+	return repository.NewTxExecutor(db, repository.NewRetryPolicy(cfg))
+}
+
+// selectRepository provides the database or the in-memory implementation of a repository
+// depending on database.type
+func selectRepository[T, D, M any](newDatabase func(*gorm.DB, *repository.TxExecutor) D, newMemory func(*memory.Store) M) func(*config.Config, *gorm.DB, *repository.TxExecutor, *memory.Store) T {
+	return func(cfg *config.Config, db *gorm.DB, executor *repository.TxExecutor, store *memory.Store) T {
+		if cfg.Database.Type == config.DatabaseTypeMemory {
+			return any(newMemory(store)).(T)
+		}
+		return any(newDatabase(db, executor)).(T)
+	}
+}
+
 var APIModule = fx.Module("api", fx.Provide(
 	ProvideValidator,
 	handler.NewLoanHandler,
 	handler.NewBorrowerHandler,
 	handler.NewEmployeeHandler,
 	handler.NewLenderHandler,
+	handler.NewWalletHandler,
+	handler.NewWebhookHandler,
+	handler.NewProductHandler,
+	handler.NewNotificationHandler,
+	handler.NewAuditHandler,
+	handler.NewImportHandler,
+	handler.NewHealthHandler,
+	ProvideHealthChecker,
 	NewServer,
+	rpc.NewLoanServer,
+	rpc.NewPartyServer,
+	rpc.NewServer,
 ),
-	fx.Invoke(registerRoutes))
+	// The HTTP server is registered last so it is the first to stop: the service is marked
+	// not ready before the gRPC server and the background jobs are drained
+	fx.Invoke(checkSchema, registerGRPCServer, registerRoutes))
+
+// checkSchema refuses to start the API on a database missing migrations, run cmd/migrate first
+func checkSchema(cfg *config.Config, db *gorm.DB) error {
+	if cfg.Database.Type == config.DatabaseTypeMemory {
+		return nil
+	}
+
+	migrator, err := newMigrator(cfg, db)
+	if err != nil {
+		return err
+	}
+	return migrator.Check(context.Background())
+}
+
+func newMigrator(cfg *config.Config, db *gorm.DB) (*migrations.Migrator, error) {
+	dialect, err := migrations.Dialect(cfg.Database.Type)
+	if err != nil {
+		return nil, err
+	}
+	return migrations.NewMigrator(db, dialect)
+}
+
+// ProvideHealthChecker checks for /readyz that the database is reachable with an up to date
+// schema and that the background jobs run. The database is not checked in memory mode.
+func ProvideHealthChecker(cfg *config.Config, database *repository.Database, dispatcher *outbox.Dispatcher, worker *webhook.Worker) (*health.Checker, error) {
+	checker := health.NewChecker()
+
+	if database != nil {
+		migrator, err := newMigrator(cfg, database.DB)
+		if err != nil {
+			return nil, err
+		}
+		checker.Add("database", database.Ping)
+		checker.Add("migrations", migrator.Check)
+	}
+	checker.Add("outbox_dispatcher", running("outbox dispatcher", dispatcher.Running))
+	checker.Add("webhook_worker", running("webhook worker", worker.Running))
+
+	return checker, nil
+}
+
+func running(name string, isRunning func() bool) health.Check {
+	return func(ctx context.Context) error {
+		if !isRunning() {
+			return fmt.Errorf("%s is not running", name)
+		}
+		return nil
+	}
+}
+
+// registerGRPCServer serves the gRPC API next to the HTTP server when a gRPC port is configured
+func registerGRPCServer(lc fx.Lifecycle, cfg *config.Config, server *grpc.Server) {
+	if cfg.Server.GRPCPort == "" {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			listener, err := net.Listen("tcp", ":"+cfg.Server.GRPCPort)
+			if err != nil {
+				return err
+			}
+
+			go func() {
+				zap.L().Info("Starting gRPC server", zap.String("port", cfg.Server.GRPCPort))
+				if err := server.Serve(listener); err != nil {
+					zap.L().Error("gRPC server error", zap.Error(err))
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			stopped := make(chan struct{})
+			go func() {
+				server.GracefulStop()
+				close(stopped)
+			}()
+
+			select {
+			case <-stopped:
+			case <-ctx.Done():
+				server.Stop()
+			}
+			return nil
+		},
+	})
+}
 
 func registerRoutes(lc fx.Lifecycle,
 	e *echo.Echo, cfg *config.Config, loanHandler *handler.LoanHandler,
 	borrowerHandler *handler.BorrowerHandler, emp *handler.EmployeeHandler,
-	lenderHandler *handler.LenderHandler) {
+	lenderHandler *handler.LenderHandler, walletHandler *handler.WalletHandler,
+	webhookHandler *handler.WebhookHandler, productHandler *handler.ProductHandler, notificationHandler *handler.NotificationHandler,
+	auditHandler *handler.AuditHandler, importHandler *handler.ImportHandler,
+	healthHandler *handler.HealthHandler, checker *health.Checker, m *metrics.Metrics) {
 	api := e.Group("/api/v1")
 
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
+	e.GET("/healthz", healthHandler.Healthz)
+	e.GET("/readyz", healthHandler.Readyz)
+	e.GET("/metrics", echo.WrapHandler(m.Handler()))
 
 	loans := api.Group("/loans")
 	loans.GET("", loanHandler.ListLoans)
 	loans.POST("", loanHandler.CreateLoan)
+	loans.GET("/transitions", loanHandler.ListTransitions)
 	loans.PATCH("/:id/:status", loanHandler.UpdateLoanStatus)
 
 	borrowers := api.Group("/borrowers")
 	borrowers.GET("", borrowerHandler.ListBorrowers)
 	borrowers.POST("", borrowerHandler.CreateBorrower)
+	borrowers.POST("/import", importHandler.ImportBorrowers)
+	borrowers.PUT("/:id/credit-limit", borrowerHandler.UpdateCreditLimit)
+	borrowers.GET("/:id/exposure", borrowerHandler.GetExposure)
+	borrowers.GET("/:id/loans", borrowerHandler.ListLoans)
+	borrowers.GET("/:id/statement", borrowerHandler.GetStatement)
 
 	employees := api.Group("/employees")
 	employees.GET("", emp.ListEmployees)
 	employees.POST("", emp.CreateEmployee)
+	employees.POST("/import", importHandler.ImportEmployees)
 
 	lenders := api.Group("/lenders")
 	lenders.GET("", lenderHandler.ListLenders)
 	lenders.POST("", lenderHandler.CreateLender)
+	lenders.POST("/import", importHandler.ImportLenders)
+	lenders.GET("/:id/portfolio", lenderHandler.GetPortfolio)
+	lenders.GET("/:id/wallet", walletHandler.GetWallet)
+	lenders.POST("/:id/wallet/deposit", walletHandler.Deposit)
+	lenders.POST("/:id/wallet/withdraw", walletHandler.Withdraw)
+	lenders.GET("/:id/wallet/transactions", walletHandler.ListTransactions)
+
+	products := api.Group("/products")
+	products.GET("", productHandler.ListProducts)
+	products.POST("", productHandler.CreateProduct)
+	products.GET("/:id", productHandler.GetProduct)
+	products.PUT("/:id", productHandler.UpdateProduct)
+	products.DELETE("/:id", productHandler.DeleteProduct)
+
+	webhooks := api.Group("/webhooks")
+	webhooks.GET("", webhookHandler.ListSubscriptions)
+	webhooks.POST("", webhookHandler.CreateSubscription)
+	webhooks.DELETE("/:id", webhookHandler.DeactivateSubscription)
+	webhooks.GET("/:id/deliveries", webhookHandler.ListDeliveries)
+	webhooks.POST("/deliveries/:id/redeliver", webhookHandler.Redeliver)
+
+	api.GET("/notifications", notificationHandler.ListNotifications)
+
+	api.GET("/audit", auditHandler.ListEntries)
 
 	// Start server in a goroutine
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			go func() {
-				// Log server startup
-				e.Logger.Info("Starting HTTP server on port " + cfg.Server.Port)
-
-				// Log registered routes
+				zap.L().Info("Starting HTTP server", zap.String("port", cfg.Server.Port))
 				for _, route := range e.Routes() {
-					e.Logger.Info("Route registered: " + route.Method + " " + route.Path)
+					zap.L().Debug("Route registered", zap.String("method", route.Method), zap.String("path", route.Path))
 				}
 
 				if err := e.Start(":" + cfg.Server.Port); err != nil {
 					if err != http.ErrServerClosed {
-						e.Logger.Fatal("Server error: " + err.Error())
+						zap.L().Fatal("HTTP server error", zap.Error(err))
 					} else {
-						e.Logger.Info("Server stopped")
+						zap.L().Info("HTTP server stopped")
 					}
 				}
 			}()
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
+			// Fail /readyz first, then wait for the in-flight requests, loan transitions included
+			checker.SetShuttingDown()
+			zap.L().Info("Shutting down HTTP server, draining in-flight requests")
 			return e.Shutdown(ctx)
 		},
 	})
 }
 
-func NewServer(cfg *config.Config) *echo.Echo {
+func NewServer(cfg *config.Config, m *metrics.Metrics) *echo.Echo {
 	e := echo.New()
-	e.Use(middleware.Logger())
-	e.Use(middleware.Recover())
+	e.HideBanner = true
+	e.HidePort = true
+
+	// Every request gets an ID, the one of the X-Request-ID header or a new one, echoed in the
+	// response, recorded in the audit log and attached to the logs of the request
+	e.Use(middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, id string) {
+			c.SetRequest(c.Request().WithContext(requestid.WithContext(c.Request().Context(), id)))
+		},
+	}))
+	e.Use(logging.Middleware())
+	e.Use(m.Middleware())
+	e.Use(tracing.Middleware())
+	e.Use(middleware.RecoverWithConfig(middleware.RecoverConfig{
+		LogErrorFunc: func(c echo.Context, err error, stack []byte) error {
+			logging.FromContext(c.Request().Context()).Error("Recovered from panic", zap.Error(err), zap.ByteString("stack", stack))
+			return err
+		},
+	}))
 	return e
 }
@@ -0,0 +1,82 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"go.uber.org/zap"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+const slowQueryThreshold = 200 * time.Millisecond
+
+var gormLevels = map[string]gormlogger.LogLevel{
+	"silent": gormlogger.Silent,
+	"error":  gormlogger.Error,
+	"warn":   gormlogger.Warn,
+	"info":   gormlogger.Info,
+}
+
+// gormLogger writes the GORM logs with the request ID of the statement context
+type gormLogger struct {
+	level gormlogger.LogLevel
+}
+
+// NewGORMLogger logs the failed statements, the slow ones, and every statement at the info level
+func NewGORMLogger(cfg *config.Config) (gormlogger.Interface, error) {
+	if cfg.Log.GORMLevel == "" {
+		return &gormLogger{level: gormlogger.Warn}, nil
+	}
+
+	level, ok := gormLevels[cfg.Log.GORMLevel]
+	if !ok {
+		return nil, fmt.Errorf("invalid GORM log level %q", cfg.Log.GORMLevel)
+	}
+	return &gormLogger{level: level}, nil
+}
+
+func (l *gormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	return &gormLogger{level: level}
+}
+
+func (l *gormLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= gormlogger.Info {
+		FromContext(ctx).Sugar().Infof(msg, args...)
+	}
+}
+
+func (l *gormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= gormlogger.Warn {
+		FromContext(ctx).Sugar().Warnf(msg, args...)
+	}
+}
+
+func (l *gormLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= gormlogger.Error {
+		FromContext(ctx).Sugar().Errorf(msg, args...)
+	}
+}
+
+func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	fields := func() []zap.Field {
+		sql, rows := fc()
+		return []zap.Field{zap.String("sql", sql), zap.Int64("rows", rows), zap.Duration("elapsed", elapsed)}
+	}
+
+	switch {
+	case err != nil && !errors.Is(err, gormlogger.ErrRecordNotFound) && l.level >= gormlogger.Error:
+		FromContext(ctx).Error("Database statement failed", append(fields(), zap.Error(err))...)
+	case elapsed > slowQueryThreshold && l.level >= gormlogger.Warn:
+		FromContext(ctx).Warn("Slow database statement", fields()...)
+	case l.level >= gormlogger.Info:
+		FromContext(ctx).Info("Database statement", fields()...)
+	}
+}
@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Middleware logs every HTTP request once it is served, in place of the Echo logger
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			started := time.Now()
+
+			err := next(c)
+			if err != nil {
+				// Let Echo write the error response, so its status code is logged
+				c.Error(err)
+			}
+
+			request := c.Request()
+			status := c.Response().Status
+			fields := []zap.Field{
+				zap.String("method", request.Method),
+				zap.String("route", c.Path()),
+				zap.String("uri", request.RequestURI),
+				zap.Int("status", status),
+				zap.Duration("latency", time.Since(started)),
+				zap.String("remote_ip", c.RealIP()),
+				zap.Int64("bytes_out", c.Response().Size),
+			}
+			if err != nil {
+				fields = append(fields, zap.Error(err))
+			}
+
+			level := zapcore.InfoLevel
+			if status >= http.StatusInternalServerError {
+				level = zapcore.ErrorLevel
+			}
+			FromContext(request.Context()).Log(level, "HTTP request", fields...)
+			return nil
+		}
+	}
+}
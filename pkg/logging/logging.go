@@ -0,0 +1,55 @@
+// Package logging writes structured JSON logs, each line from a request carrying its
+// request ID, so the logs of the handlers, services and repositories can be correlated.
+package logging
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/requestid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// New builds the JSON logger writing to the standard error at the configured level
+func New(cfg *config.Config) (*zap.Logger, error) {
+	level := zapcore.InfoLevel
+	if cfg.Log.Level != "" {
+		var err error
+		if level, err = zapcore.ParseLevel(cfg.Log.Level); err != nil {
+			return nil, fmt.Errorf("invalid log level: %w", err)
+		}
+	}
+
+	zapConfig := zap.NewProductionConfig()
+	zapConfig.Level = zap.NewAtomicLevelAt(level)
+	zapConfig.EncoderConfig.TimeKey = "time"
+	zapConfig.EncoderConfig.EncodeTime = zapcore.RFC3339NanoTimeEncoder
+	zapConfig.Sampling = nil
+	return zapConfig.Build()
+}
+
+// Setup installs logger as the one returned by FromContext, and sends the output of the
+// standard log package, still used by some libraries, to it
+func Setup(logger *zap.Logger) {
+	zap.ReplaceGlobals(logger)
+	zap.RedirectStdLog(logger)
+}
+
+// FromContext returns the logger with the request ID and the trace of ctx, when it has them
+func FromContext(ctx context.Context) *zap.Logger {
+	logger := zap.L()
+	if ctx == nil {
+		return logger
+	}
+
+	if id := requestid.FromContext(ctx); id != "" {
+		logger = logger.With(zap.String("request_id", id))
+	}
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		logger = logger.With(zap.String("trace_id", span.TraceID().String()), zap.String("span_id", span.SpanID().String()))
+	}
+	return logger
+}
@@ -0,0 +1,157 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theodorusyoga/loan-service-state-machine/config"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/logging"
+	"github.com/theodorusyoga/loan-service-state-machine/pkg/requestid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// observe replaces the global logger with one recording the entries from level
+func observe(t *testing.T, level zapcore.Level) *observer.ObservedLogs {
+	core, logs := observer.New(level)
+	restore := zap.ReplaceGlobals(zap.New(core))
+	t.Cleanup(restore)
+	return logs
+}
+
+func TestNew(t *testing.T) {
+	cfg := &config.Config{}
+	logger, err := logging.New(cfg)
+	require.NoError(t, err)
+	assert.True(t, logger.Core().Enabled(zapcore.InfoLevel))
+	assert.False(t, logger.Core().Enabled(zapcore.DebugLevel))
+
+	cfg.Log.Level = "debug"
+	logger, err = logging.New(cfg)
+	require.NoError(t, err)
+	assert.True(t, logger.Core().Enabled(zapcore.DebugLevel))
+
+	cfg.Log.Level = "verbose"
+	_, err = logging.New(cfg)
+	assert.Error(t, err)
+}
+
+func TestFromContext(t *testing.T) {
+	logs := observe(t, zapcore.InfoLevel)
+
+	logging.FromContext(context.Background()).Info("without request")
+	logging.FromContext(requestid.WithContext(context.Background(), "req-1")).Info("with request")
+
+	entries := logs.All()
+	require.Len(t, entries, 2)
+	assert.NotContains(t, entries[0].ContextMap(), "request_id")
+	assert.Equal(t, "req-1", entries[1].ContextMap()["request_id"])
+}
+
+func TestMiddleware(t *testing.T) {
+	logs := observe(t, zapcore.InfoLevel)
+
+	e := echo.New()
+	e.Use(middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, id string) {
+			c.SetRequest(c.Request().WithContext(requestid.WithContext(c.Request().Context(), id)))
+		},
+	}))
+	e.Use(logging.Middleware())
+	e.GET("/loans/:id", func(c echo.Context) error {
+		logging.FromContext(c.Request().Context()).Info("handling")
+		return c.NoContent(http.StatusNoContent)
+	})
+	e.GET("/broken", func(c echo.Context) error {
+		return errors.New("boom")
+	})
+
+	t.Run("accepts the request ID of the client", func(t *testing.T) {
+		logs.TakeAll()
+		req := httptest.NewRequest(http.MethodGet, "/loans/42", nil)
+		req.Header.Set(echo.HeaderXRequestID, "client-id")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, "client-id", rec.Header().Get(echo.HeaderXRequestID))
+		entries := logs.TakeAll()
+		require.Len(t, entries, 2)
+		assert.Equal(t, "handling", entries[0].Message)
+		assert.Equal(t, "client-id", entries[0].ContextMap()["request_id"])
+
+		fields := entries[1].ContextMap()
+		assert.Equal(t, "HTTP request", entries[1].Message)
+		assert.Equal(t, zapcore.InfoLevel, entries[1].Level)
+		assert.Equal(t, "client-id", fields["request_id"])
+		assert.Equal(t, "/loans/:id", fields["route"])
+		assert.Equal(t, int64(http.StatusNoContent), fields["status"])
+	})
+
+	t.Run("generates a request ID and logs server errors as errors", func(t *testing.T) {
+		logs.TakeAll()
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/broken", nil))
+
+		id := rec.Header().Get(echo.HeaderXRequestID)
+		assert.NotEmpty(t, id)
+		entries := logs.TakeAll()
+		require.Len(t, entries, 1)
+		assert.Equal(t, zapcore.ErrorLevel, entries[0].Level)
+		assert.Equal(t, id, entries[0].ContextMap()["request_id"])
+		assert.Equal(t, int64(http.StatusInternalServerError), entries[0].ContextMap()["status"])
+		assert.Equal(t, "boom", entries[0].ContextMap()["error"])
+	})
+}
+
+func TestGORMLogger(t *testing.T) {
+	logs := observe(t, zapcore.DebugLevel)
+	ctx := requestid.WithContext(context.Background(), "req-2")
+	statement := func() (string, int64) { return "SELECT 1", 1 }
+
+	cfg := &config.Config{}
+	cfg.Log.GORMLevel = "verbose"
+	_, err := logging.NewGORMLogger(cfg)
+	assert.Error(t, err)
+
+	// Failed and slow statements only, when unset
+	cfg.Log.GORMLevel = ""
+	logger, err := logging.NewGORMLogger(cfg)
+	require.NoError(t, err)
+
+	logger.Trace(ctx, time.Now(), statement, nil)
+	assert.Zero(t, logs.Len())
+
+	logger.Trace(ctx, time.Now(), statement, gormlogger.ErrRecordNotFound)
+	assert.Zero(t, logs.Len())
+
+	logger.Trace(ctx, time.Now(), statement, errors.New("connection refused"))
+	logger.Trace(ctx, time.Now().Add(-time.Second), statement, nil)
+	entries := logs.TakeAll()
+	require.Len(t, entries, 2)
+	assert.Equal(t, zapcore.ErrorLevel, entries[0].Level)
+	assert.Equal(t, "req-2", entries[0].ContextMap()["request_id"])
+	assert.Equal(t, "SELECT 1", entries[0].ContextMap()["sql"])
+	assert.Equal(t, "Slow database statement", entries[1].Message)
+
+	// Every statement at the info level
+	cfg.Log.GORMLevel = "info"
+	logger, err = logging.NewGORMLogger(cfg)
+	require.NoError(t, err)
+	logger.Trace(ctx, time.Now(), statement, nil)
+	assert.Equal(t, 1, logs.FilterMessage("Database statement").Len())
+
+	// Nothing when silenced
+	logs.TakeAll()
+	logger.LogMode(gormlogger.Silent).Trace(ctx, time.Now(), statement, errors.New("connection refused"))
+	assert.Zero(t, logs.Len())
+}
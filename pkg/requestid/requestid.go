@@ -0,0 +1,21 @@
+// Package requestid carries the ID of the API request that triggered an operation,
+// so it can be recorded far from the HTTP layer.
+package requestid
+
+import "context"
+
+type contextKey struct{}
+
+// WithContext returns a copy of ctx holding the request ID
+func WithContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID held by ctx, or an empty string
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}